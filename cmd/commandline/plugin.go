@@ -50,7 +50,8 @@ var (
 				outputPath = base + ".mlchainpkg"
 			}
 
-			plugin.PackagePlugin(inputPath, outputPath)
+			withWheels, _ := cmd.Flags().GetBool("with_wheels")
+			plugin.PackagePlugin(inputPath, outputPath, withWheels)
 		},
 	}
 
@@ -172,4 +173,5 @@ func init() {
 	// pluginTestCommand.Flags().StringP("timeout", "t", "", "timeout")
 
 	pluginPackageCommand.Flags().StringP("output_path", "o", "", "output path")
+	pluginPackageCommand.Flags().Bool("with_wheels", false, "download wheels of python dependencies for every arch in meta.arch and embed them, for offline installation")
 }
@@ -0,0 +1,77 @@
+package plugin
+
+import (
+	_ "embed"
+	"fmt"
+	"path/filepath"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+)
+
+//go:embed templates/go/go.mod.tmpl
+var GO_MOD_TEMPLATE []byte
+
+//go:embed templates/go/main.go.tmpl
+var GO_ENTRYPOINT_TEMPLATE []byte
+
+//go:embed templates/go/runtime.go.tmpl
+var GO_RUNTIME_TEMPLATE []byte
+
+//go:embed templates/go/tool.go.tmpl
+var GO_TOOL_TEMPLATE []byte
+
+//go:embed templates/go/tool_provider.yaml
+var GO_TOOL_PROVIDER_MANIFEST_TEMPLATE []byte
+
+//go:embed templates/go/tool.yaml
+var GO_TOOL_MANIFEST_TEMPLATE []byte
+
+//go:embed templates/go/GUIDE.md
+var GO_GUIDE []byte
+
+//go:embed templates/go/.mlchainignore
+var GO_MLCHAINIGNORE []byte
+
+//go:embed templates/go/.gitignore
+var GO_GITIGNORE []byte
+
+// categories the go template could scaffold
+var goCategories = []string{"tool"}
+
+func createGoEnvironment(
+	root string, manifest *plugin_entities.PluginDeclaration, category string,
+) error {
+	if category != "tool" {
+		return fmt.Errorf("go template only supports %v plugins for now", goCategories)
+	}
+
+	files := map[string][]byte{
+		"GUIDE.md":   GO_GUIDE,
+		"go.mod":     GO_MOD_TEMPLATE,
+		"main.go":    GO_ENTRYPOINT_TEMPLATE,
+		"runtime.go": GO_RUNTIME_TEMPLATE,
+		"tool.go":    GO_TOOL_TEMPLATE,
+		filepath.Join("provider", fmt.Sprintf("%s.yaml", manifest.Name)): GO_TOOL_PROVIDER_MANIFEST_TEMPLATE,
+		filepath.Join("tools", fmt.Sprintf("%s.yaml", manifest.Name)):    GO_TOOL_MANIFEST_TEMPLATE,
+	}
+
+	for name, tmpl := range files {
+		content, err := renderTemplate(tmpl, manifest, []string{})
+		if err != nil {
+			return err
+		}
+		if err := writeFile(filepath.Join(root, name), content); err != nil {
+			return err
+		}
+	}
+
+	if err := writeFile(filepath.Join(root, ".mlchainignore"), string(GO_MLCHAINIGNORE)); err != nil {
+		return err
+	}
+
+	if err := writeFile(filepath.Join(root, ".gitignore"), string(GO_GITIGNORE)); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -188,6 +188,14 @@ func (m model) createPlugin() {
 		manifest.Meta.Runner.Entrypoint = "main"
 		manifest.Meta.Runner.Language = constants.Python
 		manifest.Meta.Runner.Version = "3.12"
+	case constants.Go:
+		manifest.Meta.Runner.Entrypoint = "."
+		manifest.Meta.Runner.Language = constants.Go
+		manifest.Meta.Runner.Version = "1.21"
+	case constants.NodeJS:
+		manifest.Meta.Runner.Entrypoint = "dist/main.js"
+		manifest.Meta.Runner.Language = constants.NodeJS
+		manifest.Meta.Runner.Version = "20"
 	default:
 		log.Error("unsupported language: %s", m.subMenus[SUB_MENU_KEY_LANGUAGE].(language).Language())
 		return
@@ -234,21 +242,43 @@ func (m model) createPlugin() {
 		return
 	}
 
-	// create .env.example
-	if err := writeFile(filepath.Join(pluginDir, ".env.example"), string(ENV_EXAMPLE)); err != nil {
-		log.Error("failed to write .env.example file: %s", err)
-		return
-	}
+	if manifest.Meta.Runner.Language == constants.Python {
+		// create .env.example, remote debugging is only supported by the python sdk
+		if err := writeFile(filepath.Join(pluginDir, ".env.example"), string(ENV_EXAMPLE)); err != nil {
+			log.Error("failed to write .env.example file: %s", err)
+			return
+		}
 
-	err = createPythonEnvironment(
-		pluginDir,
-		manifest.Meta.Runner.Entrypoint,
-		manifest,
-		m.subMenus[SUB_MENU_KEY_CATEGORY].(category).Category(),
-	)
-	if err != nil {
-		log.Error("failed to create python environment: %s", err)
-		return
+		err = createPythonEnvironment(
+			pluginDir,
+			manifest.Meta.Runner.Entrypoint,
+			manifest,
+			m.subMenus[SUB_MENU_KEY_CATEGORY].(category).Category(),
+		)
+		if err != nil {
+			log.Error("failed to create python environment: %s", err)
+			return
+		}
+	} else if manifest.Meta.Runner.Language == constants.Go {
+		err = createGoEnvironment(
+			pluginDir,
+			manifest,
+			m.subMenus[SUB_MENU_KEY_CATEGORY].(category).Category(),
+		)
+		if err != nil {
+			log.Error("failed to create go environment: %s", err)
+			return
+		}
+	} else if manifest.Meta.Runner.Language == constants.NodeJS {
+		err = createTypeScriptEnvironment(
+			pluginDir,
+			manifest,
+			m.subMenus[SUB_MENU_KEY_CATEGORY].(category).Category(),
+		)
+		if err != nil {
+			log.Error("failed to create typescript environment: %s", err)
+			return
+		}
 	}
 
 	success = true
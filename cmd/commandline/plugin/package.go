@@ -12,7 +12,7 @@ var (
 	MaxPluginPackageSize = int64(52428800) // 50MB
 )
 
-func PackagePlugin(inputPath string, outputPath string) {
+func PackagePlugin(inputPath string, outputPath string, withWheels bool) {
 	decoder, err := decoder.NewFSPluginDecoder(inputPath)
 	if err != nil {
 		log.Error("failed to create plugin decoder , plugin path: %s, error: %v", inputPath, err)
@@ -20,6 +20,28 @@ func PackagePlugin(inputPath string, outputPath string) {
 		return
 	}
 
+	// vendor wheels so that the plugin could be installed without the package index
+	if withWheels {
+		manifest, err := decoder.Manifest()
+		if err != nil {
+			log.Error("failed to read manifest %v", err)
+			os.Exit(1)
+			return
+		}
+
+		if err := downloadWheels(inputPath, &manifest); err != nil {
+			log.Error("failed to download wheels %v", err)
+			os.Exit(1)
+			return
+		}
+
+		if err := checkWheelsPackaged(decoder); err != nil {
+			log.Error("failed to package wheels %v", err)
+			os.Exit(1)
+			return
+		}
+	}
+
 	packager := packager.NewPackager(decoder)
 	zipFile, err := packager.Pack(MaxPluginPackageSize)
 
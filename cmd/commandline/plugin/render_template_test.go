@@ -1,6 +1,10 @@
 package plugin
 
 import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -36,3 +40,68 @@ func TestRenderPythonToolTemplate(t *testing.T) {
 		t.Errorf("template content does not contain TestTool, snakeToCamel failed")
 	}
 }
+
+func TestCreateGoEnvironment(t *testing.T) {
+	manifest := &plugin_entities.PluginDeclaration{
+		PluginDeclarationWithoutAdvancedFields: plugin_entities.PluginDeclarationWithoutAdvancedFields{
+			Name:   "test",
+			Author: "test",
+			Description: plugin_entities.I18nObject{
+				EnUS: "test",
+			},
+		},
+	}
+
+	root := t.TempDir()
+	if err := createGoEnvironment(root, manifest, "tool"); err != nil {
+		t.Fatalf("failed to create go environment: %v", err)
+	}
+
+	// rendered sources should still be valid go
+	for _, name := range []string{"main.go", "runtime.go", "tool.go"} {
+		if _, err := parser.ParseFile(token.NewFileSet(), filepath.Join(root, name), nil, 0); err != nil {
+			t.Errorf("failed to parse %s: %v", name, err)
+		}
+	}
+
+	for _, name := range []string{"go.mod", "provider/test.yaml", "tools/test.yaml", ".mlchainignore"} {
+		if _, err := os.Stat(filepath.Join(root, name)); err != nil {
+			t.Errorf("%s not created: %v", name, err)
+		}
+	}
+
+	if err := createGoEnvironment(t.TempDir(), manifest, "llm"); err == nil {
+		t.Errorf("go template should not support llm plugins")
+	}
+}
+
+func TestCreateTypeScriptEnvironment(t *testing.T) {
+	manifest := &plugin_entities.PluginDeclaration{
+		PluginDeclarationWithoutAdvancedFields: plugin_entities.PluginDeclarationWithoutAdvancedFields{
+			Name:   "test",
+			Author: "test",
+			Description: plugin_entities.I18nObject{
+				EnUS: "test",
+			},
+		},
+	}
+
+	root := t.TempDir()
+	if err := createTypeScriptEnvironment(root, manifest, "tool"); err != nil {
+		t.Fatalf("failed to create typescript environment: %v", err)
+	}
+
+	tool, err := os.ReadFile(filepath.Join(root, "src/tool.ts"))
+	if err != nil {
+		t.Fatalf("failed to read tool.ts: %v", err)
+	}
+	if !strings.Contains(string(tool), `case "test":`) || !strings.Contains(string(tool), "Record<string, any>") {
+		t.Errorf("tool.ts is not rendered as expected:\n%s", tool)
+	}
+
+	for _, name := range []string{"package.json", "tsconfig.json", "src/main.ts", "src/runtime.ts", "provider/test.yaml", "tools/test.yaml"} {
+		if _, err := os.Stat(filepath.Join(root, name)); err != nil {
+			t.Errorf("%s not created: %v", name, err)
+		}
+	}
+}
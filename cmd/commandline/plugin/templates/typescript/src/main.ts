@@ -0,0 +1,10 @@
+import { Plugin } from "./runtime";
+import { getRuntimeParameters, invokeTool, validateCredentials } from "./tool";
+
+const plugin = new Plugin();
+
+plugin.handle("validate_tool_credentials", validateCredentials);
+plugin.handle("get_tool_runtime_parameters", getRuntimeParameters);
+plugin.handle("invoke_tool", invokeTool);
+
+plugin.run();
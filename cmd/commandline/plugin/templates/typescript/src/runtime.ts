@@ -0,0 +1,92 @@
+import * as readline from "readline";
+
+// Handler handles a request from the daemon, chunks are sent back through the session
+export type Handler = (session: Session, request: any) => Promise<void> | void;
+
+// Plugin talks to the daemon over stdin and stdout, one json message per line
+export class Plugin {
+  private handlers: Map<string, Handler> = new Map();
+
+  // registers the handler of an action, like `invoke_tool`
+  handle(action: string, handler: Handler) {
+    this.handlers.set(action, handler);
+  }
+
+  // serves requests until stdin is closed by the daemon
+  run() {
+    // the daemon stops a plugin which stays silent for 60 seconds
+    const heartbeat = setInterval(() => this.send("", "heartbeat", {}), 10 * 1000);
+    this.send("", "heartbeat", {});
+
+    const lines = readline.createInterface({ input: process.stdin, crlfDelay: Infinity });
+    lines.on("line", (line) => {
+      if (line.trim() !== "") {
+        this.dispatch(line);
+      }
+    });
+    lines.on("close", () => clearInterval(heartbeat));
+  }
+
+  // sends a log message to the daemon
+  log(message: string) {
+    this.send("", "log", { level: "info", message, timestamp: Date.now() / 1000 });
+  }
+
+  private dispatch(line: string) {
+    let message: { session_id: string; event: string; data: any };
+    try {
+      message = JSON.parse(line);
+    } catch (e) {
+      this.send("", "error", `invalid message: ${e}`);
+      return;
+    }
+
+    // only requests are handled, backwards invocations are not used by this template
+    if (message.event !== "request") {
+      return;
+    }
+
+    const session = new Session(message.session_id, this);
+    const handler = this.handlers.get(message.data?.action);
+    if (!handler) {
+      session.error("NotImplementedError", `action ${message.data?.action} is not implemented`);
+      return;
+    }
+
+    Promise.resolve()
+      .then(() => handler(session, message.data))
+      .then(
+        () => session.end(),
+        (e) => session.error("InvokeError", e instanceof Error ? e.message : String(e)),
+      );
+  }
+
+  send(sessionId: string, event: string, data: any) {
+    process.stdout.write(JSON.stringify({ session_id: sessionId, event, data }) + "\n");
+  }
+}
+
+// Session is a single request from the daemon
+export class Session {
+  constructor(
+    readonly id: string,
+    private plugin: Plugin,
+  ) {}
+
+  // sends a response chunk
+  stream(chunk: any) {
+    this.plugin.send(this.id, "session", { type: "stream", data: chunk });
+  }
+
+  // ends the session with an error
+  error(errorType: string, message: string) {
+    this.plugin.send(this.id, "session", {
+      type: "error",
+      data: { error_type: errorType, message, args: {} },
+    });
+  }
+
+  end() {
+    this.plugin.send(this.id, "session", { type: "end", data: {} });
+  }
+}
@@ -0,0 +1,35 @@
+import { Session } from "./runtime";
+
+interface ToolRequest {
+  provider: string;
+  tool: string;
+  tool_parameters: Record<string, any>;
+  credentials: Record<string, any>;
+}
+
+// checks the credentials declared in provider/{{ .PluginName }}.yaml
+export function validateCredentials(session: Session, request: ToolRequest) {
+  session.stream({ result: true });
+}
+
+// returns extra parameters of a tool decided at runtime
+export function getRuntimeParameters(session: Session, request: ToolRequest) {
+  session.stream({ parameters: [] });
+}
+
+// runs the tools declared in tools/*.yaml
+export async function invokeTool(session: Session, request: ToolRequest) {
+  switch (request.tool) {
+    case "{{ .PluginName }}": {
+      const query = String(request.tool_parameters?.query ?? "");
+      session.stream(textMessage(`Hello, ${query}!`));
+      return;
+    }
+  }
+
+  throw new Error(`tool ${request.tool} not found`);
+}
+
+function textMessage(text: string) {
+  return { type: "text", message: { text }, meta: null };
+}
@@ -0,0 +1,85 @@
+package plugin
+
+import (
+	_ "embed"
+	"fmt"
+	"path/filepath"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+)
+
+//go:embed templates/typescript/package.json
+var TYPESCRIPT_PACKAGE_TEMPLATE []byte
+
+//go:embed templates/typescript/tsconfig.json
+var TYPESCRIPT_TSCONFIG_TEMPLATE []byte
+
+//go:embed templates/typescript/src/main.ts
+var TYPESCRIPT_ENTRYPOINT_TEMPLATE []byte
+
+//go:embed templates/typescript/src/runtime.ts
+var TYPESCRIPT_RUNTIME_TEMPLATE []byte
+
+//go:embed templates/typescript/src/tool.ts
+var TYPESCRIPT_TOOL_TEMPLATE []byte
+
+//go:embed templates/typescript/tool_provider.yaml
+var TYPESCRIPT_TOOL_PROVIDER_MANIFEST_TEMPLATE []byte
+
+//go:embed templates/typescript/tool.yaml
+var TYPESCRIPT_TOOL_MANIFEST_TEMPLATE []byte
+
+//go:embed templates/typescript/GUIDE.md
+var TYPESCRIPT_GUIDE []byte
+
+//go:embed templates/typescript/.mlchainignore
+var TYPESCRIPT_MLCHAINIGNORE []byte
+
+//go:embed templates/typescript/.gitignore
+var TYPESCRIPT_GITIGNORE []byte
+
+// categories the typescript template could scaffold
+var typescriptCategories = []string{"tool"}
+
+func createTypeScriptEnvironment(
+	root string, manifest *plugin_entities.PluginDeclaration, category string,
+) error {
+	if category != "tool" {
+		return fmt.Errorf("typescript template only supports %v plugins for now", typescriptCategories)
+	}
+
+	files := map[string][]byte{
+		"GUIDE.md":      TYPESCRIPT_GUIDE,
+		"package.json":  TYPESCRIPT_PACKAGE_TEMPLATE,
+		"tsconfig.json": TYPESCRIPT_TSCONFIG_TEMPLATE,
+		"src/main.ts":   TYPESCRIPT_ENTRYPOINT_TEMPLATE,
+		"src/tool.ts":   TYPESCRIPT_TOOL_TEMPLATE,
+		filepath.Join("provider", fmt.Sprintf("%s.yaml", manifest.Name)): TYPESCRIPT_TOOL_PROVIDER_MANIFEST_TEMPLATE,
+		filepath.Join("tools", fmt.Sprintf("%s.yaml", manifest.Name)):    TYPESCRIPT_TOOL_MANIFEST_TEMPLATE,
+	}
+
+	for name, tmpl := range files {
+		content, err := renderTemplate(tmpl, manifest, []string{})
+		if err != nil {
+			return err
+		}
+		if err := writeFile(filepath.Join(root, name), content); err != nil {
+			return err
+		}
+	}
+
+	// the runtime contains no placeholder, it's copied as is
+	if err := writeFile(filepath.Join(root, "src/runtime.ts"), string(TYPESCRIPT_RUNTIME_TEMPLATE)); err != nil {
+		return err
+	}
+
+	if err := writeFile(filepath.Join(root, ".mlchainignore"), string(TYPESCRIPT_MLCHAINIGNORE)); err != nil {
+		return err
+	}
+
+	if err := writeFile(filepath.Join(root, ".gitignore"), string(TYPESCRIPT_GITIGNORE)); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_packager/decoder"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/constants"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+)
+
+const (
+	// the daemon installs the plugin from this directory without the package index
+	WHEELS_DIR = "wheels"
+)
+
+// platform tags of linux wheels the daemon could install, by arch
+var wheelPlatforms = map[constants.Arch][]string{
+	constants.AMD64: {"manylinux_2_17_x86_64", "manylinux2014_x86_64", "manylinux_2_28_x86_64"},
+	constants.ARM64: {"manylinux_2_17_aarch64", "manylinux2014_aarch64", "manylinux_2_28_aarch64"},
+}
+
+// downloadWheels downloads wheels of the plugin's dependencies into wheels/ for every declared arch
+func downloadWheels(pluginPath string, manifest *plugin_entities.PluginDeclaration) error {
+	if manifest.Meta.Runner.Language != constants.Python {
+		return fmt.Errorf("wheels could only be downloaded for python plugins")
+	}
+
+	python, err := exec.LookPath("python3")
+	if err != nil {
+		return fmt.Errorf("python3 is required to download wheels")
+	}
+
+	requirements, cleanup, err := exportRequirements(pluginPath)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	wheelsPath := filepath.Join(pluginPath, WHEELS_DIR)
+	for _, arch := range manifest.Meta.Arch {
+		platforms, ok := wheelPlatforms[arch]
+		if !ok {
+			return fmt.Errorf("unsupported arch: %s", arch)
+		}
+
+		log.Info("downloading wheels for %s", arch)
+
+		args := []string{
+			"-m", "pip", "download",
+			"-r", requirements,
+			"--dest", wheelsPath,
+			"--only-binary=:all:",
+			"--implementation", "cp",
+			"--python-version", manifest.Meta.Runner.Version,
+		}
+		for _, platform := range platforms {
+			args = append(args, "--platform", platform)
+		}
+
+		cmd := exec.Command(python, args...)
+		cmd.Dir = pluginPath
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("failed to download wheels for %s: %s", arch, err)
+		}
+	}
+
+	return nil
+}
+
+// exportRequirements returns requirements of the plugin in requirements.txt format,
+// lockfiles are exported with hashes so that the daemon verifies the wheels against them
+func exportRequirements(pluginPath string) (string, func(), error) {
+	exists := func(name string) bool {
+		_, err := os.Stat(filepath.Join(pluginPath, name))
+		return err == nil
+	}
+
+	var args []string
+	switch {
+	case exists("pyproject.toml") && exists("uv.lock"):
+		args = []string{"uv", "export", "--locked", "--no-dev", "--no-emit-project", "--format", "requirements-txt", "--output-file"}
+	case exists("pyproject.toml") && exists("poetry.lock"):
+		args = []string{"poetry", "export", "--only", "main", "--format", "requirements.txt", "--output"}
+	case exists("requirements.txt"):
+		return filepath.Join(pluginPath, "requirements.txt"), func() {}, nil
+	default:
+		return "", nil, fmt.Errorf("failed to find requirements.txt, uv.lock or poetry.lock")
+	}
+
+	exported, err := os.CreateTemp("", "mlchain-requirements-*.txt")
+	if err != nil {
+		return "", nil, err
+	}
+	exported.Close()
+	cleanup := func() { os.Remove(exported.Name()) }
+
+	cmd := exec.Command(args[0], append(args[1:], exported.Name())...)
+	cmd.Dir = pluginPath
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("failed to export requirements: %s", err)
+	}
+
+	return exported.Name(), cleanup, nil
+}
+
+// checkWheelsPackaged makes sure wheels/ is not excluded by .mlchainignore
+func checkWheelsPackaged(decoder *decoder.FSPluginDecoder) error {
+	packaged := false
+	if err := decoder.Walk(func(filename string, dir string) error {
+		if dir == WHEELS_DIR {
+			packaged = true
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if !packaged {
+		return fmt.Errorf("%s/ is excluded from the package, remove it from .mlchainignore", WHEELS_DIR)
+	}
+
+	return nil
+}
@@ -3,12 +3,16 @@ package main
 import (
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/sandbox"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/server"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/app"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
 )
 
 func main() {
+	// runs the sandbox init process if the daemon is re-executed by a sandboxed plugin
+	sandbox.Init()
+
 	var config app.Config
 
 	// load env
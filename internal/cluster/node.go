@@ -122,7 +122,11 @@ func (c *Cluster) FetchPluginAvailableNodesByHashedId(hashedPluginId string) ([]
 	}
 
 	nodes := make([]string, 0)
-	for key := range states {
+	for key, state := range states {
+		// a draining plugin accepts no new request
+		if state.Draining {
+			continue
+		}
 		nodeId, _, err := c.splitNodePluginJoin(key)
 		if err != nil {
 			continue
@@ -6,6 +6,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/plugin_errors"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/cache"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
@@ -18,7 +19,8 @@ type pluginLifeTime struct {
 
 type pluginState struct {
 	plugin_entities.PluginRuntimeState
-	Identity string `json:"identity"`
+	Identity string                            `json:"identity"`
+	Type     plugin_entities.PluginRuntimeType `json:"type"`
 }
 
 // RegisterPlugin registers a plugin to the cluster, and start to be scheduled
@@ -48,6 +50,15 @@ func (c *Cluster) RegisterPlugin(lifetime plugin_entities.PluginLifetime) error
 		c.pluginLock.Unlock()
 	})
 
+	// other nodes stop redirecting requests to a draining plugin right away
+	lifetime.OnDraining(func() {
+		c.pluginLock.Lock()
+		if err := c.doPluginStateUpdate(l); err != nil {
+			log.Error("failed to update state of draining plugin %s: %s", identity.String(), err.Error())
+		}
+		c.pluginLock.Unlock()
+	})
+
 	c.pluginLock.Lock()
 	if !lifetime.Stopped() {
 		c.plugins.Store(identity.String(), l)
@@ -139,6 +150,7 @@ func (c *Cluster) doPluginStateUpdate(lifetime *pluginLifeTime) error {
 
 	scheduleState := &pluginState{
 		Identity:           identity.String(),
+		Type:               lifetime.lifetime.Type(),
 		PluginRuntimeState: state,
 	}
 
@@ -287,7 +299,11 @@ func (c *Cluster) autoGCPlugins() error {
 }
 
 func (c *Cluster) IsPluginOnCurrentNode(identity plugin_entities.PluginUniqueIdentifier) (bool, error) {
-	_, ok := c.plugins.Load(identity.String())
+	l, ok := c.plugins.Load(identity.String())
+	if ok && l.lifetime.RuntimeState().Draining {
+		// the request is redirected to another node running the plugin if any
+		return false, plugin_errors.ErrPluginDraining
+	}
 	if !ok {
 		_, err := c.manager.Get(identity)
 		if err != nil {
@@ -4,28 +4,29 @@ import (
 	"errors"
 	"io"
 	"net/http"
+
+	"golang.org/x/net/websocket"
 )
 
 // RedirectRequest redirects the request to the specified node
 func (c *Cluster) RedirectRequest(
 	node_id string, request *http.Request,
 ) (int, http.Header, io.ReadCloser, error) {
-	node, ok := c.nodes.Load(node_id)
-	if !ok {
-		return 0, nil, nil, errors.New("node not found")
+	address, err := c.NodeAddress(node_id)
+	if err != nil {
+		return 0, nil, nil, err
 	}
 
-	ips := c.SortIps(node)
-	if len(ips) == 0 {
-		return 0, nil, nil, errors.New("no available ip found")
+	url := "http://" + address + request.URL.Path
+	if request.URL.RawQuery != "" {
+		url += "?" + request.URL.RawQuery
 	}
 
-	ip := ips[0]
-
-	// create a new request
-	redirectedRequest, err := http.NewRequest(
+	// create a new request, it's cancelled along with the original one once the client is gone
+	redirectedRequest, err := http.NewRequestWithContext(
+		request.Context(),
 		request.Method,
-		"http://"+ip.fullAddress()+request.URL.Path,
+		url,
 		request.Body,
 	)
 
@@ -49,3 +50,62 @@ func (c *Cluster) RedirectRequest(
 
 	return resp.StatusCode, resp.Header, resp.Body, nil
 }
+
+// NodeAddress returns the preferred address of the specified node, for requests which
+// could not be redirected by RedirectRequest, like websocket connections
+func (c *Cluster) NodeAddress(node_id string) (string, error) {
+	node, ok := c.nodes.Load(node_id)
+	if !ok {
+		return "", errors.New("node not found")
+	}
+
+	ips := c.SortIps(node)
+	if len(ips) == 0 {
+		return "", errors.New("no available ip found")
+	}
+
+	return ips[0].fullAddress(), nil
+}
+
+// RedirectWebSocket opens the websocket connection of the request on the specified node,
+// messages are relayed by the caller
+func (c *Cluster) RedirectWebSocket(
+	node_id string, request *http.Request,
+) (*websocket.Conn, error) {
+	address, err := c.NodeAddress(node_id)
+	if err != nil {
+		return nil, err
+	}
+
+	url := "ws://" + address + request.URL.Path
+	if request.URL.RawQuery != "" {
+		url += "?" + request.URL.RawQuery
+	}
+
+	config, err := websocket.NewConfig(url, "http://"+address)
+	if err != nil {
+		return nil, err
+	}
+
+	// copy headers, the handshake is done again with the node
+	for key, values := range request.Header {
+		if websocketHandshakeHeaders[http.CanonicalHeaderKey(key)] {
+			continue
+		}
+		for _, value := range values {
+			config.Header.Add(key, value)
+		}
+	}
+
+	return websocket.DialConfig(config)
+}
+
+var websocketHandshakeHeaders = map[string]bool{
+	"Connection":               true,
+	"Upgrade":                  true,
+	"Origin":                   true,
+	"Sec-Websocket-Key":        true,
+	"Sec-Websocket-Version":    true,
+	"Sec-Websocket-Extensions": true,
+	"Sec-Websocket-Protocol":   true,
+}
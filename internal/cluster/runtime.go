@@ -0,0 +1,65 @@
+package cluster
+
+import (
+	"sort"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/cache"
+)
+
+// PluginRuntime is a plugin runtime hosted by a node of the cluster
+type PluginRuntime struct {
+	plugin_entities.PluginRuntimeState
+	PluginUniqueIdentifier string                            `json:"plugin_unique_identifier"`
+	Type                   plugin_entities.PluginRuntimeType `json:"type"`
+	NodeID                 string                            `json:"node_id"`
+}
+
+// ListPluginRuntimes returns runtimes hosted by all the nodes of the cluster,
+// runtimes of other nodes are reported as they were last scheduled, the ones of the current node are live
+func (c *Cluster) ListPluginRuntimes() ([]PluginRuntime, error) {
+	states, err := cache.GetMap[pluginState](PLUGIN_STATE_MAP_KEY)
+	if err != nil && err != cache.ErrNotFound {
+		return nil, err
+	}
+
+	return c.mergePluginRuntimes(states), nil
+}
+
+// mergePluginRuntimes returns runtimes of other nodes from their states in redis
+// and the live runtimes of the current node in place of its states
+func (c *Cluster) mergePluginRuntimes(states map[string]pluginState) []PluginRuntime {
+	runtimes := []PluginRuntime{}
+	for nodePluginJoin, state := range states {
+		nodeId, _, err := c.splitNodePluginJoin(nodePluginJoin)
+		if err != nil || nodeId == c.id || !c.isPluginActive(&state) {
+			continue
+		}
+
+		runtimes = append(runtimes, PluginRuntime{
+			PluginRuntimeState:     state.PluginRuntimeState,
+			PluginUniqueIdentifier: state.Identity,
+			Type:                   state.Type,
+			NodeID:                 nodeId,
+		})
+	}
+
+	c.plugins.Range(func(identity string, plugin *pluginLifeTime) bool {
+		runtimes = append(runtimes, PluginRuntime{
+			PluginRuntimeState:     plugin.lifetime.RuntimeState(),
+			PluginUniqueIdentifier: identity,
+			Type:                   plugin.lifetime.Type(),
+			NodeID:                 c.id,
+		})
+		return true
+	})
+
+	sort.Slice(runtimes, func(i, j int) bool {
+		if runtimes[i].PluginUniqueIdentifier != runtimes[j].PluginUniqueIdentifier {
+			return runtimes[i].PluginUniqueIdentifier < runtimes[j].PluginUniqueIdentifier
+		}
+		return runtimes[i].NodeID < runtimes[j].NodeID
+	})
+
+	return runtimes
+}
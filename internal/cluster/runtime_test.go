@@ -0,0 +1,78 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/app"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+)
+
+func TestMergePluginRuntimes(t *testing.T) {
+	cluster := NewCluster(&app.Config{
+		ServerPort: 12121,
+	}, nil)
+
+	// the plugin runs on the current node, its state in redis is as it was last scheduled
+	plugin := getRandomPluginRuntime()
+	plugin.State.Pids = []int{42}
+	plugin.State.Sessions = 3
+	cluster.plugins.Store("langgenius/local:0.0.1@a", &pluginLifeTime{lifetime: &plugin})
+
+	scheduledAt := time.Now()
+	expiredAt := time.Now().Add(-cluster.pluginDeactivatedTimeout * 2)
+	states := map[string]pluginState{
+		cluster.id + ":local": {
+			PluginRuntimeState: plugin_entities.PluginRuntimeState{
+				Pids:        []int{1},
+				ScheduledAt: &scheduledAt,
+			},
+			Identity: "langgenius/local:0.0.1@a",
+			Type:     plugin_entities.PLUGIN_RUNTIME_TYPE_LOCAL,
+		},
+		"node-b:remote": {
+			PluginRuntimeState: plugin_entities.PluginRuntimeState{
+				Pids:        []int{7},
+				Sessions:    1,
+				ScheduledAt: &scheduledAt,
+			},
+			Identity: "langgenius/remote:0.0.1@b",
+			Type:     plugin_entities.PLUGIN_RUNTIME_TYPE_LOCAL,
+		},
+		// the node has not scheduled the plugin for too long
+		"node-c:remote": {
+			PluginRuntimeState: plugin_entities.PluginRuntimeState{
+				ScheduledAt: &expiredAt,
+			},
+			Identity: "langgenius/remote:0.0.1@b",
+			Type:     plugin_entities.PLUGIN_RUNTIME_TYPE_LOCAL,
+		},
+		"invalid": {
+			PluginRuntimeState: plugin_entities.PluginRuntimeState{
+				ScheduledAt: &scheduledAt,
+			},
+			Identity: "langgenius/invalid:0.0.1@c",
+		},
+	}
+
+	runtimes := cluster.mergePluginRuntimes(states)
+	if len(runtimes) != 2 {
+		t.Fatalf("expected a runtime of the current node and one of another node, got %+v", runtimes)
+	}
+
+	local := runtimes[0]
+	if local.PluginUniqueIdentifier != "langgenius/local:0.0.1@a" || local.NodeID != cluster.id {
+		t.Fatalf("expected the runtime of the current node first, got %+v", local)
+	}
+	if len(local.Pids) != 1 || local.Pids[0] != 42 || local.Sessions != 3 {
+		t.Fatalf("expected the live state of the current node, got %+v", local.PluginRuntimeState)
+	}
+
+	remote := runtimes[1]
+	if remote.PluginUniqueIdentifier != "langgenius/remote:0.0.1@b" || remote.NodeID != "node-b" {
+		t.Fatalf("expected the runtime of the other node, got %+v", remote)
+	}
+	if len(remote.Pids) != 1 || remote.Pids[0] != 7 || remote.Sessions != 1 {
+		t.Fatalf("expected the state of the other node from redis, got %+v", remote.PluginRuntimeState)
+	}
+}
@@ -65,6 +65,7 @@ func StreamResponse[T any](i *RealBackwardsInvocation, method string, path strin
 	}
 
 	newResponse := stream.NewStream[T](1024)
+	newResponse.SetBlocking()
 	newResponse.OnClose(func() {
 		response.Close()
 	})
@@ -12,6 +12,10 @@ type BaseInvokeMlchainRequest struct {
 	TenantId string     `json:"tenant_id"`
 	UserId   string     `json:"user_id"`
 	Type     InvokeType `json:"type"`
+	// deadline of the session issuing the invocation in milliseconds since the unix epoch,
+	// mlchain forwards it to the calls it makes on behalf of the invocation, the clocks of
+	// mlchain and the daemon are assumed to be in sync like the ones of the nodes of a cluster
+	Deadline int64 `json:"deadline,omitempty"`
 }
 
 type InvokeType string
@@ -2,7 +2,6 @@ package plugin_daemon
 
 import (
 	"bytes"
-	"encoding/base64"
 	"errors"
 
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/session_manager"
@@ -48,7 +47,11 @@ func InvokeAgentStrategy(
 		}
 	}
 
-	newResponse := stream.NewStream[agent_entities.AgentStrategyResponseChunk](128)
+	newResponse := newResponseStream[agent_entities.AgentStrategyResponseChunk](128)
+	newResponse.OnClose(func() {
+		// the session is cancelled if the caller leaves before the plugin ends it
+		response.Close()
+	})
 	routine.Submit(map[string]string{
 		"module":                  "plugin_daemon",
 		"function":                "InvokeAgentStrategy",
@@ -79,7 +82,7 @@ func InvokeAgentStrategy(
 				// convert total_length to int
 				totalLengthInt := int(totalLength)
 
-				blob, ok := item.Message["blob"].(string)
+				blob, ok := item.Message["blob"]
 				if !ok {
 					continue
 				}
@@ -94,7 +97,7 @@ func InvokeAgentStrategy(
 				}
 
 				if end {
-					newResponse.Write(agent_entities.AgentStrategyResponseChunk{
+					newResponse.WriteContext(session.Context(), agent_entities.AgentStrategyResponseChunk{
 						ToolResponseChunk: tool_entities.ToolResponseChunk{
 							Type: tool_entities.ToolResponseChunkTypeBlob,
 							Message: map[string]any{
@@ -110,8 +113,7 @@ func InvokeAgentStrategy(
 						newResponse.WriteError(errors.New("file is too large"))
 						return
 					} else {
-						// decode the blob using base64
-						decoded, err := base64.StdEncoding.DecodeString(blob)
+						decoded, err := decodeBlobChunk(blob)
 						if err != nil {
 							newResponse.WriteError(err)
 							return
@@ -124,8 +126,9 @@ func InvokeAgentStrategy(
 						files[id].Write(decoded)
 					}
 				}
-			} else {
-				newResponse.Write(item)
+			} else if err := newResponse.WriteContext(session.Context(), item); err != nil {
+				newResponse.WriteError(err)
+				return
 			}
 		}
 	})
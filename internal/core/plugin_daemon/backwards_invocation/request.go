@@ -2,6 +2,7 @@ package backwards_invocation
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/mlchain_invocation"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/session_manager"
@@ -99,3 +100,63 @@ func (bi *BackwardsInvocation) UserID() (string, error) {
 	}
 	return bi.session.UserID, nil
 }
+
+// Cancelled returns true if the session of the invocation is cancelled
+func (bi *BackwardsInvocation) Cancelled() bool {
+	if bi.session == nil {
+		return false
+	}
+
+	return bi.session.IsCancelled()
+}
+
+// Deadline returns the deadline of the session of the invocation, zero if there is none
+func (bi *BackwardsInvocation) Deadline() time.Time {
+	if bi.session == nil {
+		return time.Time{}
+	}
+	return bi.session.Deadline
+}
+
+// DeadlineExceeded returns true once the deadline of the session of the invocation has passed
+func (bi *BackwardsInvocation) DeadlineExceeded() bool {
+	if bi.session == nil {
+		return false
+	}
+	return bi.session.DeadlineExceeded()
+}
+
+// closeOnCancel closes the response once the session is cancelled or its deadline is exceeded, which drops
+// the request to mlchain, the returned function stops watching and must be called once the response is consumed
+func (bi *BackwardsInvocation) closeOnCancel(response interface{ Close() }) func() {
+	if bi.session == nil {
+		return func() {}
+	}
+
+	cancelled, stopWatching := bi.session.WatchCancelled()
+	deadline := bi.session.Deadline
+	done := make(chan bool)
+	go func() {
+		defer stopWatching()
+
+		// a nil channel never fires, sessions without a deadline wait for the cancellation only
+		var expired <-chan time.Time
+		if !deadline.IsZero() {
+			timer := time.NewTimer(time.Until(deadline))
+			defer timer.Stop()
+			expired = timer.C
+		}
+
+		select {
+		case <-cancelled:
+			response.Close()
+		case <-expired:
+			response.Close()
+		case <-done:
+		}
+	}()
+
+	return func() {
+		close(done)
+	}
+}
@@ -61,8 +61,20 @@ func InvokeMlchain(
 		"module":   "plugin_daemon",
 		"function": "InvokeMlchain",
 	}, func() {
-		dispatchMlchainInvocationTask(requestHandle)
 		defer requestHandle.EndResponse()
+
+		// the caller may be gone while the task is queued
+		if requestHandle.Cancelled() {
+			requestHandle.WriteError(fmt.Errorf("session cancelled"))
+			return
+		}
+
+		// nested calls are kept inside the budget of the session
+		if requestHandle.DeadlineExceeded() {
+			requestHandle.WriteError(fmt.Errorf("deadline of session exceeded"))
+			return
+		}
+		dispatchMlchainInvocationTask(requestHandle)
 	})
 
 	return nil
@@ -273,6 +285,9 @@ func dispatchMlchainInvocationTask(handle *BackwardsInvocation) {
 		return
 	}
 	requestData["user_id"] = userId
+	if deadline := handle.Deadline(); !deadline.IsZero() {
+		requestData["deadline"] = deadline.UnixMilli()
+	}
 	typ := handle.Type()
 	requestData["type"] = typ
 
@@ -295,6 +310,7 @@ func executeMlchainInvocationToolTask(
 		handle.WriteError(fmt.Errorf("invoke tool failed: %s", err.Error()))
 		return
 	}
+	defer handle.closeOnCancel(response)()
 
 	for response.Next() {
 		value, err := response.Read()
@@ -316,6 +332,7 @@ func executeMlchainInvocationLLMTask(
 		handle.WriteError(fmt.Errorf("invoke llm model failed: %s", err.Error()))
 		return
 	}
+	defer handle.closeOnCancel(response)()
 
 	for response.Next() {
 		value, err := response.Read()
@@ -363,6 +380,7 @@ func executeMlchainInvocationTTSTask(
 		handle.WriteError(fmt.Errorf("invoke tts model failed: %s", err.Error()))
 		return
 	}
+	defer handle.closeOnCancel(response)()
 
 	for response.Next() {
 		value, err := response.Read()
@@ -410,6 +428,7 @@ func executeMlchainInvocationAppTask(
 		handle.WriteError(fmt.Errorf("invoke app failed: %s", err.Error()))
 		return
 	}
+	defer handle.closeOnCancel(response)()
 
 	userId, err := handle.UserID()
 	if err != nil {
@@ -2,12 +2,14 @@ package backwards_invocation
 
 import (
 	"testing"
+	"time"
 
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/mlchain_invocation"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/mlchain_invocation/tester"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_daemon/access_types"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/session_manager"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/stream"
 )
 
 func getTestSession() *session_manager.Session {
@@ -168,3 +170,122 @@ func TestBackwardsInvocationAllDeniedPermission(t *testing.T) {
 		t.Errorf("checkPermission failed: expected error, got nil")
 	}
 }
+
+func TestBackwardsInvocationCancelled(t *testing.T) {
+	session := getTestSession()
+	request := NewBackwardsInvocation(mlchain_invocation.INVOKE_TYPE_LLM, "", session, nil, nil)
+
+	response := stream.NewStream[string](8)
+	stop := request.closeOnCancel(response)
+	defer stop()
+
+	if request.Cancelled() {
+		t.Fatal("invocation should not be cancelled before the session")
+	}
+
+	session.Cancel()
+	// cancelling twice is a no-op
+	session.Cancel()
+
+	if !request.Cancelled() {
+		t.Fatal("invocation should be cancelled with the session")
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for !response.IsClosed() {
+		if time.Now().After(deadline) {
+			t.Fatal("response should be closed once the session is cancelled")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// cancelRecorder counts the messages written to the plugin
+type cancelRecorder struct {
+	plugin_entities.PluginLifetime
+	accepts bool
+	writes  int
+}
+
+func (r *cancelRecorder) Write(session_id string, data []byte) {
+	r.writes++
+}
+
+func (r *cancelRecorder) AcceptsCancel(session_id string) bool {
+	return r.accepts
+}
+
+func TestSessionCancelEventNeedsSupport(t *testing.T) {
+	// an sdk which does not know the event is never sent one, the session is cancelled anyway
+	old := &cancelRecorder{}
+	session := getTestSession()
+	session.BindRuntime(old)
+	session.Cancel()
+	if old.writes != 0 {
+		t.Fatal("expected no cancel event for a plugin which does not handle it")
+	}
+	if !session.IsCancelled() {
+		t.Fatal("expected the session to be cancelled")
+	}
+
+	supported := &cancelRecorder{accepts: true}
+	session = getTestSession()
+	session.BindRuntime(supported)
+	session.Cancel()
+	if supported.writes != 1 {
+		t.Fatalf("expected a cancel event, got %d writes", supported.writes)
+	}
+}
+
+func TestSessionContext(t *testing.T) {
+	session := getTestSession()
+	ctx := session.Context()
+	if ctx.Err() != nil {
+		t.Fatal("expected the context of a session in flight to be alive")
+	}
+
+	session.Cancel()
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected the context to be done once the session is cancelled")
+	}
+
+	session = getTestSession()
+	session.Deadline = time.Now().Add(50 * time.Millisecond)
+	select {
+	case <-session.Context().Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected the context to be done once the deadline is exceeded")
+	}
+}
+
+func TestBackwardsInvocationDeadlineExceeded(t *testing.T) {
+	session := getTestSession()
+	session.Deadline = time.Now().Add(100 * time.Millisecond)
+	request := NewBackwardsInvocation(mlchain_invocation.INVOKE_TYPE_LLM, "", session, nil, nil)
+
+	response := stream.NewStream[string](8)
+	stop := request.closeOnCancel(response)
+	defer stop()
+
+	if request.DeadlineExceeded() {
+		t.Fatal("invocation should not exceed the deadline before it passes")
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for !response.IsClosed() {
+		if time.Now().After(deadline) {
+			t.Fatal("response should be closed once the deadline of the session is exceeded")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if !request.DeadlineExceeded() {
+		t.Fatal("invocation should exceed the deadline of the session")
+	}
+
+	if request.Cancelled() {
+		t.Fatal("exceeding the deadline should not cancel the session")
+	}
+}
@@ -112,10 +112,11 @@ func (h *AWSTransactionHandler) Handle(
 			}
 		},
 		func() {},
+		func() {},
 		func(err string) {
 			log.Warn("invoke mlchain failed, received errors: %s", err)
 		},
-		func(message string) {}, //log
+		func(session_id string, event plugin_entities.PluginLogEvent) {}, //log
 	)
 
 	select {
@@ -1 +1,39 @@
 package plugin_daemon
+
+import (
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/app"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/stream"
+)
+
+const (
+	RESPONSE_OVERFLOW_MODE_BLOCK = "block"
+	RESPONSE_OVERFLOW_MODE_SPILL = "spill"
+)
+
+var (
+	// how responses are buffered once the caller reads slower than the plugin writes
+	responseOverflowMode = RESPONSE_OVERFLOW_MODE_SPILL
+	responseSpillPath    string
+)
+
+func InitDaemon(config *app.Config) {
+	if config.PluginResponseOverflowMode != "" {
+		responseOverflowMode = config.PluginResponseOverflowMode
+	}
+	responseSpillPath = config.PluginResponseSpillPath
+
+	log.Info("Plugin daemon initialized, responses overflow in %s mode", responseOverflowMode)
+}
+
+// newResponseStream creates a stream of responses of a plugin, a full buffer never drops data,
+// the writer either waits for the caller, which holds the plugin back, or spills data to disk
+func newResponseStream[T any](size int) *stream.Stream[T] {
+	response := stream.NewStream[T](size)
+	if responseOverflowMode == RESPONSE_OVERFLOW_MODE_SPILL {
+		response.SetSpill(responseSpillPath)
+	} else {
+		response.SetBlocking()
+	}
+	return response
+}
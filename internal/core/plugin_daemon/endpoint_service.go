@@ -29,7 +29,7 @@ func InvokeEndpoint(
 
 	statusCode := http.StatusContinue
 	headers := &http.Header{}
-	response := stream.NewStream[[]byte](128)
+	response := newResponseStream[[]byte](128)
 	response.OnClose(func() {
 		// add close callback, ensure resources are released
 		resp.Close()
@@ -59,7 +59,7 @@ func InvokeEndpoint(
 				return http.StatusInternalServerError, nil, nil, err
 			}
 
-			response.Write(dehexed)
+			response.WriteContext(session.Context(), dehexed)
 			routine.Submit(map[string]string{
 				"module":   "plugin_daemon",
 				"function": "InvokeEndpoint",
@@ -77,7 +77,10 @@ func InvokeEndpoint(
 					if err != nil {
 						return
 					}
-					response.Write(dehexed)
+					if err := response.WriteContext(session.Context(), dehexed); err != nil {
+						response.WriteError(err)
+						return
+					}
 				}
 			})
 			break
@@ -3,6 +3,7 @@ package plugin_daemon
 import (
 	"errors"
 	"fmt"
+	"sync/atomic"
 
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_daemon/backwards_invocation"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_daemon/backwards_invocation/transaction"
@@ -22,13 +23,16 @@ func GenericInvokePlugin[Req any, Rsp any](
 		return nil, errors.New("plugin runtime not found")
 	}
 
-	response := stream.NewStream[Rsp](response_buffer_size)
+	response := newResponseStream[Rsp](response_buffer_size)
+
+	// set once the plugin ends the session by itself
+	finished := new(atomic.Bool)
 
 	listener := runtime.Listen(session.ID)
 	listener.Listen(func(chunk plugin_entities.SessionMessage) {
 		switch chunk.Type {
 		case plugin_entities.SESSION_MESSAGE_TYPE_STREAM:
-			chunk, err := parser.UnmarshalJsonBytes[Rsp](chunk.Data)
+			chunk, err := decodeStreamChunk[Rsp](chunk)
 			if err != nil {
 				response.WriteError(errors.New(parser.MarshalJson(map[string]string{
 					"error_type": "unmarshal_error",
@@ -36,8 +40,15 @@ func GenericInvokePlugin[Req any, Rsp any](
 				})))
 				response.Close()
 				return
-			} else {
-				response.Write(chunk)
+			}
+
+			if err := response.WriteContext(session.Context(), chunk); err != nil {
+				response.WriteError(errors.New(parser.MarshalJson(map[string]string{
+					"error_type": "response_overflow",
+					"message":    fmt.Sprintf("failed to buffer response: %s", err.Error()),
+				})))
+				response.Close()
+				return
 			}
 		case plugin_entities.SESSION_MESSAGE_TYPE_INVOKE:
 			// check if the request contains a aws_event_id
@@ -64,12 +75,14 @@ func GenericInvokePlugin[Req any, Rsp any](
 				return
 			}
 		case plugin_entities.SESSION_MESSAGE_TYPE_END:
+			finished.Store(true)
 			response.Close()
 		case plugin_entities.SESSION_MESSAGE_TYPE_ERROR:
 			e, err := parser.UnmarshalJsonBytes[plugin_entities.ErrorResponse](chunk.Data)
 			if err != nil {
 				break
 			}
+			finished.Store(true)
 			response.WriteError(errors.New(e.Error()))
 			response.Close()
 		default:
@@ -82,6 +95,11 @@ func GenericInvokePlugin[Req any, Rsp any](
 	})
 
 	response.OnClose(func() {
+		// the caller is gone before the plugin ends the session, cancel it
+		// while the session is still bound to its instance
+		if !finished.Load() {
+			session.Cancel()
+		}
 		listener.Close()
 	})
 
@@ -96,6 +114,29 @@ func GenericInvokePlugin[Req any, Rsp any](
 	return response, nil
 }
 
+// decodeStreamChunk unmarshals a stream chunk of the plugin, raw bytes sent along it are handed
+// to the chunk if it takes them, otherwise they are encoded into the json as base64
+func decodeStreamChunk[Rsp any](message plugin_entities.SessionMessage) (Rsp, error) {
+	if message.Blob == nil {
+		return parser.UnmarshalJsonBytes[Rsp](message.Data)
+	}
+
+	if _, ok := any(new(Rsp)).(plugin_entities.BlobReceiver); !ok {
+		data, err := message.Blob.EncodeInto(message.Data)
+		if err != nil {
+			var chunk Rsp
+			return chunk, err
+		}
+		return parser.UnmarshalJsonBytes[Rsp](data)
+	}
+
+	chunk, err := parser.UnmarshalJsonBytes[Rsp](message.Data)
+	if err != nil {
+		return chunk, err
+	}
+	return chunk, any(&chunk).(plugin_entities.BlobReceiver).SetBlob(message.Blob)
+}
+
 func getInvokePluginMap(
 	session *session_manager.Session,
 	request any,
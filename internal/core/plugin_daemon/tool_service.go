@@ -49,7 +49,11 @@ func InvokeTool(
 		}
 	}
 
-	newResponse := stream.NewStream[tool_entities.ToolResponseChunk](128)
+	newResponse := newResponseStream[tool_entities.ToolResponseChunk](128)
+	newResponse.OnClose(func() {
+		// the session is cancelled if the caller leaves before the plugin ends it
+		response.Close()
+	})
 	routine.Submit(map[string]string{
 		"module":        "plugin_daemon",
 		"function":      "InvokeTool",
@@ -80,7 +84,7 @@ func InvokeTool(
 				// convert total_length to int
 				totalLengthInt := int(totalLength)
 
-				blob, ok := item.Message["blob"].(string)
+				blob, ok := item.Message["blob"]
 				if !ok {
 					continue
 				}
@@ -95,7 +99,12 @@ func InvokeTool(
 				}
 
 				if end {
-					newResponse.Write(tool_entities.ToolResponseChunk{
+					// the last piece of a blob handed over in pieces carries bytes,
+					// the end chunk a plugin sends itself does not
+					if decoded, ok := blob.([]byte); ok {
+						files[id].Write(decoded)
+					}
+					newResponse.WriteContext(session.Context(), tool_entities.ToolResponseChunk{
 						Type: tool_entities.ToolResponseChunkTypeBlob,
 						Message: map[string]any{
 							"blob": files[id].Bytes(), // bytes will be encoded to base64 finally
@@ -103,19 +112,21 @@ func InvokeTool(
 						Meta: item.Meta,
 					})
 				} else {
-					if files[id].Len() > 15*1024*1024 {
+					// raw bytes come through the framed stdio protocol which sends blobs of any size,
+					// only chunks encoded as base64 by the plugin are bounded
+					_, raw := blob.([]byte)
+					if !raw && files[id].Len() > 15*1024*1024 {
 						// delete the file if it is too large
 						delete(files, id)
 						newResponse.WriteError(errors.New("file is too large"))
 						return
 					} else {
-						// decode the blob using base64
-						decoded, err := base64.StdEncoding.DecodeString(blob)
+						decoded, err := decodeBlobChunk(blob)
 						if err != nil {
 							newResponse.WriteError(err)
 							return
 						}
-						if len(decoded) > 8192 {
+						if !raw && len(decoded) > 8192 {
 							// single chunk is too large, raises error
 							newResponse.WriteError(errors.New("single file chunk is too large"))
 							return
@@ -123,8 +134,9 @@ func InvokeTool(
 						files[id].Write(decoded)
 					}
 				}
-			} else {
-				newResponse.Write(item)
+			} else if err := newResponse.WriteContext(session.Context(), item); err != nil {
+				newResponse.WriteError(err)
+				return
 			}
 		}
 	})
@@ -227,3 +239,16 @@ func GetToolRuntimeParameters(
 		1,
 	)
 }
+
+// decodeBlobChunk returns the bytes of a blob chunk, they are raw if the plugin sent them
+// through the framed stdio protocol, otherwise they are encoded as base64
+func decodeBlobChunk(blob any) ([]byte, error) {
+	switch blob := blob.(type) {
+	case []byte:
+		return blob, nil
+	case string:
+		return base64.StdEncoding.DecodeString(blob)
+	default:
+		return nil, errors.New("blob of the chunk is neither bytes nor a string")
+	}
+}
@@ -1,8 +1,10 @@
 package plugin_daemon
 
 import (
+	"bytes"
 	"testing"
 
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/tool_entities"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/stream"
 )
@@ -76,3 +78,68 @@ func TestToolInvokeJSONSchemaValidatorWithInvalidSchema(t *testing.T) {
 		t.Fatal("expected error, got nil")
 	}
 }
+
+func TestDecodeStreamChunkWithBlob(t *testing.T) {
+	message := plugin_entities.SessionMessage{
+		Type: plugin_entities.SESSION_MESSAGE_TYPE_STREAM,
+		Data: []byte(`{"type":"blob_chunk","message":{"id":"1","total_length":3,"end":false}}`),
+		Blob: &plugin_entities.SessionBlob{Path: []string{"message", "blob"}, Data: []byte{0x00, '\n', 0xff}},
+	}
+
+	chunk, err := decodeStreamChunk[tool_entities.ToolResponseChunk](message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blob, ok := chunk.Message["blob"].([]byte); !ok || !bytes.Equal(blob, []byte{0x00, '\n', 0xff}) {
+		t.Fatalf("expected the raw bytes to be handed to the chunk, got %v", chunk.Message["blob"])
+	}
+
+	// responses which take no raw bytes get them as base64 like a plugin would send
+	type audio struct {
+		Message struct {
+			Blob []byte `json:"blob"`
+		} `json:"message"`
+	}
+	decoded, err := decodeStreamChunk[audio](message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decoded.Message.Blob, []byte{0x00, '\n', 0xff}) {
+		t.Fatalf("expected the bytes to be decoded from base64, got %v", decoded.Message.Blob)
+	}
+}
+
+func TestDecodeStreamChunkWithBlobPieces(t *testing.T) {
+	pieces := [][]byte{{0x00, '\n'}, {0xff}}
+	offset := uint64(0)
+	for sequence, piece := range pieces {
+		chunk, err := decodeStreamChunk[tool_entities.ToolResponseChunk](plugin_entities.SessionMessage{
+			Type: plugin_entities.SESSION_MESSAGE_TYPE_STREAM,
+			Data: []byte(`{"type":"blob","message":{},"meta":{"mime_type":"image/png"}}`),
+			Blob: &plugin_entities.SessionBlob{
+				Path:     []string{"message", "blob"},
+				Data:     piece,
+				ID:       "1",
+				Sequence: sequence,
+				Offset:   offset,
+				Total:    3,
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		offset += uint64(len(piece))
+
+		// pieces of a blob are merged like blob chunks of the plugin
+		if chunk.Type != tool_entities.ToolResponseChunkTypeBlobChunk || chunk.Message["id"] != "1" ||
+			chunk.Message["total_length"] != float64(3) || chunk.Message["end"] != (sequence == len(pieces)-1) {
+			t.Fatalf("expected piece %d to be a blob chunk, got %+v", sequence, chunk)
+		}
+		if blob, ok := chunk.Message["blob"].([]byte); !ok || !bytes.Equal(blob, piece) {
+			t.Fatalf("expected the raw piece in the chunk, got %v", chunk.Message["blob"])
+		}
+		if chunk.Meta["mime_type"] != "image/png" {
+			t.Fatalf("expected the meta of the blob to be kept, got %v", chunk.Meta)
+		}
+	}
+}
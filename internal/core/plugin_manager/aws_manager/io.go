@@ -117,6 +117,7 @@ func (r *AWSPluginRuntime) Write(session_id string, data []byte) {
 					l.Send(sessionMessage)
 				},
 				func() {},
+				func() {},
 				func(err string) {
 					l.Send(plugin_entities.SessionMessage{
 						Type: plugin_entities.SESSION_MESSAGE_TYPE_ERROR,
@@ -126,7 +127,7 @@ func (r *AWSPluginRuntime) Write(session_id string, data []byte) {
 						}),
 					})
 				},
-				func(message string) {},
+				func(session_id string, event plugin_entities.PluginLogEvent) {},
 			)
 		}
 
@@ -0,0 +1,77 @@
+package plugin_manager
+
+import (
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/cache"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+)
+
+const (
+	// reset requests are broadcast, every node runs its own copy of the plugin
+	PLUGIN_CRASH_LOOP_RESET_CHANNEL = "plugin_crash_loop_reset"
+)
+
+type crashLoopResetEvent struct {
+	Identity string `json:"identity"`
+}
+
+// waitCrashLoopReset blocks until the crash loop status of the plugin is reset,
+// returns false if the plugin has been stopped meanwhile
+func (p *PluginManager) waitCrashLoopReset(r plugin_entities.PluginFullDuplexLifetime) bool {
+	identity, err := r.Identity()
+	if err != nil {
+		return false
+	}
+
+	reset := make(chan bool, 1)
+	p.crashLoopResets.Store(identity.String(), reset)
+	defer p.crashLoopResets.Delete(identity.String())
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-reset:
+			log.Info("crash loop of plugin %s has been reset, restarting", identity.String())
+			return true
+		case <-ticker.C:
+			if r.Stopped() {
+				return false
+			}
+		}
+	}
+}
+
+// ResetCrashLoop restarts the plugin in crash loop on every node
+func (p *PluginManager) ResetCrashLoop(identity plugin_entities.PluginUniqueIdentifier) error {
+	return cache.Publish(PLUGIN_CRASH_LOOP_RESET_CHANNEL, crashLoopResetEvent{
+		Identity: identity.String(),
+	})
+}
+
+func (p *PluginManager) resetCrashLoop(identity string) {
+	if reset, ok := p.crashLoopResets.Load(identity); ok {
+		select {
+		case reset <- true:
+		default:
+		}
+	}
+}
+
+func (p *PluginManager) startCrashLoopResetListener() {
+	go func() {
+		for {
+			events, cancel := cache.Subscribe[crashLoopResetEvent](PLUGIN_CRASH_LOOP_RESET_CHANNEL)
+			for event := range events {
+				p.resetCrashLoop(event.Identity)
+			}
+			cancel()
+
+			// subscription is lost, try again later
+			time.Sleep(5 * time.Second)
+		}
+	}()
+}
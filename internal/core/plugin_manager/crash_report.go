@@ -0,0 +1,73 @@
+package plugin_manager
+
+import (
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/local_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/db"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/models"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+)
+
+const (
+	// crash records kept for each plugin, older ones are removed
+	MAX_PLUGIN_CRASHES = 100
+)
+
+// recordCrash stores the crash report of a local plugin
+func (p *PluginManager) recordCrash(report local_manager.CrashReport) {
+	identity, err := plugin_entities.NewPluginUniqueIdentifier(report.PluginUniqueIdentifier)
+	if err != nil {
+		log.Error("invalid identity of crashed plugin %s: %s", report.PluginUniqueIdentifier, err.Error())
+		return
+	}
+
+	crash := models.PluginCrash{
+		PluginUniqueIdentifier: identity.String(),
+		PluginID:               identity.PluginID(),
+		InstanceID:             report.InstanceID,
+		Pid:                    report.Pid,
+		ExitCode:               report.ExitCode,
+		Signal:                 report.Signal,
+		Reason:                 report.Reason,
+		Stderr:                 report.Stderr,
+		Uptime:                 report.Uptime.Seconds(),
+		Restarts:               report.Restarts,
+		Sessions:               report.Sessions,
+		SessionTenants:         report.SessionTenants,
+	}
+	crash.CreatedAt = report.CrashedAt
+
+	if err := db.Create(&crash); err != nil {
+		log.Error("failed to record crash of plugin %s: %s", identity.String(), err.Error())
+		return
+	}
+
+	// remove the oldest records beyond the limit
+	outdated, err := db.GetAll[models.PluginCrash](
+		db.Equal("plugin_unique_identifier", identity.String()),
+		db.OrderBy("created_at", true),
+		db.Page(2, MAX_PLUGIN_CRASHES),
+	)
+	if err != nil {
+		log.Error("failed to list crashes of plugin %s: %s", identity.String(), err.Error())
+		return
+	}
+
+	for _, crash := range outdated {
+		if err := db.Delete(&crash); err != nil {
+			log.Error("failed to remove crash of plugin %s: %s", identity.String(), err.Error())
+		}
+	}
+}
+
+// ListCrashes returns the latest crashes of the plugin across the cluster, newest first
+func (p *PluginManager) ListCrashes(
+	identity plugin_entities.PluginUniqueIdentifier,
+	limit int,
+) ([]models.PluginCrash, error) {
+	return db.GetAll[models.PluginCrash](
+		db.Equal("plugin_unique_identifier", identity.String()),
+		db.OrderBy("created_at", true),
+		db.Page(1, limit),
+	)
+}
@@ -0,0 +1,412 @@
+package plugin_manager
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/basic_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/local_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/log_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/positive_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_packager/decoder"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/db"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/service/install_service"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/app"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/models"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/models/curd"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/parser"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/routine"
+)
+
+const (
+	// source of installations created by the development mode
+	PLUGIN_DEV_INSTALLATION_SOURCE = "dev"
+)
+
+// devPlugin is a plugin run right from its source directory
+type devPlugin struct {
+	root     string
+	checksum string
+	runtime  *local_manager.LocalPluginRuntime
+}
+
+type devFileStamp struct {
+	size    int64
+	modTime time.Time
+}
+
+// startDevWatcher runs plugins in the source directories and reloads them once their source changes
+func (p *PluginManager) startDevWatcher(config *app.Config) {
+	interval := time.Duration(config.PluginDevPollInterval) * time.Millisecond
+	for _, root := range config.PluginDevPaths {
+		root := strings.TrimSpace(root)
+		if root == "" {
+			continue
+		}
+
+		log.Info("start to watch plugin source in path: %s", root)
+		go p.watchDevPlugin(root, config.PluginDevTenantID, interval)
+	}
+}
+
+func (p *PluginManager) watchDevPlugin(root string, tenantId string, interval time.Duration) {
+	var current *devPlugin
+	var snapshot map[string]devFileStamp
+	var synced map[string]bool
+
+	// the copy left by a previous run may hold files removed from the source since
+	workingPath := p.devWorkingPath(root)
+	if err := os.RemoveAll(workingPath); err != nil {
+		log.Error("failed to clean working path %s of plugin source %s: %s", workingPath, root, err.Error())
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for ; ; <-ticker.C {
+		next, err := scanDevSource(root)
+		if err != nil {
+			// the manifest may be saved halfway, try again later
+			if snapshot != nil {
+				log.Error("failed to scan plugin source %s: %s", root, err.Error())
+				snapshot = nil
+			}
+			continue
+		}
+
+		if snapshot != nil && sameDevSource(snapshot, next) {
+			continue
+		}
+		snapshot = next
+
+		synced, err = syncDevSource(root, workingPath, synced)
+		if err != nil {
+			log.Error("failed to copy plugin source %s: %s", root, err.Error())
+			snapshot = nil
+			continue
+		}
+
+		reloaded, err := p.reloadDevPlugin(root, workingPath, tenantId, current)
+		if err != nil {
+			log.Error("failed to reload plugin source %s: %s", root, err.Error())
+			continue
+		}
+		current = reloaded
+	}
+}
+
+// scanDevSource returns the python and yaml files of the plugin which are not ignored by .mlchainignore
+func scanDevSource(root string) (map[string]devFileStamp, error) {
+	sourceDecoder, err := decoder.NewFSPluginDecoder(root)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]devFileStamp{}
+	err = sourceDecoder.Walk(func(filename string, dir string) error {
+		// the virtual environment is created by the daemon in the working directory
+		if dir == ".venv" || strings.HasPrefix(dir, ".venv/") {
+			return nil
+		}
+
+		switch path.Ext(filename) {
+		case ".py", ".yaml", ".yml":
+		default:
+			return nil
+		}
+
+		name := path.Join(dir, filename)
+		info, err := sourceDecoder.Stat(name)
+		if err != nil {
+			// removed while walking
+			return nil
+		}
+
+		files[name] = devFileStamp{
+			size:    info.Size(),
+			modTime: info.ModTime(),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// devWorkingPath is where the plugin of the source directory is built and run, so that the virtual
+// environment and build outputs never end up in the source directory of the developer
+func (p *PluginManager) devWorkingPath(root string) string {
+	hash := sha256.Sum256([]byte(root))
+	return path.Join(p.workingDirectory, "dev", hex.EncodeToString(hash[:8]))
+}
+
+// syncDevSource copies the files of the plugin which are not ignored by .mlchainignore to the working path,
+// files copied before and removed from the source since are removed, anything else in the working path,
+// like the virtual environment, is kept
+func syncDevSource(root string, workingPath string, previous map[string]bool) (map[string]bool, error) {
+	sourceDecoder, err := decoder.NewFSPluginDecoder(root)
+	if err != nil {
+		return previous, err
+	}
+
+	files := map[string]bool{}
+	err = sourceDecoder.Walk(func(filename string, dir string) error {
+		if dir == ".venv" || strings.HasPrefix(dir, ".venv/") {
+			return nil
+		}
+
+		name := path.Join(dir, filename)
+		info, err := sourceDecoder.Stat(name)
+		if err != nil {
+			// removed while walking
+			return nil
+		}
+		content, err := sourceDecoder.ReadFile(name)
+		if err != nil {
+			return nil
+		}
+
+		if err := os.MkdirAll(path.Join(workingPath, dir), 0755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path.Join(workingPath, name), content, info.Mode().Perm()); err != nil {
+			return err
+		}
+		files[name] = true
+		return nil
+	})
+	if err != nil {
+		return previous, err
+	}
+
+	for name := range previous {
+		if !files[name] {
+			os.Remove(path.Join(workingPath, name))
+		}
+	}
+
+	return files, nil
+}
+
+func sameDevSource(a map[string]devFileStamp, b map[string]devFileStamp) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for name, stamp := range a {
+		if other, ok := b[name]; !ok || other != stamp {
+			return false
+		}
+	}
+
+	return true
+}
+
+// devChecksum identifies a declaration of the plugin, the plugin is registered again once it changes
+func devChecksum(declaration plugin_entities.PluginDeclaration, tenantId string) string {
+	hash := sha256.New()
+	hash.Write(parser.MarshalJsonBytes(declaration))
+	hash.Write([]byte(tenantId))
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// reloadDevPlugin restarts the processes of the plugin if its declaration is unchanged,
+// otherwise the plugin is launched and registered again with the new declaration
+func (p *PluginManager) reloadDevPlugin(
+	root string, workingPath string, tenantId string, current *devPlugin,
+) (*devPlugin, error) {
+	sourceDecoder, err := decoder.NewFSPluginDecoder(workingPath)
+	if err != nil {
+		return current, err
+	}
+
+	declaration, err := sourceDecoder.Manifest()
+	if err != nil {
+		return current, err
+	}
+
+	checksum := devChecksum(declaration, tenantId)
+	if current != nil && current.checksum == checksum && !current.runtime.Stopped() {
+		if current.runtime.RuntimeState().Status == plugin_entities.PLUGIN_RUNTIME_STATUS_CRASH_LOOP {
+			// the change is likely the fix
+			identity, err := current.runtime.Identity()
+			if err == nil {
+				p.resetCrashLoop(identity.String())
+			}
+			return current, nil
+		}
+
+		log.Info("source of plugin %s changed, restarting", root)
+		current.runtime.RollingRestart()
+		return current, nil
+	}
+
+	if current != nil {
+		log.Info("declaration of plugin %s changed, launching it again", root)
+		current.runtime.Stop()
+	}
+
+	runtime, err := p.launchDev(root, workingPath, tenantId, sourceDecoder, declaration, checksum)
+	if err != nil {
+		return nil, err
+	}
+
+	return &devPlugin{
+		root:     root,
+		checksum: checksum,
+		runtime:  runtime,
+	}, nil
+}
+
+// launchDev launches the plugin from the copy of its source directory and installs it to the tenant,
+// like remote debugging, the plugin is owned by the tenant
+func (p *PluginManager) launchDev(
+	root string,
+	workingPath string,
+	tenantId string,
+	sourceDecoder *decoder.FSPluginDecoder,
+	declaration plugin_entities.PluginDeclaration,
+	checksum string,
+) (*local_manager.LocalPluginRuntime, error) {
+	declaration.Author = tenantId
+
+	runtime := local_manager.NewLocalPluginRuntime(
+		p.pythonInterpreterPath,
+		p.goCompilerPath,
+		p.nodeExecutablePath,
+		p.localPluginPoolConfig,
+		p.localPluginCgroupConfig,
+		p.localPluginSandboxConfig,
+		p.localPluginPythonDependencyConfig,
+		p.localPluginHealthConfig,
+	)
+	runtime.PluginRuntime = plugin_entities.PluginRuntime{
+		Config: declaration,
+		State: plugin_entities.PluginRuntimeState{
+			Status:      plugin_entities.PLUGIN_RUNTIME_STATUS_PENDING,
+			WorkingPath: workingPath,
+		},
+	}
+	runtime.PositivePluginRuntime = positive_manager.PositivePluginRuntime{
+		BasicPluginRuntime: basic_manager.NewBasicPluginRuntime(p.mediaBucket),
+		WorkingPath:        workingPath,
+		Decoder:            sourceDecoder,
+		InnerChecksum:      checksum,
+	}
+	runtime.SetFromSource()
+
+	identity, err := runtime.Identity()
+	if err != nil {
+		return nil, err
+	}
+	runtime.SetLogStore(p.logManager.Store(identity.String()))
+	runtime.SetCrashHandler(p.recordCrash)
+	runtime.SetStdioConfig(p.localPluginStdioConfig)
+
+	assets, err := sourceDecoder.Assets()
+	if err != nil {
+		return nil, err
+	}
+	if err := runtime.RemapAssets(&runtime.Config, assets); err != nil {
+		return nil, errors.Join(err, fmt.Errorf("remap plugin assets error"))
+	}
+
+	environment, err := p.loadEnvironment(identity.PluginID())
+	if err != nil {
+		return nil, errors.Join(err, fmt.Errorf("load plugin environment error"))
+	}
+	runtime.SetEnvironment(environment)
+
+	if err := p.installDevPlugin(runtime, tenantId); err != nil {
+		return nil, errors.Join(err, fmt.Errorf("install plugin error"))
+	}
+
+	p.m.Store(identity.String(), runtime)
+	p.lifecycle(runtime, log_manager.LOG_LEVEL_INFO, "launched from source %s", root)
+
+	routine.Submit(map[string]string{
+		"module":   "plugin_manager",
+		"function": "LaunchDev",
+	}, func() {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Error("plugin runtime panic: %v", r)
+			}
+			// the declaration may have been changed back, a new runtime has the same identity then
+			p.m.CompareAndDelete(identity.String(), runtime)
+		}()
+
+		p.fullDuplexLifecycle(runtime, nil, nil)
+	})
+
+	return runtime, nil
+}
+
+// installDevPlugin installs the plugin to the tenant, replacing the installation of a previous declaration
+func (p *PluginManager) installDevPlugin(runtime *local_manager.LocalPluginRuntime, tenantId string) error {
+	identity, err := runtime.Identity()
+	if err != nil {
+		return err
+	}
+
+	installation, err := db.GetOne[models.PluginInstallation](
+		db.Equal("plugin_id", identity.PluginID()),
+		db.Equal("tenant_id", tenantId),
+	)
+	if err == nil {
+		if installation.PluginUniqueIdentifier == identity.String() {
+			return nil
+		}
+
+		// endpoints of the tenant are kept, the plugin is replaced rather than removed
+		response, err := curd.UninstallPlugin(
+			tenantId,
+			plugin_entities.PluginUniqueIdentifier(installation.PluginUniqueIdentifier),
+			installation.ID,
+		)
+		if err != nil {
+			return err
+		}
+
+		// every change of the declaration creates a new identity, the declaration of the old one is never used again
+		if response.IsPluginDeleted {
+			if err := db.DeleteByCondition(models.PluginDeclaration{
+				PluginUniqueIdentifier: installation.PluginUniqueIdentifier,
+			}); err != nil {
+				return err
+			}
+		}
+	} else if err != db.ErrDatabaseNotFound {
+		return err
+	}
+
+	if _, err := db.GetOne[models.PluginDeclaration](
+		db.Equal("plugin_unique_identifier", identity.String()),
+	); err == db.ErrDatabaseNotFound {
+		if err := db.Create(&models.PluginDeclaration{
+			PluginUniqueIdentifier: identity.String(),
+			PluginID:               identity.PluginID(),
+			Declaration:            runtime.Config,
+		}); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+
+	_, _, err = install_service.InstallPlugin(
+		tenantId, "", runtime, PLUGIN_DEV_INSTALLATION_SOURCE, map[string]any{},
+	)
+	return err
+}
@@ -0,0 +1,165 @@
+package plugin_manager
+
+import (
+	"os"
+	"path"
+	"testing"
+)
+
+func copyDevSource(t *testing.T, src string, dst string) {
+	entries, err := os.ReadDir(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			if err := os.MkdirAll(path.Join(dst, entry.Name()), 0755); err != nil {
+				t.Fatal(err)
+			}
+			copyDevSource(t, path.Join(src, entry.Name()), path.Join(dst, entry.Name()))
+			continue
+		}
+
+		content, err := os.ReadFile(path.Join(src, entry.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path.Join(dst, entry.Name()), content, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestScanDevSource(t *testing.T) {
+	root := t.TempDir()
+	copyDevSource(t, "serverless/packager_test_plugin", root)
+
+	files := map[string]string{
+		".mlchainignore":         "ignored/\n",
+		"ignored/tool.py":        "",
+		".venv/lib/site.py":      "",
+		"tools/tool.py":          "",
+		"tools/notes.txt":        "",
+		"requirements.txt":       "",
+		"provider/jina.yaml.bak": "",
+	}
+	for name, content := range files {
+		if err := os.MkdirAll(path.Dir(path.Join(root, name)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path.Join(root, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	snapshot, err := scanDevSource(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"main.py", "manifest.yaml", "provider/jina.yaml", "tools/tool.py"}
+	if len(snapshot) != len(expected) {
+		t.Fatalf("unexpected files: %v", snapshot)
+	}
+	for _, name := range expected {
+		if _, ok := snapshot[name]; !ok {
+			t.Fatalf("%s is not watched", name)
+		}
+	}
+
+	// unchanged source
+	again, err := scanDevSource(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sameDevSource(snapshot, again) {
+		t.Fatal("source should be unchanged")
+	}
+
+	// ignored files are not watched
+	if err := os.WriteFile(path.Join(root, "ignored/tool.py"), []byte("print(1)"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	again, err = scanDevSource(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sameDevSource(snapshot, again) {
+		t.Fatal("ignored files should not change the source")
+	}
+
+	if err := os.WriteFile(path.Join(root, "main.py"), []byte("print('changed')"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	again, err = scanDevSource(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sameDevSource(snapshot, again) {
+		t.Fatal("source should be changed")
+	}
+}
+
+func TestSyncDevSource(t *testing.T) {
+	root := t.TempDir()
+	workingPath := t.TempDir()
+	copyDevSource(t, "serverless/packager_test_plugin", root)
+
+	files := map[string]string{
+		".mlchainignore":    "ignored/\n",
+		"ignored/tool.py":   "",
+		".venv/lib/site.py": "",
+		"tools/tool.py":     "",
+	}
+	for name, content := range files {
+		if err := os.MkdirAll(path.Dir(path.Join(root, name)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path.Join(root, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	synced, err := syncDevSource(root, workingPath, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"main.py", "manifest.yaml", "tools/tool.py"} {
+		if !synced[name] {
+			t.Fatalf("%s is not copied", name)
+		}
+		if _, err := os.Stat(path.Join(workingPath, name)); err != nil {
+			t.Fatalf("%s is not copied: %s", name, err)
+		}
+	}
+	for _, name := range []string{"ignored/tool.py", ".venv/lib/site.py"} {
+		if _, err := os.Stat(path.Join(workingPath, name)); err == nil {
+			t.Fatalf("%s should not be copied", name)
+		}
+	}
+
+	// the virtual environment built in the working path is kept, files removed from the source are not
+	if err := os.MkdirAll(path.Join(workingPath, ".venv"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path.Join(workingPath, ".venv/pyvenv.cfg"), []byte(""), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(path.Join(root, "tools/tool.py")); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := syncDevSource(root, workingPath, synced); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path.Join(workingPath, "tools/tool.py")); err == nil {
+		t.Fatal("files removed from the source should be removed")
+	}
+	if _, err := os.Stat(path.Join(workingPath, ".venv/pyvenv.cfg")); err != nil {
+		t.Fatal("the virtual environment should be kept")
+	}
+	if _, err := os.Stat(path.Join(root, ".venv/pyvenv.cfg")); err == nil {
+		t.Fatal("nothing should be written to the source")
+	}
+}
@@ -0,0 +1,292 @@
+package plugin_manager
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/local_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/db"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/models"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/cache"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/encryption"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+)
+
+const (
+	// changes are broadcast, every node restarts its own copy of the plugin on a change of a plugin-wide
+	// variable, and drops the cached variables of the tenant on a change of a tenant-scoped one
+	PLUGIN_ENVIRONMENT_CHANGED_CHANNEL = "plugin_environment_changed"
+)
+
+var ErrEnvironmentNotFound = errors.New("environment variable not found")
+
+type environmentChangedEvent struct {
+	PluginID string `json:"plugin_id"`
+	// empty for a plugin-wide variable
+	TenantID string `json:"tenant_id"`
+}
+
+// EnvironmentVariable is an environment variable of a plugin with its value masked
+type EnvironmentVariable struct {
+	PluginID  string    `json:"plugin_id"`
+	TenantID  string    `json:"tenant_id"`
+	Key       string    `json:"key"`
+	Value     string    `json:"value"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func environmentSecretKey(secret string) []byte {
+	key := sha256.Sum256([]byte(secret))
+	return key[:]
+}
+
+func (p *PluginManager) encryptEnvironmentValue(value string) (string, error) {
+	encrypted, err := encryption.AESEncrypt(p.environmentSecretKey, []byte(value))
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+func (p *PluginManager) decryptEnvironmentValue(value string) (string, error) {
+	encrypted, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", err
+	}
+
+	decrypted, err := encryption.AESDecrypt(p.environmentSecretKey, encrypted)
+	if err != nil {
+		return "", err
+	}
+	return string(decrypted), nil
+}
+
+// SandboxEnabled returns true if local plugins run in the sandbox, which sets the proxy variables
+func (p *PluginManager) SandboxEnabled() bool {
+	return p.localPluginSandboxConfig.Enabled
+}
+
+// ListEnvironment returns environment variables of the plugin with their values masked
+func (p *PluginManager) ListEnvironment(pluginId string) ([]EnvironmentVariable, error) {
+	environments, err := db.GetAll[models.PluginEnvironment](
+		db.Equal("plugin_id", pluginId),
+		db.OrderBy("tenant_id", false),
+		db.OrderBy("key", false),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	variables := []EnvironmentVariable{}
+	for _, environment := range environments {
+		value, err := p.decryptEnvironmentValue(environment.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt %s: %s", environment.Key, err.Error())
+		}
+
+		variables = append(variables, EnvironmentVariable{
+			PluginID:  environment.PluginID,
+			TenantID:  environment.TenantID,
+			Key:       environment.Key,
+			Value:     encryption.MaskSecret(value),
+			UpdatedAt: environment.UpdatedAt,
+		})
+	}
+
+	return variables, nil
+}
+
+// SetEnvironment creates or updates an environment variable of the plugin, the plugin is restarted
+// on every node for a plugin-wide variable, a tenant-scoped one is sent along the next request of the tenant
+func (p *PluginManager) SetEnvironment(pluginId string, tenantId string, key string, value string) error {
+	encrypted, err := p.encryptEnvironmentValue(value)
+	if err != nil {
+		return err
+	}
+
+	environment, err := db.GetOne[models.PluginEnvironment](
+		db.Equal("plugin_id", pluginId),
+		db.Equal("tenant_id", tenantId),
+		db.Equal("key", key),
+	)
+	if err == db.ErrDatabaseNotFound {
+		err = db.Create(&models.PluginEnvironment{
+			PluginID: pluginId,
+			TenantID: tenantId,
+			Key:      key,
+			Value:    encrypted,
+		})
+	} else if err == nil {
+		environment.Value = encrypted
+		err = db.Update(&environment)
+	}
+	if err != nil {
+		return err
+	}
+
+	return p.publishEnvironmentChanged(pluginId, tenantId)
+}
+
+// DeleteEnvironment removes an environment variable of the plugin, the plugin is restarted
+// on every node for a plugin-wide variable, a tenant-scoped one is no longer sent along requests of the tenant
+func (p *PluginManager) DeleteEnvironment(pluginId string, tenantId string, key string) error {
+	environment, err := db.GetOne[models.PluginEnvironment](
+		db.Equal("plugin_id", pluginId),
+		db.Equal("tenant_id", tenantId),
+		db.Equal("key", key),
+	)
+	if err == db.ErrDatabaseNotFound {
+		return ErrEnvironmentNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := db.Delete(&environment); err != nil {
+		return err
+	}
+
+	return p.publishEnvironmentChanged(pluginId, tenantId)
+}
+
+func (p *PluginManager) publishEnvironmentChanged(pluginId string, tenantId string) error {
+	return cache.Publish(PLUGIN_ENVIRONMENT_CHANGED_CHANNEL, environmentChangedEvent{
+		PluginID: pluginId,
+		TenantID: tenantId,
+	})
+}
+
+// loadEnvironment returns the decrypted environment of the plugin shared by every tenant as `KEY=VALUE` pairs,
+// a process serves every tenant, so tenant-scoped variables are never put into it
+func (p *PluginManager) loadEnvironment(pluginId string) ([]string, error) {
+	environments, err := db.GetAll[models.PluginEnvironment](
+		db.Equal("plugin_id", pluginId),
+		db.Equal("tenant_id", ""),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []string{}
+	for _, environment := range environments {
+		value, err := p.decryptEnvironmentValue(environment.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt %s: %s", environment.Key, err.Error())
+		}
+		result = append(result, environment.Key+"="+value)
+	}
+	sort.Strings(result)
+
+	return result, nil
+}
+
+// TenantEnvironment returns the decrypted variables of the plugin scoped to the tenant, they are sent
+// along every request of a session of the tenant rather than put into the environment of the process,
+// so that tenants sharing a process never see each other's variables, only local plugins get them
+func (p *PluginManager) TenantEnvironment(
+	runtime plugin_entities.PluginLifetime, tenantId string,
+) (map[string]string, error) {
+	if tenantId == "" || runtime.Type() != plugin_entities.PLUGIN_RUNTIME_TYPE_LOCAL {
+		return nil, nil
+	}
+
+	identity, err := runtime.Identity()
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := identity.PluginID() + ":" + tenantId
+	if environment, ok := p.tenantEnvironments.Load(cacheKey); ok {
+		return environment, nil
+	}
+
+	environments, err := db.GetAll[models.PluginEnvironment](
+		db.Equal("plugin_id", identity.PluginID()),
+		db.Equal("tenant_id", tenantId),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+	for _, environment := range environments {
+		value, err := p.decryptEnvironmentValue(environment.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt %s: %s", environment.Key, err.Error())
+		}
+		result[environment.Key] = value
+	}
+
+	p.tenantEnvironments.Store(cacheKey, result)
+	return result, nil
+}
+
+// forgetTenantEnvironments drops the cached tenant-scoped variables of the plugin
+func (p *PluginManager) forgetTenantEnvironments(pluginId string) {
+	p.tenantEnvironments.Range(func(key string, _ map[string]string) bool {
+		if strings.HasPrefix(key, pluginId+":") {
+			p.tenantEnvironments.Delete(key)
+		}
+		return true
+	})
+}
+
+// applyEnvironmentChanged picks up a changed variable on this node, processes never get tenant-scoped
+// variables, so only a change of a plugin-wide one replaces them
+func (p *PluginManager) applyEnvironmentChanged(event environmentChangedEvent) {
+	if event.TenantID != "" {
+		p.tenantEnvironments.Delete(event.PluginID + ":" + event.TenantID)
+		return
+	}
+
+	p.restartWithEnvironment(event.PluginID)
+}
+
+// restartWithEnvironment reloads the environment of local runtimes of the plugin on this node
+// and replaces their processes
+func (p *PluginManager) restartWithEnvironment(pluginId string) {
+	p.forgetTenantEnvironments(pluginId)
+
+	p.m.Range(func(key string, value plugin_entities.PluginLifetime) bool {
+		runtime, ok := value.(*local_manager.LocalPluginRuntime)
+		if !ok {
+			return true
+		}
+
+		identity, err := plugin_entities.NewPluginUniqueIdentifier(key)
+		if err != nil || identity.PluginID() != pluginId {
+			return true
+		}
+
+		environment, err := p.loadEnvironment(pluginId)
+		if err != nil {
+			log.Error("failed to load environment of plugin %s: %s", key, err.Error())
+			return true
+		}
+
+		runtime.SetEnvironment(environment)
+		runtime.RollingRestart()
+		return true
+	})
+}
+
+func (p *PluginManager) startEnvironmentChangedListener() {
+	go func() {
+		for {
+			events, cancel := cache.Subscribe[environmentChangedEvent](PLUGIN_ENVIRONMENT_CHANGED_CHANNEL)
+			for event := range events {
+				p.applyEnvironmentChanged(event)
+			}
+			cancel()
+
+			// subscription is lost, try again later
+			time.Sleep(5 * time.Second)
+		}
+	}()
+}
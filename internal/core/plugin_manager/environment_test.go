@@ -0,0 +1,104 @@
+package plugin_manager
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/session_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/parser"
+)
+
+func TestEnvironmentValueEncryption(t *testing.T) {
+	p := &PluginManager{environmentSecretKey: environmentSecretKey("secret")}
+
+	encrypted, err := p.encryptEnvironmentValue("http://proxy:3128")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if encrypted == "http://proxy:3128" {
+		t.Fatal("expected the value to be encrypted")
+	}
+
+	decrypted, err := p.decryptEnvironmentValue(encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decrypted != "http://proxy:3128" {
+		t.Fatalf("expected the original value, got %s", decrypted)
+	}
+
+	other := &PluginManager{environmentSecretKey: environmentSecretKey("another secret")}
+	if _, err := other.decryptEnvironmentValue(encrypted); err == nil {
+		t.Fatal("expected decryption with another secret to fail")
+	}
+}
+
+func TestTenantEnvironmentIsScopedToSessions(t *testing.T) {
+	p := &PluginManager{}
+	runtime := &fakePlugin{}
+	identity, _ := runtime.Identity()
+
+	p.tenantEnvironments.Store(identity.PluginID()+":tenant-a", map[string]string{"API_KEY": "secret-a"})
+	p.tenantEnvironments.Store("another/plugin:tenant-a", map[string]string{"API_KEY": "secret-b"})
+
+	environment, err := p.TenantEnvironment(runtime, "tenant-a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	session := session_manager.NewSession(session_manager.NewSessionPayload{
+		TenantID:    "tenant-a",
+		IgnoreCache: true,
+		Environment: environment,
+	})
+	defer session.Close(session_manager.CloseSessionPayload{IgnoreCache: true})
+
+	request := string(session.Message(session_manager.PLUGIN_IN_STREAM_EVENT_REQUEST, map[string]any{}))
+	if !strings.Contains(request, `"environment":{"API_KEY":"secret-a"}`) {
+		t.Fatalf("expected the request to carry the variables of the tenant, got %s", request)
+	}
+
+	cancel := string(session.Message(session_manager.PLUGIN_IN_STREAM_EVENT_CANCEL, map[string]any{}))
+	if strings.Contains(cancel, "secret-a") {
+		t.Fatalf("expected only the request to carry the variables, got %s", cancel)
+	}
+
+	// sessions are cached in redis, the variables must never be
+	if strings.Contains(parser.MarshalJson(session), "secret-a") {
+		t.Fatal("expected the variables to be kept out of the cached session")
+	}
+
+	// sessions without a tenant get nothing
+	if environment, _ := p.TenantEnvironment(runtime, ""); environment != nil {
+		t.Fatal("expected no variables without a tenant")
+	}
+
+	p.forgetTenantEnvironments(identity.PluginID())
+	if _, ok := p.tenantEnvironments.Load(identity.PluginID() + ":tenant-a"); ok {
+		t.Fatal("expected the variables of the plugin to be forgotten")
+	}
+	if _, ok := p.tenantEnvironments.Load("another/plugin:tenant-a"); !ok {
+		t.Fatal("expected the variables of other plugins to be kept")
+	}
+}
+
+func TestTenantEnvironmentChangeKeepsProcesses(t *testing.T) {
+	p := &PluginManager{}
+	p.tenantEnvironments.Store("langgenius/test:tenant-a", map[string]string{"API_KEY": "secret-a"})
+	p.tenantEnvironments.Store("langgenius/test:tenant-b", map[string]string{"API_KEY": "secret-b"})
+
+	// a tenant-scoped variable is sent along requests, only the tenant reloads it
+	p.applyEnvironmentChanged(environmentChangedEvent{PluginID: "langgenius/test", TenantID: "tenant-a"})
+	if _, ok := p.tenantEnvironments.Load("langgenius/test:tenant-a"); ok {
+		t.Fatal("expected the variables of the tenant to be reloaded")
+	}
+	if _, ok := p.tenantEnvironments.Load("langgenius/test:tenant-b"); !ok {
+		t.Fatal("expected the plugin not to be restarted for a tenant-scoped variable")
+	}
+
+	// a plugin-wide variable restarts the plugin, which reloads every tenant
+	p.applyEnvironmentChanged(environmentChangedEvent{PluginID: "langgenius/test"})
+	if _, ok := p.tenantEnvironments.Load("langgenius/test:tenant-b"); ok {
+		t.Fatal("expected the plugin to be restarted for a plugin-wide variable")
+	}
+}
@@ -6,6 +6,8 @@ const (
 	PluginInstallEventInfo  PluginInstallEvent = "info"
 	PluginInstallEventDone  PluginInstallEvent = "done"
 	PluginInstallEventError PluginInstallEvent = "error"
+	// the launch of a local plugin entered a stage, data is the stage
+	PluginInstallEventStage PluginInstallEvent = "stage"
 )
 
 type PluginInstallResponse struct {
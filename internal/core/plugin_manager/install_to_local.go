@@ -1,18 +1,28 @@
 package plugin_manager
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/local_manager"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/routine"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/stream"
 )
 
+type launchProgress struct {
+	stage local_manager.LaunchStage
+	err   error
+}
+
 // InstallToLocal installs a plugin to local
+// it's done once the environment of the plugin is initialized, or once the plugin is ready if wait_for_ready is set,
+// stages of the launch are reported in the meantime
 func (p *PluginManager) InstallToLocal(
 	plugin_unique_identifier plugin_entities.PluginUniqueIdentifier,
 	source string,
 	meta map[string]any,
+	wait_for_ready bool,
 ) (
 	*stream.Stream[PluginInstallResponse], error,
 ) {
@@ -26,11 +36,34 @@ func (p *PluginManager) InstallToLocal(
 		return nil, err
 	}
 
-	runtime, launchedChan, errChan, err := p.launchLocal(plugin_unique_identifier)
+	// intermediate stages are dropped if nobody is waiting for them anymore, the end of the launch never is,
+	// a handler is told at most once that the launch is over
+	progress := make(chan launchProgress, 16)
+	terminal := make(chan launchProgress, 1)
+	runtime, launchedChan, errChan, err := p.launchLocal(
+		plugin_unique_identifier,
+		func(stage local_manager.LaunchStage, err error) {
+			if err != nil || stage == local_manager.LAUNCH_STAGE_READY {
+				terminal <- launchProgress{stage: stage, err: err}
+				return
+			}
+
+			select {
+			case progress <- launchProgress{stage: stage, err: err}:
+			default:
+			}
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
 
+	// long enough to install dependencies and wait for the plugin to be ready
+	timeout := local_manager.LAUNCH_PREPARE_TIMEOUT + local_manager.DEPENDENCY_INSTALL_TIMEOUT
+	if localRuntime, ok := runtime.(*local_manager.LocalPluginRuntime); ok {
+		timeout = localRuntime.LaunchTimeout()
+	}
+
 	response := stream.NewStream[PluginInstallResponse](128)
 	routine.Submit(map[string]string{
 		"module":   "plugin_manager",
@@ -40,7 +73,7 @@ func (p *PluginManager) InstallToLocal(
 
 		ticker := time.NewTicker(time.Second * 5) // check heartbeat every 5 seconds
 		defer ticker.Stop()
-		timer := time.NewTimer(time.Second * 240) // timeout after 240 seconds
+		timer := time.NewTimer(timeout)
 		defer timer.Stop()
 
 		for {
@@ -54,12 +87,53 @@ func (p *PluginManager) InstallToLocal(
 			case <-timer.C:
 				// timeout
 				response.Write(PluginInstallResponse{
-					Event: PluginInstallEventInfo,
-					Data:  "Timeout",
+					Event: PluginInstallEventError,
+					Data:  fmt.Sprintf("plugin is not installed in %s", timeout),
 				})
 				runtime.Stop()
 				return
-			case err := <-errChan:
+			case progress := <-progress:
+				response.Write(PluginInstallResponse{
+					Event: PluginInstallEventStage,
+					Data:  string(progress.stage),
+				})
+			case progress := <-terminal:
+				if progress.err != nil {
+					if !wait_for_ready {
+						// the environment is initialized again until it fails too many times
+						response.Write(PluginInstallResponse{
+							Event: PluginInstallEventInfo,
+							Data:  progress.err.Error(),
+						})
+						continue
+					}
+
+					response.Write(PluginInstallResponse{
+						Event: PluginInstallEventError,
+						Data:  progress.err.Error(),
+					})
+					runtime.Stop()
+					return
+				}
+
+				response.Write(PluginInstallResponse{
+					Event: PluginInstallEventStage,
+					Data:  string(progress.stage),
+				})
+
+				if wait_for_ready {
+					response.Write(PluginInstallResponse{
+						Event: PluginInstallEventDone,
+						Data:  "Installed",
+					})
+					return
+				}
+			case err, ok := <-errChan:
+				if !ok {
+					// no more errors
+					errChan = nil
+					continue
+				}
 				if err != nil {
 					// if error occurs, stop the plugin
 					response.Write(PluginInstallResponse{
@@ -70,6 +144,12 @@ func (p *PluginManager) InstallToLocal(
 					return
 				}
 			case <-launchedChan:
+				if wait_for_ready {
+					// keep waiting for the plugin to be ready
+					launchedChan = nil
+					continue
+				}
+
 				response.Write(PluginInstallResponse{
 					Event: PluginInstallEventDone,
 					Data:  "Installed",
@@ -9,6 +9,7 @@ import (
 
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/basic_manager"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/local_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/plugin_errors"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/positive_manager"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_packager/decoder"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
@@ -47,9 +48,9 @@ func (p *PluginManager) getLocalPluginRuntime(pluginUniqueIdentifier plugin_enti
 		return nil, errors.Join(err, fmt.Errorf("calculate checksum error"))
 	}
 
-	identity := manifest.Identity()
-	identity = strings.ReplaceAll(identity, ":", "-")
-	pluginWorkingPath := path.Join(p.workingDirectory, fmt.Sprintf("%s@%s", identity, checksum))
+	pluginWorkingPath := p.localPluginWorkingPath(
+		plugin_entities.PluginUniqueIdentifier(fmt.Sprintf("%s@%s", manifest.Identity(), checksum)),
+	)
 	return &pluginRuntimeWithDecoder{
 		runtime: plugin_entities.PluginRuntime{
 			Config: manifest,
@@ -65,12 +66,21 @@ func (p *PluginManager) getLocalPluginRuntime(pluginUniqueIdentifier plugin_enti
 	}, nil
 }
 
+// localPluginWorkingPath returns where the plugin is extracted to
+func (p *PluginManager) localPluginWorkingPath(pluginUniqueIdentifier plugin_entities.PluginUniqueIdentifier) string {
+	return path.Join(p.workingDirectory, strings.ReplaceAll(pluginUniqueIdentifier.String(), ":", "-"))
+}
+
 // launch a local plugin
 // returns a full duplex lifetime, a launched channel, an error channel, and an error
 // caller should always handle both the channels to avoid deadlock
 // 1. for launched channel, launch process will close the channel to notify the caller, just wait for it
 // 2. for error channel, it will be closed also, but no more error will be sent, caller should consume all errors
-func (p *PluginManager) launchLocal(pluginUniqueIdentifier plugin_entities.PluginUniqueIdentifier) (
+// launchHandler is notified of the stages of the launch if it's not nil
+func (p *PluginManager) launchLocal(
+	pluginUniqueIdentifier plugin_entities.PluginUniqueIdentifier,
+	launchHandler local_manager.LaunchHandler,
+) (
 	plugin_entities.PluginFullDuplexLifetime, <-chan bool, <-chan error, error,
 ) {
 	plugin, err := p.getLocalPluginRuntime(pluginUniqueIdentifier)
@@ -94,6 +104,21 @@ func (p *PluginManager) launchLocal(pluginUniqueIdentifier plugin_entities.Plugi
 			return nil, nil, nil, fmt.Errorf("plugin runtime not found")
 		}
 
+		if launchHandler != nil {
+			// follow the launch in progress, a plugin which has been ready is told so right away,
+			// a plugin which is given up would never be ready
+			state := lifetime.RuntimeState()
+			if state.Status == plugin_entities.PLUGIN_RUNTIME_STATUS_CRASH_LOOP {
+				launchHandler(local_manager.LaunchStage(""), plugin_errors.ErrPluginCrashLoop)
+			} else if state.Draining || state.Status == plugin_entities.PLUGIN_RUNTIME_STATUS_STOPPED {
+				launchHandler(local_manager.LaunchStage(""), plugin_errors.ErrPluginDraining)
+			} else if runtime, ok := lifetime.(*local_manager.LocalPluginRuntime); ok {
+				runtime.AddLaunchHandler(launchHandler)
+			} else {
+				launchHandler(local_manager.LAUNCH_STAGE_READY, nil)
+			}
+		}
+
 		// returns a closed channel to indicate the plugin is already running, no more waiting is needed
 		c := make(chan bool)
 		close(c)
@@ -111,6 +136,9 @@ func (p *PluginManager) launchLocal(pluginUniqueIdentifier plugin_entities.Plugi
 
 	// check if the working directory exists, if not, create it, otherwise, launch it directly
 	if _, err := os.Stat(plugin.runtime.State.WorkingPath); err != nil {
+		if launchHandler != nil {
+			launchHandler(local_manager.LAUNCH_STAGE_EXTRACTING, nil)
+		}
 		if err := decoder.ExtractTo(plugin.runtime.State.WorkingPath); err != nil {
 			return nil, nil, nil, errors.Join(err, fmt.Errorf("extract plugin to working directory error"))
 		}
@@ -130,8 +158,29 @@ func (p *PluginManager) launchLocal(pluginUniqueIdentifier plugin_entities.Plugi
 		return nil, nil, nil, failed(err.Error())
 	}
 
-	localPluginRuntime := local_manager.NewLocalPluginRuntime(p.pythonInterpreterPath)
+	localPluginRuntime := local_manager.NewLocalPluginRuntime(
+		p.pythonInterpreterPath,
+		p.goCompilerPath,
+		p.nodeExecutablePath,
+		p.localPluginPoolConfig,
+		p.localPluginCgroupConfig,
+		p.localPluginSandboxConfig,
+		p.localPluginPythonDependencyConfig,
+		p.localPluginHealthConfig,
+	)
 	localPluginRuntime.PluginRuntime = plugin.runtime
+	localPluginRuntime.SetLogStore(p.logManager.Store(identity.String()))
+	localPluginRuntime.SetCrashHandler(p.recordCrash)
+	localPluginRuntime.SetStdioConfig(p.localPluginStdioConfig)
+	if launchHandler != nil {
+		localPluginRuntime.AddLaunchHandler(launchHandler)
+	}
+
+	environment, err := p.loadEnvironment(identity.PluginID())
+	if err != nil {
+		return nil, nil, nil, failed(errors.Join(err, fmt.Errorf("load plugin environment error")).Error())
+	}
+	localPluginRuntime.SetEnvironment(environment)
 	localPluginRuntime.PositivePluginRuntime = positive_manager.PositivePluginRuntime{
 		BasicPluginRuntime: basic_manager.NewBasicPluginRuntime(p.mediaBucket),
 		WorkingPath:        plugin.runtime.State.WorkingPath,
@@ -163,7 +212,8 @@ func (p *PluginManager) launchLocal(pluginUniqueIdentifier plugin_entities.Plugi
 			if r := recover(); r != nil {
 				log.Error("plugin runtime panic: %v", r)
 			}
-			p.m.Delete(identity.String())
+			// the plugin may have been launched again with the same identity
+			p.m.CompareAndDelete(identity.String(), localPluginRuntime)
 		}()
 
 		// add max launching lock to prevent too many plugins launching at the same time
@@ -3,8 +3,10 @@ package plugin_manager
 import (
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/log_manager"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
 )
@@ -43,6 +45,7 @@ func (p *PluginManager) fullDuplexLifecycle(
 
 	// try to init environment until succeed
 	failedTimes := 0
+	var lastErr error
 
 	// only notify launched once
 	once := sync.Once{}
@@ -54,8 +57,8 @@ func (p *PluginManager) fullDuplexLifecycle(
 				if errChan != nil {
 					errChan <- fmt.Errorf(
 						"init environment for plugin %s failed too many times, "+
-							"you should consider the package is corrupted or your network is unstable",
-						configuration.Identity(),
+							"you should consider the package is corrupted or your network is unstable: %s",
+						configuration.Identity(), lastErr,
 					)
 					close(errChan)
 				}
@@ -68,11 +71,13 @@ func (p *PluginManager) fullDuplexLifecycle(
 
 		log.Info("init environment for plugin %s", configuration.Identity())
 		if err := r.InitEnvironment(); err != nil {
+			lastErr = err
 			if r.Stopped() {
 				// plugin has been stopped, exit
 				break
 			}
 			log.Error("init environment failed: %s, retry in 30s", err.Error())
+			p.lifecycle(r, log_manager.LOG_LEVEL_ERROR, "init environment failed: %s", err.Error())
 			time.Sleep(30 * time.Second)
 			failedTimes++
 			continue
@@ -93,13 +98,36 @@ func (p *PluginManager) fullDuplexLifecycle(
 
 	// init environment successfully
 	// once succeed, we consider the plugin is installed successfully
+	policy := p.restartPolicy.resolve(configuration.Meta.Restart)
+	restarts := newRestartTracker(policy)
+
+	// crashes of single processes count towards the crash loop as well,
+	// the last one decides how the plugin is restarted once all of them have exited
+	crashed := new(atomic.Bool)
+	crashDelay := new(atomic.Int64)
+	crashLooping := new(atomic.Bool)
+	if restarter, ok := r.(instanceRestarter); ok {
+		restarter.SetInstanceRestartHandler(func(uptime time.Duration) (time.Duration, bool) {
+			delay, ok := restarts.next(time.Now(), uptime)
+			crashDelay.Store(int64(delay))
+			crashLooping.Store(!ok)
+			crashed.Store(true)
+			return delay, ok
+		})
+	}
+
 	for !r.Stopped() {
+		startedAt := time.Now()
+		crashed.Store(false)
+
 		// start plugin
 		if err := r.StartPlugin(); err != nil {
 			if r.Stopped() {
 				// plugin has been stopped, exit
 				break
 			}
+			log.Error("start plugin %s failed: %s", configuration.Identity(), err.Error())
+			p.lifecycle(r, log_manager.LOG_LEVEL_ERROR, "start failed: %s", err.Error())
 		}
 
 		// wait for plugin to stop normally
@@ -108,10 +136,61 @@ func (p *PluginManager) fullDuplexLifecycle(
 			<-c
 		}
 
-		// restart plugin in 5s
-		time.Sleep(5 * time.Second)
+		if r.Stopped() {
+			break
+		}
+
+		var delay time.Duration
+		var ok bool
+		if crashed.Load() {
+			// the crashes which stopped the plugin have been counted already
+			delay, ok = time.Duration(crashDelay.Load()), !crashLooping.Load()
+		} else {
+			delay, ok = restarts.next(time.Now(), time.Since(startedAt))
+		}
+
+		if !ok {
+			log.Error(
+				"plugin %s exited more than %d times in %s, it will not be restarted until reset",
+				configuration.Identity(), policy.MaxRestarts, policy.Window,
+			)
+			r.SetCrashLoop()
+			p.lifecycle(
+				r, log_manager.LOG_LEVEL_ERROR, "exited more than %d times in %s, put in crash loop",
+				policy.MaxRestarts, policy.Window,
+			)
+			if !p.waitCrashLoopReset(r) {
+				// plugin has been stopped, exit
+				break
+			}
+			restarts.reset()
+			delay = 0
+			p.lifecycle(r, log_manager.LOG_LEVEL_INFO, "crash loop reset, restarting")
+		} else {
+			log.Warn("plugin %s exited, restart in %s", configuration.Identity(), delay)
+			p.lifecycle(r, log_manager.LOG_LEVEL_WARN, "exited, restart in %s", delay)
+		}
+
+		time.Sleep(delay)
 
 		// add restart times
 		r.AddRestarts()
 	}
 }
+
+// instanceRestarter is a runtime which restarts its crashed processes by itself, like a local pool
+type instanceRestarter interface {
+	SetInstanceRestartHandler(handler func(uptime time.Duration) (time.Duration, bool))
+}
+
+// lifecycle keeps a lifecycle event of the plugin in its log store
+func (p *PluginManager) lifecycle(
+	r plugin_entities.PluginLifetime, level string, format string, args ...any,
+) {
+	identity, err := r.Identity()
+	if err != nil {
+		return
+	}
+
+	p.logManager.Store(identity.String()).Lifecycle(level, format, args...)
+}
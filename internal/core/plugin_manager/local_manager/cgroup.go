@@ -0,0 +1,118 @@
+package local_manager
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/log_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/cgroup"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+)
+
+// CgroupConfig controls the resource limits applied to local plugin processes
+type CgroupConfig struct {
+	Enabled bool
+	// root cgroup, every process gets its own leaf under it
+	Root string
+	// cpus a single process could use, 0 means unlimited
+	CPUQuota float64
+	// set if the root cgroup could not be prepared, processes are not started without their limits
+	InitError error
+}
+
+// setupCgroup creates the cgroup leaf of a new process and makes the command start inside it,
+// memory.max is taken from the manifest, the returned file must be closed once the command is started
+func (r *LocalPluginRuntime) setupCgroup(cmd *exec.Cmd) (*cgroup.Cgroup, *os.File, error) {
+	if !r.cgroupConfig.Enabled {
+		return nil, nil, nil
+	}
+
+	if r.cgroupConfig.InitError != nil {
+		return nil, nil, fmt.Errorf("cgroup is not available: %s", r.cgroupConfig.InitError.Error())
+	}
+
+	name := strings.NewReplacer("/", "-", ":", "-", "@", "-").Replace(r.Config.Identity())
+	c, err := cgroup.New(r.cgroupConfig.Root, fmt.Sprintf("%s-%s", name, uuid.NewString()))
+	if err != nil {
+		return nil, nil, fmt.Errorf("create cgroup failed: %s", err.Error())
+	}
+
+	setup := func() (*os.File, error) {
+		if r.Config.Resource.Memory > 0 {
+			if err := c.SetMemoryMax(r.Config.Resource.Memory); err != nil {
+				return nil, err
+			}
+		}
+
+		if r.cgroupConfig.CPUQuota > 0 {
+			if err := c.SetCPUQuota(r.cgroupConfig.CPUQuota); err != nil {
+				return nil, err
+			}
+		}
+
+		return c.Attach(cmd)
+	}
+
+	dir, err := setup()
+	if err != nil {
+		c.Delete()
+		return nil, nil, err
+	}
+
+	return c, dir, nil
+}
+
+// collectCgroupEvents reports OOM kills and throttling of the instance since the last collection
+func (r *LocalPluginRuntime) collectCgroupEvents(instance *pluginInstance) {
+	if instance.cgroup == nil {
+		return
+	}
+
+	events, err := instance.cgroup.Events()
+	if err != nil {
+		log.Error("read cgroup events of plugin %s failed: %s", r.Config.Identity(), err.Error())
+		return
+	}
+
+	if oomKills := events.OOMKills - instance.events.OOMKills; oomKills > 0 {
+		log.Error(
+			"plugin %s was killed by the OOM killer, memory limit: %d bytes",
+			r.Config.Identity(), r.Config.Resource.Memory,
+		)
+		r.lifecycle(
+			log_manager.LOG_LEVEL_ERROR,
+			"killed by the OOM killer, memory limit: %d bytes", r.Config.Resource.Memory,
+		)
+		r.AddOOMKills(oomKills)
+		instance.oomKilled = true
+	}
+
+	if throttled := events.ThrottledUsec - instance.events.ThrottledUsec; throttled > 0 {
+		log.Warn(
+			"plugin %s was throttled for %dms in %d periods, cpu quota: %.2f",
+			r.Config.Identity(), throttled/1000,
+			events.ThrottledPeriods-instance.events.ThrottledPeriods, r.cgroupConfig.CPUQuota,
+		)
+		r.AddCPUThrottled(throttled)
+	}
+
+	instance.events = events
+}
+
+// collectPoolCgroupEvents collects cgroup events of all the instances in the pool
+func (r *LocalPluginRuntime) collectPoolCgroupEvents() {
+	if !r.cgroupConfig.Enabled {
+		return
+	}
+
+	r.instanceLock.RLock()
+	instances := append([]*pluginInstance{}, r.instances...)
+	r.instanceLock.RUnlock()
+
+	for _, instance := range instances {
+		r.collectCgroupEvents(instance)
+	}
+}
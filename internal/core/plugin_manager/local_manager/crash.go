@@ -0,0 +1,111 @@
+package local_manager
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/log_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/parser"
+)
+
+const (
+	// lines of stderr kept for a crash report, enough for a python traceback
+	CRASH_STDERR_LINES = 100
+	// how long a crash report waits for the rest of stderr after the process exits
+	CRASH_STDERR_FLUSH_TIMEOUT = time.Second
+
+	// error type of the message sent to sessions in flight once their process crashes
+	CRASH_ERROR_TYPE = "PluginCrashedError"
+)
+
+// CrashReport describes an unexpected exit of a process of the plugin
+type CrashReport struct {
+	PluginUniqueIdentifier string
+	InstanceID             string
+	Pid                    int
+	// exit code of the process, -1 if it was killed by a signal
+	ExitCode int
+	Signal   string
+	// why the daemon killed the process, e.g. missed heartbeats or the OOM killer, empty otherwise
+	Reason string
+	// the last lines of stderr
+	Stderr   []string
+	Uptime   time.Duration
+	Restarts int
+	// sessions which were in flight on the process and the tenants which own them
+	Sessions       []string
+	SessionTenants map[string]string
+	CrashedAt      time.Time
+}
+
+// Summary returns a short description of how the process exited
+func (c CrashReport) Summary() string {
+	summary := fmt.Sprintf("exited with code %d", c.ExitCode)
+	if c.Signal != "" {
+		summary = fmt.Sprintf("killed by signal %s", c.Signal)
+	}
+
+	if c.Reason != "" {
+		summary += ": " + c.Reason
+	}
+
+	return summary
+}
+
+// SetCrashHandler sets the handler of crash reports of the processes
+func (r *LocalPluginRuntime) SetCrashHandler(handler func(CrashReport)) {
+	r.crashHandler = handler
+}
+
+// exitStatus returns the exit code and the signal which killed the process if any
+func exitStatus(state *os.ProcessState) (int, string) {
+	if state == nil {
+		return -1, ""
+	}
+
+	if status, ok := state.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return -1, status.Signal().String()
+	}
+
+	return state.ExitCode(), ""
+}
+
+// instanceSessions returns sessions dispatched to the instance
+func (r *LocalPluginRuntime) instanceSessions(instance *pluginInstance) []string {
+	r.instanceLock.RLock()
+	defer r.instanceLock.RUnlock()
+
+	sessions := []string{}
+	for sessionId, v := range r.sessionInstances {
+		if v == instance {
+			sessions = append(sessions, sessionId)
+		}
+	}
+
+	return sessions
+}
+
+// reportCrash fails the sessions in flight on the crashed instance and hands the report to the crash handler
+func (r *LocalPluginRuntime) reportCrash(instance *pluginInstance, stdio *stdioHolder, report CrashReport) {
+	summary := report.Summary()
+	log.Error("plugin %s instance %s crashed, %s", r.Config.Identity(), instance.ioIdentity, summary)
+	r.lifecycle(log_manager.LOG_LEVEL_ERROR, "instance %s crashed, %s", instance.ioIdentity, summary)
+
+	stdio.failSessions(parser.MarshalJsonBytes(plugin_entities.SessionMessage{
+		Type: plugin_entities.SESSION_MESSAGE_TYPE_ERROR,
+		Data: parser.MarshalJsonBytes(plugin_entities.ErrorResponse{
+			ErrorType: CRASH_ERROR_TYPE,
+			Message:   fmt.Sprintf("plugin process %d crashed, %s", report.Pid, summary),
+		}),
+	}))
+
+	r.instanceCrashed(report.Uptime)
+	r.launchCrashed(report)
+	if r.crashHandler != nil {
+		r.crashHandler(report)
+	}
+}
@@ -0,0 +1,87 @@
+package local_manager
+
+import (
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/parser"
+)
+
+func TestExitStatus(t *testing.T) {
+	cmd := exec.Command("sh", "-c", "exit 3")
+	cmd.Run()
+	if code, signal := exitStatus(cmd.ProcessState); code != 3 || signal != "" {
+		t.Fatalf("expected exit code 3, got %d %s", code, signal)
+	}
+
+	cmd = exec.Command("sh", "-c", "kill -9 $$")
+	cmd.Run()
+	if code, signal := exitStatus(cmd.ProcessState); code != -1 || signal != "killed" {
+		t.Fatalf("expected to be killed, got %d %s", code, signal)
+	}
+}
+
+func TestCrashFailsSessions(t *testing.T) {
+	_, stdinWriter := io.Pipe()
+	stdoutReader, _ := io.Pipe()
+	stderrReader, stderrWriter := io.Pipe()
+
+	holder := registerStdioHandler("test", stdinWriter, stdoutReader, stderrReader)
+	defer removeStdioHandler(holder.GetID())
+
+	done := make(chan bool)
+	go func() {
+		holder.StartStderr()
+		close(done)
+	}()
+
+	lines := []string{}
+	for i := 0; i < CRASH_STDERR_LINES+10; i++ {
+		lines = append(lines, fmt.Sprintf("line %d", i))
+	}
+	stderrWriter.Write([]byte(strings.Join(lines, "\n") + "\nTraceback (most recent call last):\n"))
+	stderrWriter.Close()
+	<-done
+
+	tail := holder.StderrTail()
+	if len(tail) != CRASH_STDERR_LINES || tail[len(tail)-1] != "Traceback (most recent call last):" {
+		t.Fatalf("unexpected stderr tail: %v", tail)
+	}
+
+	received := make(chan []byte, 1)
+	setupStdioEventListener(holder.GetID(), "session-1", func(data []byte, blob *plugin_entities.SessionBlob) {
+		received <- data
+	})
+
+	r := &LocalPluginRuntime{sessionInstances: map[string]*pluginInstance{}}
+	instance := &pluginInstance{ioIdentity: holder.GetID(), pid: 42}
+	r.sessionInstances["session-1"] = instance
+
+	reports := []CrashReport{}
+	r.SetCrashHandler(func(report CrashReport) {
+		reports = append(reports, report)
+	})
+
+	r.reportCrash(instance, holder, CrashReport{
+		Pid:      42,
+		ExitCode: 1,
+		Sessions: r.instanceSessions(instance),
+	})
+
+	message, err := parser.UnmarshalJsonBytes[plugin_entities.SessionMessage](<-received)
+	if err != nil || message.Type != plugin_entities.SESSION_MESSAGE_TYPE_ERROR {
+		t.Fatalf("expected an error message, got %v", message)
+	}
+	response, err := parser.UnmarshalJsonBytes[plugin_entities.ErrorResponse](message.Data)
+	if err != nil || response.ErrorType != CRASH_ERROR_TYPE || !strings.Contains(response.Message, "exited with code 1") {
+		t.Fatalf("unexpected error response: %v", response)
+	}
+
+	if len(reports) != 1 || len(reports[0].Sessions) != 1 || reports[0].Sessions[0] != "session-1" {
+		t.Fatalf("unexpected crash reports: %v", reports)
+	}
+}
@@ -0,0 +1,285 @@
+package local_manager
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/lock"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+)
+
+// PythonDependencyConfig controls how dependencies of python plugins are installed
+type PythonDependencyConfig struct {
+	// daemon-wide cache of downloaded packages and virtual environment snapshots,
+	// every plugin gets its own virtual environment if empty
+	// snapshots are read-only, plugins which are not sandboxed could still make them writable again
+	CachePath string
+	// directory of wheels on the daemon, plugins are installed from it without the package index if set
+	LocalIndexPath string
+	// plugins locked by uv or poetry are installed from requirements.txt by pip if the tool is missing,
+	// the installation fails otherwise
+	PipFallback bool
+}
+
+const (
+	// packages downloaded by pip, uv and poetry
+	PYTHON_DOWNLOAD_CACHE_DIR = "downloads"
+	// virtual environments keyed by the hash of the dependencies
+	PYTHON_VENV_SNAPSHOT_DIR = "venvs"
+	// written once a snapshot is completely installed, touched every time it's reused
+	PYTHON_VENV_SNAPSHOT_MARKER = ".mlchain-snapshot"
+
+	// a snapshot used or created recently is never collected,
+	// it may be about to be linked by a plugin
+	PYTHON_VENV_SNAPSHOT_GC_GRACE = time.Hour
+	// downloaded packages not refreshed for this long are removed, installed snapshots are not affected
+	PYTHON_DOWNLOAD_CACHE_TTL = 7 * 24 * time.Hour
+)
+
+var pythonVenvSnapshotLock = lock.NewGranularityLock()
+
+// pythonDownloadCacheEnv points the package managers to the shared download cache
+func (p *LocalPluginRuntime) pythonDownloadCacheEnv() []string {
+	if p.pythonDependencyConfig.CachePath == "" {
+		return nil
+	}
+
+	downloads, err := filepath.Abs(path.Join(p.pythonDependencyConfig.CachePath, PYTHON_DOWNLOAD_CACHE_DIR))
+	if err != nil {
+		return nil
+	}
+
+	return []string{
+		"PIP_CACHE_DIR=" + path.Join(downloads, "pip"),
+		"UV_CACHE_DIR=" + path.Join(downloads, "uv"),
+		"POETRY_CACHE_DIR=" + path.Join(downloads, "poetry"),
+	}
+}
+
+// pythonDependencyKey hashes everything which decides the content of the virtual environment
+func (p *LocalPluginRuntime) pythonDependencyKey(manager pythonDependencyManager) (string, error) {
+	interpreter, err := exec.LookPath(p.defaultPythonInterpreterPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to find python: %s", err)
+	}
+	if resolved, err := filepath.EvalSymlinks(interpreter); err == nil {
+		interpreter = resolved
+	}
+
+	version, err := exec.Command(interpreter, "--version").CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("failed to get python version: %s, output: %s", err, version)
+	}
+
+	// pip comes with the interpreter, uv and poetry are installed on their own
+	managerVersion := []byte{}
+	if manager.lockfile != "" {
+		executable, err := lookupPythonDependencyManager(manager)
+		if err != nil {
+			return "", err
+		}
+		managerVersion, err = exec.Command(executable, "--version").CombinedOutput()
+		if err != nil {
+			return "", fmt.Errorf("failed to get %s version: %s, output: %s", manager.name, err, managerVersion)
+		}
+	}
+
+	h := sha256.New()
+	fmt.Fprintf(
+		h, "%s/%s\n%s\n%s\n%s\n%s\n",
+		runtime.GOOS, runtime.GOARCH, interpreter, version, manager.name, managerVersion,
+	)
+	for _, file := range manager.dependencyFiles() {
+		content, err := os.ReadFile(path.Join(p.State.WorkingPath, file))
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %s", file, err)
+		}
+		fmt.Fprintf(h, "%s\n%d\n", file, len(content))
+		h.Write(content)
+	}
+
+	// wheels decide what gets installed as well if the package index is not used
+	for _, findLink := range p.pythonFindLinks() {
+		entries, err := os.ReadDir(findLink)
+		if err != nil {
+			return "", fmt.Errorf("failed to read wheels: %s", err)
+		}
+		fmt.Fprintf(h, "find-links\n")
+		for _, entry := range entries {
+			info, err := entry.Info()
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(h, "%s\n%d\n", entry.Name(), info.Size())
+		}
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// pythonVenvSnapshot returns a virtual environment with the dependencies of the plugin installed,
+// it's created on first use and shared by every plugin with the same dependencies
+func (p *LocalPluginRuntime) pythonVenvSnapshot(manager pythonDependencyManager) (string, error) {
+	key, err := p.pythonDependencyKey(manager)
+	if err != nil {
+		return "", err
+	}
+
+	snapshot, err := filepath.Abs(path.Join(p.pythonDependencyConfig.CachePath, PYTHON_VENV_SNAPSHOT_DIR, key))
+	if err != nil {
+		return "", fmt.Errorf("failed to find virtual environment snapshot: %s", err)
+	}
+
+	pythonVenvSnapshotLock.Lock(key)
+	defer pythonVenvSnapshotLock.Unlock(key)
+
+	marker := path.Join(snapshot, PYTHON_VENV_SNAPSHOT_MARKER)
+	if _, err := os.Stat(marker); err == nil {
+		// keep it away from gc
+		now := time.Now()
+		os.Chtimes(marker, now, now)
+		log.Info("reuse python virtual environment snapshot %s for %s", key, p.State.WorkingPath)
+		return snapshot, nil
+	}
+
+	// left by an interrupted installation
+	removeSnapshot(snapshot)
+
+	if err := os.MkdirAll(filepath.Dir(snapshot), 0755); err != nil {
+		return "", fmt.Errorf("failed to create virtual environment snapshot: %s", err)
+	}
+
+	if err := p.createPythonVirtualEnvironment(snapshot, manager); err != nil {
+		return "", err
+	}
+
+	if err := os.WriteFile(marker, []byte(p.State.WorkingPath), 0644); err != nil {
+		removeSnapshot(snapshot)
+		return "", fmt.Errorf("failed to create virtual environment snapshot: %s", err)
+	}
+
+	// plugins sharing the snapshot must not change the dependencies of each other
+	if err := setWritable(snapshot, false); err != nil {
+		removeSnapshot(snapshot)
+		return "", fmt.Errorf("failed to make virtual environment snapshot read-only: %s", err)
+	}
+
+	return snapshot, nil
+}
+
+// setWritable adds or removes write permissions of everything in the directory, symlinks are skipped
+func setWritable(root string, writable bool) error {
+	return filepath.WalkDir(root, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type()&fs.ModeSymlink != 0 {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		mode := info.Mode().Perm()
+		if writable {
+			mode |= 0200
+		} else {
+			mode &^= 0222
+		}
+		return os.Chmod(file, mode)
+	})
+}
+
+// removeSnapshot removes a snapshot, entries of a read-only directory could not be removed
+func removeSnapshot(snapshot string) error {
+	setWritable(snapshot, true)
+	return os.RemoveAll(snapshot)
+}
+
+// GarbageCollectPythonDependencyCache removes snapshots which none of the working paths links to,
+// and downloaded packages which have not been refreshed for a long time
+func GarbageCollectPythonDependencyCache(cachePath string, workingPaths []string) error {
+	snapshots, err := filepath.Abs(path.Join(cachePath, PYTHON_VENV_SNAPSHOT_DIR))
+	if err != nil {
+		return err
+	}
+
+	referenced := map[string]bool{}
+	for _, workingPath := range workingPaths {
+		target, err := os.Readlink(path.Join(workingPath, ".venv"))
+		if err != nil {
+			continue
+		}
+		if filepath.Dir(target) == snapshots {
+			referenced[filepath.Base(target)] = true
+		}
+	}
+
+	entries, err := os.ReadDir(snapshots)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	for _, entry := range entries {
+		key := entry.Name()
+		if referenced[key] {
+			continue
+		}
+
+		func() {
+			pythonVenvSnapshotLock.Lock(key)
+			defer pythonVenvSnapshotLock.Unlock(key)
+
+			snapshot := path.Join(snapshots, key)
+			info, err := os.Stat(path.Join(snapshot, PYTHON_VENV_SNAPSHOT_MARKER))
+			if err != nil {
+				// incomplete snapshot, judge by the directory itself
+				info, err = os.Stat(snapshot)
+				if err != nil {
+					return
+				}
+			}
+
+			if time.Since(info.ModTime()) < PYTHON_VENV_SNAPSHOT_GC_GRACE {
+				return
+			}
+
+			if err := removeSnapshot(snapshot); err != nil {
+				log.Error("failed to remove python virtual environment snapshot %s: %s", key, err.Error())
+				return
+			}
+			log.Info("removed unreferenced python virtual environment snapshot %s", key)
+		}()
+	}
+
+	// downloaded packages are shared across snapshots, expire them by age
+	downloads := path.Join(cachePath, PYTHON_DOWNLOAD_CACHE_DIR)
+	return filepath.WalkDir(downloads, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		if time.Since(info.ModTime()) > PYTHON_DOWNLOAD_CACHE_TTL {
+			os.Remove(file)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,184 @@
+package local_manager
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestGarbageCollectPythonDependencyCache(t *testing.T) {
+	cachePath := t.TempDir()
+	workingPath := t.TempDir()
+
+	snapshots := path.Join(cachePath, PYTHON_VENV_SNAPSHOT_DIR)
+	old := time.Now().Add(-2 * PYTHON_VENV_SNAPSHOT_GC_GRACE)
+
+	newSnapshot := func(key string, modTime time.Time) string {
+		snapshot := path.Join(snapshots, key)
+		if err := os.MkdirAll(snapshot, 0755); err != nil {
+			t.Fatal(err)
+		}
+		marker := path.Join(snapshot, PYTHON_VENV_SNAPSHOT_MARKER)
+		if err := os.WriteFile(marker, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(marker, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+		return snapshot
+	}
+
+	referenced := newSnapshot("referenced", old)
+	unreferenced := newSnapshot("unreferenced", old)
+	recent := newSnapshot("recent", time.Now())
+
+	absReferenced, err := filepath.Abs(referenced)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(absReferenced, path.Join(workingPath, ".venv")); err != nil {
+		t.Fatal(err)
+	}
+
+	downloads := path.Join(cachePath, PYTHON_DOWNLOAD_CACHE_DIR, "pip")
+	if err := os.MkdirAll(downloads, 0755); err != nil {
+		t.Fatal(err)
+	}
+	expired := path.Join(downloads, "expired.whl")
+	fresh := path.Join(downloads, "fresh.whl")
+	for _, file := range []string{expired, fresh} {
+		if err := os.WriteFile(file, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expiredTime := time.Now().Add(-2 * PYTHON_DOWNLOAD_CACHE_TTL)
+	if err := os.Chtimes(expired, expiredTime, expiredTime); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := GarbageCollectPythonDependencyCache(cachePath, []string{workingPath}); err != nil {
+		t.Fatal(err)
+	}
+
+	exists := func(file string) bool {
+		_, err := os.Stat(file)
+		return err == nil
+	}
+
+	if !exists(referenced) {
+		t.Errorf("referenced snapshot should be kept")
+	}
+	if exists(unreferenced) {
+		t.Errorf("unreferenced snapshot should be removed")
+	}
+	if !exists(recent) {
+		t.Errorf("recently used snapshot should be kept")
+	}
+	if exists(expired) {
+		t.Errorf("expired download should be removed")
+	}
+	if !exists(fresh) {
+		t.Errorf("fresh download should be kept")
+	}
+}
+
+func TestPythonDependencyKey(t *testing.T) {
+	defer func(original func(string) (string, error)) { lookPath = original }(lookPath)
+
+	bin := t.TempDir()
+	fakeExecutable := func(name string, version string) string {
+		executable := path.Join(bin, name)
+		if err := os.WriteFile(executable, []byte("#!/bin/sh\necho "+version+"\n"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		return executable
+	}
+
+	python := fakeExecutable("python", "Python 3.12.0")
+	uv := fakeExecutable("uv", "uv 0.4.0")
+	lookPath = func(name string) (string, error) {
+		if name == "uv" {
+			return uv, nil
+		}
+		return python, nil
+	}
+
+	r := NewLocalPluginRuntime(python, "", "", PoolConfig{}, CgroupConfig{}, SandboxConfig{}, PythonDependencyConfig{}, HealthConfig{})
+	r.State.WorkingPath = t.TempDir()
+	for _, file := range []string{"requirements.txt", "pyproject.toml", "uv.lock"} {
+		if err := os.WriteFile(path.Join(r.State.WorkingPath, file), []byte(file), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	key := func(manager pythonDependencyManager) string {
+		key, err := r.pythonDependencyKey(manager)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return key
+	}
+
+	pip := key(PYTHON_DEPENDENCY_MANAGER_PIP)
+	locked := key(PYTHON_DEPENDENCY_MANAGER_UV)
+	if pip == locked {
+		t.Fatal("expected the dependency manager to be part of the key")
+	}
+
+	if err := os.WriteFile(path.Join(r.State.WorkingPath, "uv.lock"), []byte("changed"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if key(PYTHON_DEPENDENCY_MANAGER_UV) == locked {
+		t.Fatal("expected the lockfile to be part of the key")
+	}
+	locked = key(PYTHON_DEPENDENCY_MANAGER_UV)
+
+	fakeExecutable("uv", "uv 0.5.0")
+	if key(PYTHON_DEPENDENCY_MANAGER_UV) == locked {
+		t.Fatal("expected the version of the dependency manager to be part of the key")
+	}
+
+	fakeExecutable("python", "Python 3.13.0")
+	if key(PYTHON_DEPENDENCY_MANAGER_PIP) == pip {
+		t.Fatal("expected the python version to be part of the key")
+	}
+}
+
+func TestReadOnlySnapshotIsCollected(t *testing.T) {
+	cachePath := t.TempDir()
+	snapshot := path.Join(cachePath, PYTHON_VENV_SNAPSHOT_DIR, "readonly")
+	if err := os.MkdirAll(path.Join(snapshot, "lib"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path.Join(snapshot, "lib", "module.py"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	marker := path.Join(snapshot, PYTHON_VENV_SNAPSHOT_MARKER)
+	if err := os.WriteFile(marker, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-2 * PYTHON_VENV_SNAPSHOT_GC_GRACE)
+	if err := os.Chtimes(marker, old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := setWritable(snapshot, false); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(path.Join(snapshot, "lib", "module.py"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm()&0222 != 0 {
+		t.Fatalf("expected the snapshot to be read-only, got %s", info.Mode())
+	}
+
+	if err := GarbageCollectPythonDependencyCache(cachePath, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(snapshot); !os.IsNotExist(err) {
+		t.Fatal("expected the read-only snapshot to be removed")
+	}
+}
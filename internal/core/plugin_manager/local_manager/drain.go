@@ -0,0 +1,62 @@
+package local_manager
+
+import (
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/log_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+)
+
+const (
+	// interval to check whether in-flight sessions of a draining plugin have finished
+	DRAIN_CHECK_INTERVAL = 500 * time.Millisecond
+)
+
+// Drain stops dispatching new sessions to the plugin and stops it once in-flight sessions
+// have finished or the grace period has elapsed, it hangs until the plugin is stopped
+func (r *LocalPluginRuntime) Drain(grace time.Duration) {
+	r.instanceLock.Lock()
+	if r.draining {
+		r.instanceLock.Unlock()
+		return
+	}
+	r.draining = true
+	r.instanceLock.Unlock()
+
+	if r.Stopped() {
+		return
+	}
+
+	r.SetDraining()
+	log.Info("draining plugin %s, %d sessions in flight", r.Config.Identity(), r.inflightSessions())
+	r.lifecycle(log_manager.LOG_LEVEL_INFO, "draining, %d sessions in flight", r.inflightSessions())
+
+	ticker := time.NewTicker(DRAIN_CHECK_INTERVAL)
+	defer ticker.Stop()
+	deadline := time.Now().Add(grace)
+
+	for !r.Stopped() && r.inflightSessions() > 0 && time.Now().Before(deadline) {
+		<-ticker.C
+	}
+
+	if sessions := r.inflightSessions(); sessions > 0 {
+		log.Warn(
+			"grace period of plugin %s elapsed, %d sessions are terminated", r.Config.Identity(), sessions,
+		)
+		r.lifecycle(log_manager.LOG_LEVEL_WARN, "grace period elapsed, %d sessions are terminated", sessions)
+	}
+
+	r.Stop()
+}
+
+func (r *LocalPluginRuntime) isDraining() bool {
+	r.instanceLock.RLock()
+	defer r.instanceLock.RUnlock()
+	return r.draining
+}
+
+func (r *LocalPluginRuntime) inflightSessions() int {
+	r.instanceLock.RLock()
+	defer r.instanceLock.RUnlock()
+	return len(r.sessionInstances)
+}
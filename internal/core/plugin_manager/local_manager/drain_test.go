@@ -0,0 +1,70 @@
+package local_manager
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDrainWaitsForInflightSessions(t *testing.T) {
+	r := newTestPoolRuntime(1)
+	if r.bindSession("session-1") == nil {
+		t.Fatal("expected session to be bound")
+	}
+
+	drained := make(chan bool)
+	go func() {
+		r.Drain(time.Minute)
+		close(drained)
+	}()
+
+	for !r.isDraining() {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if r.bindSession("session-2") != nil {
+		t.Fatal("expected no new session to be dispatched while draining")
+	}
+
+	if r.bindSession("session-1") == nil {
+		t.Fatal("expected in-flight session to keep its instance")
+	}
+
+	select {
+	case <-drained:
+		t.Fatal("expected drain to wait for in-flight sessions")
+	case <-time.After(2 * DRAIN_CHECK_INTERVAL):
+	}
+
+	if !r.RuntimeState().Draining {
+		t.Fatal("expected the runtime state to be draining")
+	}
+
+	// parking or restarting the plugin must not make it available again
+	r.SetParked()
+	if !r.RuntimeState().Draining {
+		t.Fatal("expected the runtime state to stay draining")
+	}
+
+	r.releaseSession("session-1")
+
+	select {
+	case <-drained:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected drain to finish once sessions are released")
+	}
+
+	if !r.Stopped() {
+		t.Fatal("expected plugin to be stopped after draining")
+	}
+}
+
+func TestDrainGracePeriodElapsed(t *testing.T) {
+	r := newTestPoolRuntime(1)
+	r.bindSession("session-1")
+
+	r.Drain(10 * time.Millisecond)
+
+	if !r.Stopped() {
+		t.Fatal("expected plugin to be stopped once the grace period elapsed")
+	}
+}
@@ -2,20 +2,32 @@ package local_manager
 
 import (
 	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/constants"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/routine"
 )
 
 func (r *LocalPluginRuntime) InitEnvironment() error {
 	var err error
 	if r.Config.Meta.Runner.Language == constants.Python {
 		err = r.InitPythonEnvironment()
+	} else if r.Config.Meta.Runner.Language == constants.Go {
+		err = r.InitGoEnvironment()
+	} else if r.Config.Meta.Runner.Language == constants.NodeJS {
+		err = r.InitNodeEnvironment()
 	} else {
 		return fmt.Errorf("unsupported language: %s", r.Config.Meta.Runner.Language)
 	}
 
 	if err != nil {
+		r.launchFailed(err)
 		return err
 	}
 
@@ -29,3 +41,118 @@ func (r *LocalPluginRuntime) Identity() (plugin_entities.PluginUniqueIdentifier,
 	}
 	return plugin_entities.NewPluginUniqueIdentifier(fmt.Sprintf("%s@%s", r.Config.Identity(), checksum))
 }
+
+const (
+	// an installation command is killed if it outputs nothing for this long
+	INSTALL_INACTIVITY_TIMEOUT = 60 * time.Second
+)
+
+// runInstallCommand runs a dependency installation command like `pip install`,
+// stdout is logged, stderr is collected into the returned error
+// the command is killed once it has no activity for INSTALL_INACTIVITY_TIMEOUT
+func (p *LocalPluginRuntime) runInstallCommand(cmd *exec.Cmd, function string) error {
+	// get stdout and stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("failed to get stdout: %s", err)
+	}
+	defer stdout.Close()
+
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return fmt.Errorf("failed to get stderr: %s", err)
+	}
+	defer stderr.Close()
+
+	// start command
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start command: %s", err)
+	}
+	defer func() {
+		if cmd.Process != nil {
+			cmd.Process.Kill()
+		}
+	}()
+
+	var errMsg strings.Builder
+	var errMsgLock sync.Mutex
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	lastActiveAt := atomic.Int64{}
+	lastActiveAt.Store(time.Now().UnixNano())
+
+	routine.Submit(map[string]string{
+		"module":   "plugin_manager",
+		"function": function,
+	}, func() {
+		defer wg.Done()
+		// read stdout
+		buf := make([]byte, 1024)
+		for {
+			n, err := stdout.Read(buf)
+			if err != nil {
+				break
+			}
+			log.Info("installing %s - %s", p.Config.Identity(), string(buf[:n]))
+			lastActiveAt.Store(time.Now().UnixNano())
+		}
+	})
+
+	routine.Submit(map[string]string{
+		"module":   "plugin_manager",
+		"function": function,
+	}, func() {
+		defer wg.Done()
+		// read stderr
+		buf := make([]byte, 1024)
+		for {
+			n, err := stderr.Read(buf)
+			if n > 0 {
+				errMsgLock.Lock()
+				errMsg.Write(buf[:n])
+				errMsgLock.Unlock()
+				lastActiveAt.Store(time.Now().UnixNano())
+			}
+			if err != nil {
+				break
+			}
+		}
+	})
+
+	done := make(chan bool)
+	defer close(done)
+
+	routine.Submit(map[string]string{
+		"module":   "plugin_manager",
+		"function": function,
+	}, func() {
+		ticker := time.NewTicker(5 * time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			if time.Since(time.Unix(0, lastActiveAt.Load())) > INSTALL_INACTIVITY_TIMEOUT {
+				cmd.Process.Kill()
+				errMsgLock.Lock()
+				errMsg.WriteString("init process exited due to long time no activity")
+				errMsgLock.Unlock()
+				return
+			}
+		}
+	})
+
+	wg.Wait()
+
+	if err := cmd.Wait(); err != nil {
+		errMsgLock.Lock()
+		defer errMsgLock.Unlock()
+		return fmt.Errorf("%s, output: %s", err, errMsg.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package local_manager
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/constants"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+)
+
+// InitGoEnvironment prepares the binary of a go plugin
+// a prebuilt binary for the current arch is preferred, otherwise the go module is built from source
+func (p *LocalPluginRuntime) InitGoEnvironment() error {
+	arch := constants.Arch(runtime.GOARCH)
+
+	declared := false
+	for _, a := range p.Config.Meta.Arch {
+		if a == arch {
+			declared = true
+			break
+		}
+	}
+	if !declared {
+		return fmt.Errorf("plugin does not support arch %s", arch)
+	}
+
+	// use the prebuilt binary if it's shipped with the package, prebuilt binaries are linux only
+	prebuilt, err := filepath.Abs(path.Join(p.State.WorkingPath, plugin_entities.GoPrebuiltBinary(arch)))
+	if err != nil {
+		return fmt.Errorf("failed to find prebuilt binary: %s", err)
+	}
+	if _, err := os.Stat(prebuilt); err == nil && runtime.GOOS == "linux" {
+		// zip packages do not keep the executable bit
+		if err := os.Chmod(prebuilt, 0755); err != nil {
+			return fmt.Errorf("failed to make prebuilt binary executable: %s", err)
+		}
+		p.goBinaryPath = prebuilt
+		return nil
+	}
+
+	binaryPath, err := filepath.Abs(path.Join(p.State.WorkingPath, ".bin/plugin"))
+	if err != nil {
+		return fmt.Errorf("failed to find binary: %s", err)
+	}
+
+	// check if the plugin has been built
+	if _, err := os.Stat(binaryPath); err == nil {
+		p.goBinaryPath = binaryPath
+		return nil
+	}
+
+	if _, err := os.Stat(path.Join(p.State.WorkingPath, "go.mod")); err != nil {
+		return fmt.Errorf("failed to find go.mod or prebuilt binary %s", plugin_entities.GoPrebuiltBinary(arch))
+	}
+
+	log.Info("building go plugin %s", p.Config.Identity())
+	p.launchStage(LAUNCH_STAGE_INSTALLING_DEPENDENCIES)
+
+	entrypoint, err := goEntrypoint(p.Config.Meta.Runner.Entrypoint)
+	if err != nil {
+		return err
+	}
+
+	// build the main package which the entrypoint points to
+	ctx, cancel := context.WithTimeout(context.Background(), DEPENDENCY_INSTALL_TIMEOUT)
+	defer cancel()
+
+	cmd := exec.CommandContext(
+		ctx, p.goCompilerPath,
+		"build", "-trimpath", "-o", binaryPath, entrypoint,
+	)
+	cmd.Dir = p.State.WorkingPath
+	cmd.Env = goBuildEnvironment()
+	b := bytes.NewBuffer(nil)
+	cmd.Stdout = b
+	cmd.Stderr = b
+	if err := cmd.Run(); err != nil {
+		os.Remove(binaryPath)
+		return fmt.Errorf("failed to build go plugin: %s, output: %s", err, b.String())
+	}
+
+	p.goBinaryPath = binaryPath
+	return nil
+}
+
+// environment variables the go toolchain needs, other variables of the daemon like its secrets
+// are not passed to the build
+var goBuildEnvironmentKeys = []string{
+	"PATH", "HOME", "TMPDIR", "XDG_CACHE_HOME",
+	"GOPATH", "GOCACHE", "GOMODCACHE", "GOPROXY", "GONOPROXY", "GOPRIVATE",
+	"GOSUMDB", "GONOSUMDB", "GOFLAGS", "GOTOOLCHAIN",
+	"HTTP_PROXY", "HTTPS_PROXY", "NO_PROXY", "http_proxy", "https_proxy", "no_proxy",
+}
+
+// goBuildEnvironment returns the minimal environment of `go build`
+func goBuildEnvironment() []string {
+	env := []string{"CGO_ENABLED=0"}
+	for _, key := range goBuildEnvironmentKeys {
+		if value, ok := os.LookupEnv(key); ok {
+			env = append(env, key+"="+value)
+		}
+	}
+	return env
+}
+
+// goEntrypoint returns the package to build relative to the plugin directory,
+// an entrypoint pointing outside of the plugin directory is rejected
+func goEntrypoint(entrypoint string) (string, error) {
+	cleaned := path.Clean(entrypoint)
+	if path.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("entrypoint %s is outside of the plugin directory", entrypoint)
+	}
+	return "./" + cleaned, nil
+}
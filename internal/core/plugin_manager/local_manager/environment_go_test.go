@@ -0,0 +1,38 @@
+package local_manager
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGoEntrypoint(t *testing.T) {
+	for entrypoint, expected := range map[string]string{
+		"cmd/plugin":    "./cmd/plugin",
+		"./main":        "./main",
+		".":             "./.",
+		"cmd/../plugin": "./plugin",
+	} {
+		if got, err := goEntrypoint(entrypoint); err != nil || got != expected {
+			t.Errorf("expected %s for %s, got %s, %v", expected, entrypoint, got, err)
+		}
+	}
+
+	for _, entrypoint := range []string{"..", "../other", "cmd/../../other", "/usr/lib/go"} {
+		if _, err := goEntrypoint(entrypoint); err == nil {
+			t.Errorf("expected %s to be rejected", entrypoint)
+		}
+	}
+}
+
+func TestGoBuildEnvironmentKeepsSecretsOut(t *testing.T) {
+	t.Setenv("SERVER_KEY", "secret")
+	t.Setenv("GOPROXY", "https://proxy.golang.org")
+
+	env := strings.Join(goBuildEnvironment(), "\n")
+	if strings.Contains(env, "SERVER_KEY") {
+		t.Fatal("expected variables of the daemon not to be passed to the build")
+	}
+	if !strings.Contains(env, "GOPROXY=https://proxy.golang.org") || !strings.Contains(env, "CGO_ENABLED=0") {
+		t.Fatalf("expected variables of the toolchain to be passed to the build, got %s", env)
+	}
+}
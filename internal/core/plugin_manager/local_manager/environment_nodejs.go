@@ -0,0 +1,106 @@
+package local_manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+)
+
+func (p *LocalPluginRuntime) InitNodeEnvironment() error {
+	// check if dependencies have been installed
+	if _, err := os.Stat(path.Join(p.State.WorkingPath, "node_modules")); err == nil {
+		return nil
+	}
+
+	// dependencies are always installed from a lockfile, pnpm is preferred if both exist
+	var packageManager string
+	var installArgs []string
+	if _, err := os.Stat(path.Join(p.State.WorkingPath, "pnpm-lock.yaml")); err == nil {
+		packageManager = "pnpm"
+		installArgs = []string{"install", "--frozen-lockfile", "--ignore-scripts"}
+	} else if _, err := os.Stat(path.Join(p.State.WorkingPath, "package-lock.json")); err == nil {
+		packageManager = "npm"
+		installArgs = []string{"ci", "--ignore-scripts"}
+	} else {
+		return fmt.Errorf("failed to find package-lock.json or pnpm-lock.yaml")
+	}
+
+	packageJson, err := os.ReadFile(path.Join(p.State.WorkingPath, "package.json"))
+	if err != nil {
+		return fmt.Errorf("failed to find package.json: %s", err)
+	}
+
+	var manifest struct {
+		Scripts map[string]string `json:"scripts"`
+	}
+	if err := json.Unmarshal(packageJson, &manifest); err != nil {
+		return fmt.Errorf("failed to parse package.json: %s", err)
+	}
+
+	success := false
+	defer func() {
+		// if init failed, remove the node_modules directory
+		if !success {
+			os.RemoveAll(path.Join(p.State.WorkingPath, "node_modules"))
+		}
+	}()
+
+	// install dependencies, lifecycle scripts of the dependencies are not run
+	p.launchStage(LAUNCH_STAGE_INSTALLING_DEPENDENCIES)
+	ctx, cancel := context.WithTimeout(context.Background(), DEPENDENCY_INSTALL_TIMEOUT)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, packageManager, installArgs...)
+	cmd.Dir = p.State.WorkingPath
+	cmd.Env = nodeBuildEnvironment()
+
+	if err := p.runInstallCommand(cmd, "InitNodeEnvironment"); err != nil {
+		return fmt.Errorf("failed to install dependencies: %s", err)
+	}
+
+	// compile typescript or bundle the plugin if it has a build script
+	if _, ok := manifest.Scripts["build"]; ok {
+		cmd = exec.CommandContext(ctx, packageManager, "run", "build")
+		cmd.Dir = p.State.WorkingPath
+		cmd.Env = nodeBuildEnvironment()
+
+		if err := p.runInstallCommand(cmd, "InitNodeEnvironment"); err != nil {
+			return fmt.Errorf("failed to build plugin: %s", err)
+		}
+	}
+
+	if _, err := os.Stat(path.Join(p.State.WorkingPath, p.Config.Meta.Runner.Entrypoint)); err != nil {
+		return fmt.Errorf("failed to find entrypoint %s: %s", p.Config.Meta.Runner.Entrypoint, err)
+	}
+
+	success = true
+	return nil
+}
+
+// environment variables npm, pnpm and node need, other variables of the daemon like its secrets
+// are not passed to the install and the build, which run third-party code
+var nodeBuildEnvironmentKeys = []string{
+	"PATH", "HOME", "TMPDIR", "XDG_CACHE_HOME", "PNPM_HOME", "NODE_EXTRA_CA_CERTS",
+	"HTTP_PROXY", "HTTPS_PROXY", "NO_PROXY", "http_proxy", "https_proxy", "no_proxy",
+}
+
+// nodeBuildEnvironment returns the minimal environment of installing and building a nodejs plugin,
+// settings of npm and pnpm like the registry are passed as `npm_config_*` variables
+func nodeBuildEnvironment() []string {
+	env := []string{}
+	for _, key := range nodeBuildEnvironmentKeys {
+		if value, ok := os.LookupEnv(key); ok {
+			env = append(env, key+"="+value)
+		}
+	}
+	for _, variable := range os.Environ() {
+		if strings.HasPrefix(strings.ToLower(variable), "npm_config_") {
+			env = append(env, variable)
+		}
+	}
+	return env
+}
@@ -0,0 +1,20 @@
+package local_manager
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNodeBuildEnvironmentKeepsSecretsOut(t *testing.T) {
+	t.Setenv("SERVER_KEY", "secret")
+	t.Setenv("PLUGIN_ENVIRONMENT_SECRET", "secret")
+	t.Setenv("npm_config_registry", "https://registry.npmjs.org")
+
+	env := strings.Join(nodeBuildEnvironment(), "\n")
+	if strings.Contains(env, "SERVER_KEY") || strings.Contains(env, "PLUGIN_ENVIRONMENT_SECRET") {
+		t.Fatal("expected variables of the daemon not to be passed to the install")
+	}
+	if !strings.Contains(env, "npm_config_registry=https://registry.npmjs.org") || !strings.Contains(env, "PATH=") {
+		t.Fatalf("expected variables of the package manager to be passed to the install, got %s", env)
+	}
+}
@@ -9,29 +9,77 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
-	"sync"
-	"time"
 
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
-	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/routine"
 )
 
 func (p *LocalPluginRuntime) InitPythonEnvironment() error {
+	venvPath := path.Join(p.State.WorkingPath, ".venv")
+
 	// check if virtual environment exists
-	if _, err := os.Stat(path.Join(p.State.WorkingPath, ".venv")); err == nil {
-		// setup python interpreter path
-		pythonPath, err := filepath.Abs(path.Join(p.State.WorkingPath, ".venv/bin/python"))
-		if err != nil {
-			return fmt.Errorf("failed to find python: %s", err)
+	if _, err := os.Stat(venvPath); err == nil {
+		return p.usePythonVirtualEnvironment(venvPath)
+	} else if _, err := os.Lstat(venvPath); err == nil {
+		// a link to a snapshot which has been garbage collected
+		os.Remove(venvPath)
+	}
+
+	// find out how dependencies are declared
+	manager, err := detectPythonDependencyManager(p.State.WorkingPath)
+	if err != nil {
+		return err
+	}
+	manager, err = availablePythonDependencyManager(
+		p.State.WorkingPath, manager, p.pythonDependencyConfig.PipFallback,
+	)
+	if err != nil {
+		return err
+	}
+
+	if p.pythonDependencyConfig.CachePath == "" {
+		if err := p.createPythonVirtualEnvironment(venvPath, manager); err != nil {
+			return err
 		}
-		p.pythonInterpreterPath = pythonPath
-		return nil
+		return p.usePythonVirtualEnvironment(venvPath)
+	}
+
+	// share the virtual environment with plugins which have identical dependencies
+	snapshot, err := p.pythonVenvSnapshot(manager)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Symlink(snapshot, venvPath); err != nil {
+		return fmt.Errorf("failed to link virtual environment: %s", err)
+	}
+
+	return p.usePythonVirtualEnvironment(venvPath)
+}
+
+// usePythonVirtualEnvironment setups python interpreter path of the plugin
+func (p *LocalPluginRuntime) usePythonVirtualEnvironment(venvPath string) error {
+	pythonPath, err := filepath.Abs(path.Join(venvPath, "bin/python"))
+	if err != nil {
+		return fmt.Errorf("failed to find python: %s", err)
 	}
+	p.pythonInterpreterPath = pythonPath
+	return nil
+}
+
+// createPythonVirtualEnvironment creates a virtual environment at venvPath and installs dependencies into it,
+// venvPath is removed if anything goes wrong
+func (p *LocalPluginRuntime) createPythonVirtualEnvironment(venvPath string, manager pythonDependencyManager) error {
+	venvPath, err := filepath.Abs(venvPath)
+	if err != nil {
+		return fmt.Errorf("failed to find virtual environment: %s", err)
+	}
+
+	p.launchStage(LAUNCH_STAGE_CREATING_VENV)
 
 	// execute init command, create a virtual environment
 	success := false
 
-	cmd := exec.Command("bash", "-c", fmt.Sprintf("%s -m venv .venv", p.defaultPythonInterpreterPath))
+	cmd := exec.Command(p.defaultPythonInterpreterPath, "-m", "venv", venvPath)
 	cmd.Dir = p.State.WorkingPath
 	b := bytes.NewBuffer(nil)
 	cmd.Stdout = b
@@ -40,141 +88,193 @@ func (p *LocalPluginRuntime) InitPythonEnvironment() error {
 		return fmt.Errorf("failed to create virtual environment: %s, output: %s", err, b.String())
 	}
 	defer func() {
-		// if init failed, remove the .venv directory
+		// if init failed, remove the virtual environment
 		if !success {
-			os.RemoveAll(path.Join(p.State.WorkingPath, ".venv"))
+			os.RemoveAll(venvPath)
 		}
 	}()
 
 	// try find python interpreter and pip
-	pipPath, err := filepath.Abs(path.Join(p.State.WorkingPath, ".venv/bin/pip"))
-	if err != nil {
+	if _, err := os.Stat(path.Join(venvPath, "bin/pip")); err != nil {
 		return fmt.Errorf("failed to find pip: %s", err)
 	}
 
-	pythonPath, err := filepath.Abs(path.Join(p.State.WorkingPath, ".venv/bin/python"))
-	if err != nil {
+	if _, err := os.Stat(path.Join(venvPath, "bin/python")); err != nil {
 		return fmt.Errorf("failed to find python: %s", err)
 	}
 
-	if _, err := os.Stat(pipPath); err != nil {
-		return fmt.Errorf("failed to find pip: %s", err)
+	// install dependencies
+	p.launchStage(LAUNCH_STAGE_INSTALLING_DEPENDENCIES)
+	ctx, cancel := context.WithTimeout(context.Background(), DEPENDENCY_INSTALL_TIMEOUT)
+	defer cancel()
+
+	if err := p.installPythonDependencies(ctx, manager, venvPath); err != nil {
+		if manager.lockfile != "" && isStaleLockfileError(err) {
+			return fmt.Errorf(
+				"%s is out of date with pyproject.toml, lock the dependencies again and repackage the plugin: %s",
+				manager.lockfile, err,
+			)
+		}
+		return fmt.Errorf("failed to install dependencies: %s", err)
 	}
 
-	if _, err := os.Stat(pythonPath); err != nil {
-		return fmt.Errorf("failed to find python: %s", err)
+	success = true
+	return nil
+}
+
+// pythonDependencyManager is the tool used to install dependencies of a python plugin
+type pythonDependencyManager struct {
+	name string
+	// lockfile the installation is pinned to, empty for requirements.txt
+	lockfile string
+}
+
+// dependencyFiles returns the files which decide what gets installed
+func (m pythonDependencyManager) dependencyFiles() []string {
+	if m.lockfile == "" {
+		return []string{"requirements.txt"}
 	}
+	return []string{"pyproject.toml", m.lockfile}
+}
 
-	p.pythonInterpreterPath = pythonPath
+var (
+	PYTHON_DEPENDENCY_MANAGER_UV     = pythonDependencyManager{name: "uv", lockfile: "uv.lock"}
+	PYTHON_DEPENDENCY_MANAGER_POETRY = pythonDependencyManager{name: "poetry", lockfile: "poetry.lock"}
+	PYTHON_DEPENDENCY_MANAGER_PIP    = pythonDependencyManager{name: "pip"}
+)
 
-	// try find requirements.txt
-	requirementsPath := path.Join(p.State.WorkingPath, "requirements.txt")
-	if _, err := os.Stat(requirementsPath); err != nil {
-		return fmt.Errorf("failed to find requirements.txt: %s", err)
+// detectPythonDependencyManager picks the dependency manager by the files in the plugin
+// a lockfile next to pyproject.toml is preferred over requirements.txt to get reproducible installs
+func detectPythonDependencyManager(workingPath string) (pythonDependencyManager, error) {
+	exists := func(name string) bool {
+		_, err := os.Stat(path.Join(workingPath, name))
+		return err == nil
 	}
 
-	// install dependencies
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
-	defer cancel()
+	if exists("pyproject.toml") {
+		if exists("uv.lock") {
+			return PYTHON_DEPENDENCY_MANAGER_UV, nil
+		}
+		if exists("poetry.lock") {
+			return PYTHON_DEPENDENCY_MANAGER_POETRY, nil
+		}
+	}
 
-	cmd = exec.CommandContext(ctx, pipPath, "install", "-r", "requirements.txt")
-	cmd.Dir = p.State.WorkingPath
+	if exists("requirements.txt") {
+		return PYTHON_DEPENDENCY_MANAGER_PIP, nil
+	}
 
-	// get stdout and stderr
-	stdout, err := cmd.StdoutPipe()
-	if err != nil {
-		return fmt.Errorf("failed to get stdout: %s", err)
+	if exists("pyproject.toml") {
+		return pythonDependencyManager{}, fmt.Errorf("failed to find uv.lock or poetry.lock next to pyproject.toml, dependencies must be locked")
+	}
+
+	return pythonDependencyManager{}, fmt.Errorf("failed to find requirements.txt or pyproject.toml")
+}
+
+// installPythonDependencies installs dependencies into the venv,
+// from the package index or only from local wheels if there are any
+func (p *LocalPluginRuntime) installPythonDependencies(
+	ctx context.Context, manager pythonDependencyManager, venvPath string,
+) error {
+	if findLinks := p.pythonFindLinks(); len(findLinks) > 0 {
+		return p.installPythonDependenciesOffline(ctx, manager, venvPath, findLinks)
 	}
-	defer stdout.Close()
 
-	stderr, err := cmd.StderrPipe()
+	cmd, err := p.pythonDependencyCommand(ctx, manager, venvPath)
 	if err != nil {
-		return fmt.Errorf("failed to get stderr: %s", err)
+		return err
+	}
+	cmd.Dir = p.State.WorkingPath
+
+	return p.runInstallCommand(cmd, "InitPythonEnvironment")
+}
+
+// lookPath finds executables of dependency managers, replaced in tests
+var lookPath = exec.LookPath
+
+// availablePythonDependencyManager fails if uv or poetry required by the lockfile is not installed on the daemon,
+// it falls back to pip only if allowed and the plugin ships requirements.txt as well, requirements.txt
+// ignores the lockfile, so the installed versions could differ from the ones of other nodes
+func availablePythonDependencyManager(
+	workingPath string, manager pythonDependencyManager, pipFallback bool,
+) (pythonDependencyManager, error) {
+	if manager.lockfile == "" {
+		return manager, nil
 	}
-	defer stderr.Close()
 
-	// start command
-	if err := cmd.Start(); err != nil {
-		return fmt.Errorf("failed to start command: %s", err)
+	if _, err := lookPath(manager.name); err == nil {
+		return manager, nil
 	}
-	defer func() {
-		if cmd.Process != nil {
-			cmd.Process.Kill()
-		}
-	}()
 
-	var err_msg strings.Builder
-	var wg sync.WaitGroup
-	wg.Add(2)
-
-	lastActiveAt := time.Now()
-
-	routine.Submit(map[string]string{
-		"module":   "plugin_manager",
-		"function": "InitPythonEnvironment",
-	}, func() {
-		defer wg.Done()
-		// read stdout
-		buf := make([]byte, 1024)
-		for {
-			n, err := stdout.Read(buf)
-			if err != nil {
-				break
-			}
-			log.Info("installing %s - %s", p.Config.Identity(), string(buf[:n]))
-			lastActiveAt = time.Now()
+	if pipFallback {
+		if _, err := os.Stat(path.Join(workingPath, "requirements.txt")); err == nil {
+			log.Warn("%s is not installed on the daemon, installing dependencies from requirements.txt instead of %s",
+				manager.name, manager.lockfile,
+			)
+			return PYTHON_DEPENDENCY_MANAGER_PIP, nil
 		}
-	})
-
-	routine.Submit(map[string]string{
-		"module":   "plugin_manager",
-		"function": "InitPythonEnvironment",
-	}, func() {
-		defer wg.Done()
-		// read stderr
-		buf := make([]byte, 1024)
-		for {
-			n, err := stderr.Read(buf)
-			if err != nil && err != os.ErrClosed {
-				lastActiveAt = time.Now()
-				err_msg.WriteString(string(buf[:n]))
-				break
-			} else if err == os.ErrClosed {
-				break
-			}
-
-			if n > 0 {
-				err_msg.WriteString(string(buf[:n]))
-				lastActiveAt = time.Now()
-			}
+	}
+
+	return manager, fmt.Errorf("%s is required by %s but not installed on the daemon", manager.name, manager.lockfile)
+}
+
+// lookupPythonDependencyManager finds the executable of uv or poetry
+func lookupPythonDependencyManager(manager pythonDependencyManager) (string, error) {
+	executable, err := lookPath(manager.name)
+	if err != nil {
+		return "", fmt.Errorf(
+			"plugin is locked by %s but %s is not installed on the daemon", manager.lockfile, manager.name,
+		)
+	}
+	return executable, nil
+}
+
+// pythonDependencyCommand returns the command which installs dependencies into the venv
+// uv and poetry verify the hashes recorded in the lockfile and refuse a lockfile out of date,
+// pip verifies hashes if requirements.txt contains any
+func (p *LocalPluginRuntime) pythonDependencyCommand(
+	ctx context.Context, manager pythonDependencyManager, venvPath string,
+) (*exec.Cmd, error) {
+	var cmd *exec.Cmd
+	switch manager {
+	case PYTHON_DEPENDENCY_MANAGER_PIP:
+		cmd = exec.CommandContext(ctx, path.Join(venvPath, "bin/pip"), "install", "-r", "requirements.txt")
+	case PYTHON_DEPENDENCY_MANAGER_UV:
+		uvPath, err := lookupPythonDependencyManager(manager)
+		if err != nil {
+			return nil, err
 		}
-	})
-
-	routine.Submit(map[string]string{
-		"module":   "plugin_manager",
-		"function": "InitPythonEnvironment",
-	}, func() {
-		ticker := time.NewTicker(5 * time.Second)
-		defer ticker.Stop()
-		for range ticker.C {
-			if cmd.ProcessState != nil && cmd.ProcessState.Exited() {
-				break
-			}
-
-			if time.Since(lastActiveAt) > 60*time.Second {
-				cmd.Process.Kill()
-				err_msg.WriteString("init process exited due to long time no activity")
-				break
-			}
+		cmd = exec.CommandContext(
+			ctx, uvPath, "sync",
+			"--locked", "--no-dev", "--no-install-project",
+			"--python", path.Join(venvPath, "bin/python"),
+		)
+	case PYTHON_DEPENDENCY_MANAGER_POETRY:
+		poetryPath, err := lookupPythonDependencyManager(manager)
+		if err != nil {
+			return nil, err
 		}
-	})
+		cmd = exec.CommandContext(ctx, poetryPath, "install", "--no-root", "--only", "main", "--no-interaction")
+	default:
+		return nil, fmt.Errorf("unsupported python dependency manager: %s", manager.name)
+	}
 
-	wg.Wait()
+	// uv and poetry install into the active venv
+	cmd.Env = append(
+		os.Environ(),
+		"VIRTUAL_ENV="+venvPath,
+		"UV_PROJECT_ENVIRONMENT="+venvPath,
+		"POETRY_VIRTUALENVS_CREATE=false",
+	)
+	cmd.Env = append(cmd.Env, p.pythonDownloadCacheEnv()...)
 
-	if err := cmd.Wait(); err != nil {
-		return fmt.Errorf("failed to install dependencies: %s, output: %s", err, err_msg.String())
-	}
+	return cmd, nil
+}
 
-	success = true
-	return nil
+// isStaleLockfileError checks if the installation failed because the lockfile does not match pyproject.toml
+func isStaleLockfileError(err error) bool {
+	message := err.Error()
+	return strings.Contains(message, "needs to be updated") || // uv
+		strings.Contains(message, "changed significantly since poetry.lock") || // poetry 1.x
+		strings.Contains(message, "Run `poetry lock` to fix") // poetry 2.x
 }
@@ -0,0 +1,112 @@
+package local_manager
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+)
+
+const (
+	// wheels vendored in the plugin package, installed without the package index
+	PYTHON_VENDORED_WHEELS_DIR = "wheels"
+)
+
+// pythonFindLinks returns directories of wheels the plugin is installed from,
+// it's empty if the package index should be used
+func (p *LocalPluginRuntime) pythonFindLinks() []string {
+	findLinks := []string{}
+
+	vendored := path.Join(p.State.WorkingPath, PYTHON_VENDORED_WHEELS_DIR)
+	if stat, err := os.Stat(vendored); err == nil && stat.IsDir() {
+		if vendored, err := filepath.Abs(vendored); err == nil {
+			findLinks = append(findLinks, vendored)
+		}
+	}
+
+	if p.pythonDependencyConfig.LocalIndexPath != "" {
+		if localIndex, err := filepath.Abs(p.pythonDependencyConfig.LocalIndexPath); err == nil {
+			findLinks = append(findLinks, localIndex)
+		}
+	}
+
+	return findLinks
+}
+
+// installPythonDependenciesOffline installs dependencies only from the wheels in findLinks,
+// lockfiles are exported to requirements with hashes so that pip verifies the wheels against them
+func (p *LocalPluginRuntime) installPythonDependenciesOffline(
+	ctx context.Context, manager pythonDependencyManager, venvPath string, findLinks []string,
+) error {
+	requirements := path.Join(p.State.WorkingPath, "requirements.txt")
+
+	if manager.lockfile != "" {
+		exported, err := os.CreateTemp("", "mlchain-requirements-*.txt")
+		if err != nil {
+			return fmt.Errorf("failed to export %s: %s", manager.lockfile, err)
+		}
+		exported.Close()
+		defer os.Remove(exported.Name())
+
+		cmd, err := p.pythonExportCommand(ctx, manager, exported.Name())
+		if err != nil {
+			return err
+		}
+		cmd.Dir = p.State.WorkingPath
+
+		if err := p.runInstallCommand(cmd, "InitPythonEnvironment"); err != nil {
+			return err
+		}
+
+		requirements = exported.Name()
+	}
+
+	cmd := exec.CommandContext(ctx, path.Join(venvPath, "bin/pip"), pipOfflineInstallArgs(requirements, findLinks)...)
+	cmd.Dir = p.State.WorkingPath
+	cmd.Env = append(os.Environ(), "PIP_NO_INPUT=1")
+
+	return p.runInstallCommand(cmd, "InitPythonEnvironment")
+}
+
+// pythonExportCommand returns the command which exports the lockfile to requirements.txt format,
+// it fails just like installing if the lockfile is out of date
+func (p *LocalPluginRuntime) pythonExportCommand(
+	ctx context.Context, manager pythonDependencyManager, output string,
+) (*exec.Cmd, error) {
+	executable, err := lookupPythonDependencyManager(manager)
+	if err != nil {
+		return nil, err
+	}
+
+	var cmd *exec.Cmd
+	switch manager {
+	case PYTHON_DEPENDENCY_MANAGER_UV:
+		cmd = exec.CommandContext(
+			ctx, executable, "export",
+			"--locked", "--no-dev", "--no-emit-project",
+			"--format", "requirements-txt", "--output-file", output,
+		)
+	case PYTHON_DEPENDENCY_MANAGER_POETRY:
+		cmd = exec.CommandContext(
+			ctx, executable, "export",
+			"--only", "main", "--format", "requirements.txt", "--output", output,
+		)
+	default:
+		return nil, fmt.Errorf("unsupported python dependency manager: %s", manager.name)
+	}
+
+	cmd.Env = append(os.Environ(), "UV_OFFLINE=1")
+	cmd.Env = append(cmd.Env, p.pythonDownloadCacheEnv()...)
+
+	return cmd, nil
+}
+
+func pipOfflineInstallArgs(requirements string, findLinks []string) []string {
+	args := []string{"install", "--no-index"}
+	for _, findLink := range findLinks {
+		args = append(args, "--find-links", findLink)
+	}
+	return append(args, "-r", requirements)
+}
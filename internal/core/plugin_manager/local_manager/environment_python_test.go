@@ -0,0 +1,111 @@
+package local_manager
+
+import (
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestDetectPythonDependencyManager(t *testing.T) {
+	cases := []struct {
+		files   []string
+		manager pythonDependencyManager
+		fails   bool
+	}{
+		{files: []string{"requirements.txt"}, manager: PYTHON_DEPENDENCY_MANAGER_PIP},
+		{files: []string{"pyproject.toml", "uv.lock"}, manager: PYTHON_DEPENDENCY_MANAGER_UV},
+		{files: []string{"pyproject.toml", "poetry.lock"}, manager: PYTHON_DEPENDENCY_MANAGER_POETRY},
+		{files: []string{"pyproject.toml", "uv.lock", "requirements.txt"}, manager: PYTHON_DEPENDENCY_MANAGER_UV},
+		{files: []string{"pyproject.toml", "requirements.txt"}, manager: PYTHON_DEPENDENCY_MANAGER_PIP},
+		{files: []string{"uv.lock", "requirements.txt"}, manager: PYTHON_DEPENDENCY_MANAGER_PIP},
+		{files: []string{"pyproject.toml"}, fails: true},
+		{files: []string{}, fails: true},
+	}
+
+	for _, c := range cases {
+		dir := t.TempDir()
+		for _, file := range c.files {
+			if err := os.WriteFile(path.Join(dir, file), nil, 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		manager, err := detectPythonDependencyManager(dir)
+		if c.fails {
+			if err == nil {
+				t.Errorf("%v: expected error, got %s", c.files, manager.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: %s", c.files, err)
+			continue
+		}
+		if manager != c.manager {
+			t.Errorf("%v: expected %s, got %s", c.files, c.manager.name, manager.name)
+		}
+	}
+}
+
+func TestPythonFindLinks(t *testing.T) {
+	r := NewLocalPluginRuntime("", "", "", PoolConfig{}, CgroupConfig{}, SandboxConfig{}, PythonDependencyConfig{}, HealthConfig{})
+	r.State.WorkingPath = t.TempDir()
+
+	if links := r.pythonFindLinks(); len(links) != 0 {
+		t.Fatalf("package index should be used without wheels, got %v", links)
+	}
+
+	if err := os.Mkdir(path.Join(r.State.WorkingPath, PYTHON_VENDORED_WHEELS_DIR), 0755); err != nil {
+		t.Fatal(err)
+	}
+	localIndex := t.TempDir()
+	r.pythonDependencyConfig.LocalIndexPath = localIndex
+
+	links := r.pythonFindLinks()
+	if len(links) != 2 || links[0] != path.Join(r.State.WorkingPath, PYTHON_VENDORED_WHEELS_DIR) || links[1] != localIndex {
+		t.Fatalf("unexpected find links: %v", links)
+	}
+
+	args := strings.Join(pipOfflineInstallArgs("requirements.txt", links), " ")
+	if !strings.HasPrefix(args, "install --no-index ") || !strings.HasSuffix(args, " -r requirements.txt") {
+		t.Fatalf("unexpected pip arguments: %s", args)
+	}
+}
+
+func TestAvailablePythonDependencyManager(t *testing.T) {
+	defer func(original func(string) (string, error)) { lookPath = original }(lookPath)
+	lookPath = func(name string) (string, error) {
+		if name == "uv" {
+			return "/usr/bin/uv", nil
+		}
+		return "", exec.ErrNotFound
+	}
+
+	dir := t.TempDir()
+	if manager, err := availablePythonDependencyManager(dir, PYTHON_DEPENDENCY_MANAGER_UV, false); err != nil ||
+		manager != PYTHON_DEPENDENCY_MANAGER_UV {
+		t.Fatalf("expected uv to be used when it's installed, got %s, %v", manager.name, err)
+	}
+
+	// nothing to fall back to, the installation reports the missing tool
+	if _, err := availablePythonDependencyManager(dir, PYTHON_DEPENDENCY_MANAGER_POETRY, true); err == nil {
+		t.Fatal("expected poetry to be required without requirements.txt")
+	}
+
+	if err := os.WriteFile(path.Join(dir, "requirements.txt"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// the lockfile is not given up unless allowed
+	if _, err := availablePythonDependencyManager(dir, PYTHON_DEPENDENCY_MANAGER_POETRY, false); err == nil ||
+		!strings.Contains(err.Error(), "poetry is required by poetry.lock") {
+		t.Fatalf("expected poetry to be required by the lockfile, got %v", err)
+	}
+
+	if manager, err := availablePythonDependencyManager(dir, PYTHON_DEPENDENCY_MANAGER_POETRY, true); err != nil ||
+		manager != PYTHON_DEPENDENCY_MANAGER_PIP {
+		t.Fatalf("expected pip to be used when poetry is missing, got %s, %v", manager.name, err)
+	}
+}
@@ -0,0 +1,91 @@
+package local_manager
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+)
+
+const (
+	// the plugin is told how often to send heartbeats through it, in seconds
+	HEARTBEAT_INTERVAL_ENV = "MLCHAIN_PLUGIN_HEARTBEAT_INTERVAL"
+
+	// used when the manifest does not declare them
+	DEFAULT_HEARTBEAT_INTERVAL = 5 * time.Second
+	DEFAULT_HEARTBEAT_TIMEOUT  = 60 * time.Second
+	DEFAULT_READINESS_TIMEOUT  = 120 * time.Second
+)
+
+// HealthConfig bounds the health check a plugin could declare in its manifest
+type HealthConfig struct {
+	HeartbeatIntervalMin time.Duration
+	HeartbeatIntervalMax time.Duration
+	HeartbeatTimeoutMin  time.Duration
+	HeartbeatTimeoutMax  time.Duration
+	ReadinessTimeoutMax  time.Duration
+}
+
+// healthCheck is how a process of the plugin is checked
+type healthCheck struct {
+	heartbeatInterval time.Duration
+	heartbeatTimeout  time.Duration
+	// the process receives no session until it reports ready
+	readiness        bool
+	readinessTimeout time.Duration
+}
+
+func defaultHealthCheck() healthCheck {
+	return healthCheck{
+		heartbeatInterval: DEFAULT_HEARTBEAT_INTERVAL,
+		heartbeatTimeout:  DEFAULT_HEARTBEAT_TIMEOUT,
+		readinessTimeout:  DEFAULT_READINESS_TIMEOUT,
+	}
+}
+
+// resolve applies the bounds to the health check declared by the plugin, a zero bound is not applied
+func (c HealthConfig) resolve(declared *plugin_entities.PluginHealth) healthCheck {
+	check := defaultHealthCheck()
+	if declared != nil {
+		if declared.HeartbeatInterval > 0 {
+			check.heartbeatInterval = time.Duration(declared.HeartbeatInterval) * time.Second
+		}
+		if declared.HeartbeatTimeout > 0 {
+			check.heartbeatTimeout = time.Duration(declared.HeartbeatTimeout) * time.Second
+		}
+		if declared.ReadinessTimeout > 0 {
+			check.readinessTimeout = time.Duration(declared.ReadinessTimeout) * time.Second
+		}
+		check.readiness = declared.Readiness
+	}
+
+	check.heartbeatInterval = clampDuration(check.heartbeatInterval, c.HeartbeatIntervalMin, c.HeartbeatIntervalMax)
+	check.heartbeatTimeout = clampDuration(check.heartbeatTimeout, c.HeartbeatTimeoutMin, c.HeartbeatTimeoutMax)
+	check.readinessTimeout = clampDuration(check.readinessTimeout, 0, c.ReadinessTimeoutMax)
+
+	// a single late heartbeat should never kill the process, the interval is shortened rather than
+	// raising the timeout beyond its bound, the plugin is told the interval in whole seconds
+	if c.HeartbeatTimeoutMax > 0 && 2*check.heartbeatInterval > c.HeartbeatTimeoutMax {
+		check.heartbeatInterval = max((c.HeartbeatTimeoutMax / 2).Truncate(time.Second), time.Second)
+	}
+	if check.heartbeatTimeout < 2*check.heartbeatInterval {
+		check.heartbeatTimeout = 2 * check.heartbeatInterval
+	}
+
+	return check
+}
+
+// env tells the plugin how it's checked
+func (c healthCheck) env() string {
+	return fmt.Sprintf("%s=%d", HEARTBEAT_INTERVAL_ENV, int(c.heartbeatInterval/time.Second))
+}
+
+func clampDuration(d time.Duration, min time.Duration, max time.Duration) time.Duration {
+	if min > 0 && d < min {
+		return min
+	}
+	if max > 0 && d > max {
+		return max
+	}
+	return d
+}
@@ -0,0 +1,53 @@
+package local_manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+)
+
+func TestHealthConfigResolve(t *testing.T) {
+	config := HealthConfig{
+		HeartbeatIntervalMin: time.Second,
+		HeartbeatIntervalMax: 10 * time.Second,
+		HeartbeatTimeoutMin:  10 * time.Second,
+		HeartbeatTimeoutMax:  60 * time.Second,
+		ReadinessTimeoutMax:  300 * time.Second,
+	}
+
+	check := config.resolve(nil)
+	if check != defaultHealthCheck() {
+		t.Fatalf("expected defaults without declaration, got %+v", check)
+	}
+
+	check = config.resolve(&plugin_entities.PluginHealth{
+		HeartbeatInterval: 30,
+		HeartbeatTimeout:  3600,
+		Readiness:         true,
+		ReadinessTimeout:  3600,
+	})
+	if check.heartbeatInterval != 10*time.Second {
+		t.Fatalf("expected interval to be clamped, got %s", check.heartbeatInterval)
+	}
+	if check.heartbeatTimeout != 60*time.Second {
+		t.Fatalf("expected timeout to be clamped, got %s", check.heartbeatTimeout)
+	}
+	if !check.readiness || check.readinessTimeout != 300*time.Second {
+		t.Fatalf("expected readiness timeout to be clamped, got %+v", check)
+	}
+
+	// a timeout shorter than two intervals is raised
+	check = config.resolve(&plugin_entities.PluginHealth{HeartbeatInterval: 8, HeartbeatTimeout: 10})
+	if check.heartbeatTimeout != 16*time.Second {
+		t.Fatalf("expected timeout of two intervals, got %s", check.heartbeatTimeout)
+	}
+
+	// the timeout never exceeds its bound, the interval is shortened instead
+	config.HeartbeatIntervalMax = 60 * time.Second
+	config.HeartbeatTimeoutMax = 15 * time.Second
+	check = config.resolve(&plugin_entities.PluginHealth{HeartbeatInterval: 60})
+	if check.heartbeatInterval != 7*time.Second || check.heartbeatTimeout != 15*time.Second {
+		t.Fatalf("expected interval to give way to the max timeout, got %+v", check)
+	}
+}
@@ -0,0 +1,288 @@
+package local_manager
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/log_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/plugin_errors"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/cgroup"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/routine"
+)
+
+// pluginInstance is a single process of a local plugin
+type pluginInstance struct {
+	// id of the stdio holder which talks to the process
+	ioIdentity string
+	pid        int
+	startedAt  time.Time
+
+	// in-flight sessions dispatched to this instance
+	sessions int
+
+	// the last time this instance became idle
+	idleSince time.Time
+
+	// an instance accepts sessions once it's ready, right after it's started
+	// unless the plugin declares readiness
+	ready bool
+
+	// a retiring instance accepts no new sessions and is going to be stopped
+	retiring bool
+	// a replaced instance keeps serving until a replacement is ready,
+	// then it retires and is stopped once its sessions finish
+	replaced bool
+
+	// set once the daemon stops the process, any other exit is a crash
+	stopping atomic.Bool
+
+	// cgroup leaf of the process, nil if cgroup is disabled
+	cgroup *cgroup.Cgroup
+	// the last collected cgroup events
+	events cgroup.Events
+	// whether the process has been killed by the OOM killer
+	oomKilled bool
+}
+
+// startInstance launches a new process of the plugin and adds it to the pool
+// the process is removed from the pool and reported to `exited` once it exits
+func (r *LocalPluginRuntime) startInstance(exited chan<- *pluginInstance) (*pluginInstance, error) {
+	e, err := r.getCmd()
+	if err != nil {
+		return nil, err
+	}
+
+	r.instanceLock.RLock()
+	poolDone := r.poolDone
+	r.instanceLock.RUnlock()
+
+	e.Dir = r.State.WorkingPath
+	health := r.healthConfig.resolve(r.Config.Meta.Health)
+	// configured environment goes first, so the variables below could not be overridden
+	e.Env = append(e.Env, r.getEnvironment()...)
+	// add env INSTALL_METHOD=local
+	e.Env = append(e.Env, "INSTALL_METHOD=local", "PATH="+os.Getenv("PATH"), stdioProtocolsAdvertised, health.env())
+
+	if r.sandboxConfig.Enabled {
+		e, err = r.sandboxCmd(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// get writer
+	stdin, err := e.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("get stdin pipe failed: %s", err.Error())
+	}
+
+	// get stdout
+	stdout, err := e.StdoutPipe()
+	if err != nil {
+		stdin.Close()
+		return nil, fmt.Errorf("get stdout pipe failed: %s", err.Error())
+	}
+
+	// get stderr
+	stderr, err := e.StderrPipe()
+	if err != nil {
+		stdin.Close()
+		stdout.Close()
+		return nil, fmt.Errorf("get stderr pipe failed: %s", err.Error())
+	}
+
+	// apply resource limits, the process is started right inside its cgroup
+	cg, cgroupDir, err := r.setupCgroup(e)
+	if err != nil {
+		stdin.Close()
+		stdout.Close()
+		stderr.Close()
+		return nil, fmt.Errorf("setup cgroup failed: %s", err.Error())
+	}
+
+	err = e.Start()
+	if cgroupDir != nil {
+		cgroupDir.Close()
+	}
+	if err != nil {
+		stdin.Close()
+		stdout.Close()
+		stderr.Close()
+		if cg != nil {
+			cg.Delete()
+		}
+		return nil, fmt.Errorf("start plugin failed: %s", err.Error())
+	}
+
+	// setup stdio
+	stdio := registerStdioHandler(r.Config.Identity(), stdin, stdout, stderr)
+	stdio.logStore = r.logStore
+	stdio.health = health
+	if r.stdioConfig.MaxEventSize > 0 {
+		stdio.maxEventSize = r.stdioConfig.MaxEventSize
+	}
+
+	instance := &pluginInstance{
+		ioIdentity: stdio.GetID(),
+		pid:        e.Process.Pid,
+		startedAt:  time.Now(),
+		ready:      !health.readiness,
+		idleSince:  time.Now(),
+		cgroup:     cg,
+	}
+
+	r.instanceLock.Lock()
+	r.instances = append(r.instances, instance)
+	r.instanceLock.Unlock()
+
+	log.Info("plugin %s started, instance: %s", r.Config.Identity(), instance.ioIdentity)
+	r.lifecycle(log_manager.LOG_LEVEL_INFO, "instance %s started", instance.ioIdentity)
+
+	// stderr is drained until the process is reaped, only stdout is waited for
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+
+	// listen to plugin stdout
+	routine.Submit(map[string]string{
+		"module":   "plugin_manager",
+		"type":     "local",
+		"function": "StartStdout",
+	}, func() {
+		defer wg.Done()
+		stdio.StartStdout(func() {}, func() {
+			r.markReady(instance)
+		})
+	})
+
+	// listen to plugin stderr
+	stderrDone := make(chan bool)
+	routine.Submit(map[string]string{
+		"module":   "plugin_manager",
+		"type":     "local",
+		"function": "StartStderr",
+	}, func() {
+		defer close(stderrDone)
+		stdio.StartStderr()
+	})
+
+	// wait for the process to exit
+	routine.Submit(map[string]string{
+		"module":   "plugin_manager",
+		"type":     "local",
+		"function": "WaitInstance",
+	}, func() {
+		defer func() {
+			r.removeInstance(instance)
+			select {
+			case exited <- instance:
+			case <-poolDone:
+			}
+		}()
+
+		// why the daemon killed the process if it did
+		var reason string
+
+		defer func() {
+			// wait for plugin to exit
+			if err := e.Wait(); err != nil {
+				log.Error("plugin %s exited with error: %s", r.Config.Identity(), err.Error())
+				r.lifecycle(log_manager.LOG_LEVEL_ERROR, "instance %s exited with error: %s", instance.ioIdentity, err.Error())
+			} else {
+				r.lifecycle(log_manager.LOG_LEVEL_INFO, "instance %s exited", instance.ioIdentity)
+			}
+
+			if instance.cgroup != nil {
+				// check if the process was killed by the OOM killer before removing the cgroup
+				r.collectCgroupEvents(instance)
+			}
+
+			if !instance.stopping.Load() && !r.Stopped() {
+				// the rest of stderr is read once the process is reaped
+				select {
+				case <-stderrDone:
+				case <-time.After(CRASH_STDERR_FLUSH_TIMEOUT):
+				}
+
+				if instance.oomKilled {
+					reason = "killed by the OOM killer"
+				}
+
+				exitCode, signal := exitStatus(e.ProcessState)
+				sessions := r.instanceSessions(instance)
+				r.reportCrash(instance, stdio, CrashReport{
+					PluginUniqueIdentifier: r.Config.Identity(),
+					InstanceID:             instance.ioIdentity,
+					Pid:                    instance.pid,
+					ExitCode:               exitCode,
+					Signal:                 signal,
+					Reason:                 reason,
+					Stderr:                 stdio.StderrTail(),
+					Uptime:                 time.Since(instance.startedAt),
+					Restarts:               r.State.Restarts,
+					Sessions:               sessions,
+					SessionTenants:         sessionTenants(sessions),
+					CrashedAt:              time.Now(),
+				})
+			}
+
+			removeStdioHandler(stdio.GetID())
+
+			if instance.cgroup != nil {
+				if err := instance.cgroup.Delete(); err != nil {
+					log.Error("remove cgroup of plugin %s failed: %s", r.Config.Identity(), err.Error())
+				}
+			}
+		}()
+
+		// ensure the plugin process is killed after the plugin exits
+		defer e.Process.Kill()
+		defer stdio.Stop()
+
+		if err := stdio.Wait(); err != nil {
+			log.Error("plugin %s instance %s exited: %s", r.Config.Identity(), instance.ioIdentity, err.Error())
+			r.lifecycle(log_manager.LOG_LEVEL_ERROR, "instance %s stopped: %s", instance.ioIdentity, err.Error())
+			if errors.Is(err, plugin_errors.ErrPluginNotActive) || errors.Is(err, plugin_errors.ErrPluginNotReady) {
+				reason = err.Error()
+			}
+			return
+		}
+
+		wg.Wait()
+	})
+
+	return instance, nil
+}
+
+// removeInstance removes the instance from the pool, sessions bound to it are released
+func (r *LocalPluginRuntime) removeInstance(instance *pluginInstance) {
+	r.instanceLock.Lock()
+	defer r.instanceLock.Unlock()
+
+	for i, v := range r.instances {
+		if v == instance {
+			r.instances = append(r.instances[:i], r.instances[i+1:]...)
+			break
+		}
+	}
+
+	for sessionId, v := range r.sessionInstances {
+		if v == instance {
+			delete(r.sessionInstances, sessionId)
+		}
+	}
+}
+
+// stopInstance stops the process of the instance, it exits asynchronously
+func (r *LocalPluginRuntime) stopInstance(instance *pluginInstance) {
+	instance.stopping.Store(true)
+
+	stdio := getStdioHandler(instance.ioIdentity)
+	if stdio != nil {
+		stdio.Stop()
+	}
+}
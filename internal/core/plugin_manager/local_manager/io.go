@@ -1,24 +1,47 @@
 package local_manager
 
 import (
+	"fmt"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/session_manager"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/parser"
 )
 
+const (
+	// error type of sessions which could not be dispatched to any instance
+	NO_INSTANCE_ERROR_TYPE = "PluginUnavailableError"
+)
+
 func (r *LocalPluginRuntime) Listen(session_id string) *entities.Broadcast[plugin_entities.SessionMessage] {
 	listener := entities.NewBroadcast[plugin_entities.SessionMessage]()
+
+	// a parked pool is started again before the session is dispatched to an instance of it
+	instance := r.wakeAndBindSession(session_id)
+	if instance == nil {
+		// nobody listens yet, the session is failed by its first write
+		log.Error("no instance of plugin %s is available for session %s", r.Config.Identity(), session_id)
+		r.unboundSessions.Store(session_id, listener)
+		listener.OnClose(func() {
+			r.unboundSessions.Delete(session_id)
+		})
+		return listener
+	}
+
 	listener.OnClose(func() {
-		removeStdioHandlerListener(r.ioIdentity, session_id)
+		removeStdioHandlerListener(instance.ioIdentity, session_id)
+		r.releaseSession(session_id)
 	})
-	setupStdioEventListener(r.ioIdentity, session_id, func(b []byte) {
+	setupStdioEventListener(instance.ioIdentity, session_id, func(b []byte, blob *plugin_entities.SessionBlob) {
 		// unmarshal the session message
 		data, err := parser.UnmarshalJsonBytes[plugin_entities.SessionMessage](b)
 		if err != nil {
 			log.Error("unmarshal json failed: %s, failed to parse session message", err.Error())
 			return
 		}
+		data.Blob = blob
 
 		listener.Send(data)
 	})
@@ -26,5 +49,68 @@ func (r *LocalPluginRuntime) Listen(session_id string) *entities.Broadcast[plugi
 }
 
 func (r *LocalPluginRuntime) Write(session_id string, data []byte) {
-	writeToStdioHandler(r.ioIdentity, append(data, '\n'))
+	instance := r.sessionInstance(session_id)
+	if instance == nil {
+		r.failUnboundSession(session_id)
+		return
+	}
+
+	writeToStdioHandler(instance.ioIdentity, data)
+}
+
+// AcceptsCancel returns true if the instance running the session handles cancel events, sdks which
+// announce their stdio protocol do, older ones may take the event for a malformed request
+func (r *LocalPluginRuntime) AcceptsCancel(session_id string) bool {
+	instance := r.sessionInstance(session_id)
+	if instance == nil {
+		return false
+	}
+
+	holder := getStdioHandler(instance.ioIdentity)
+	return holder != nil && holder.protocolAnnounced.Load()
+}
+
+// failUnboundSession ends a session which could not be bound to any instance with an error,
+// writes to a session which has already been released are dropped
+func (r *LocalPluginRuntime) failUnboundSession(session_id string) {
+	listener, ok := r.unboundSessions.Load(session_id)
+	if !ok {
+		return
+	}
+	r.unboundSessions.Delete(session_id)
+
+	listener.Send(plugin_entities.SessionMessage{
+		Type: plugin_entities.SESSION_MESSAGE_TYPE_ERROR,
+		Data: parser.MarshalJsonBytes(plugin_entities.ErrorResponse{
+			ErrorType: NO_INSTANCE_ERROR_TYPE,
+			Message:   fmt.Sprintf("no instance of plugin %s is available", r.Config.Identity()),
+		}),
+	})
+	listener.Close()
+}
+
+// sessionTenant returns the tenant which owns the session, empty if the session is gone
+func sessionTenant(session_id string) string {
+	if session_id == "" {
+		return ""
+	}
+
+	session := session_manager.GetSession(session_manager.GetSessionPayload{ID: session_id})
+	if session == nil {
+		return ""
+	}
+
+	return session.TenantID
+}
+
+// sessionTenants returns the tenants which own the sessions by their ids
+func sessionTenants(sessions []string) map[string]string {
+	tenants := map[string]string{}
+	for _, session_id := range sessions {
+		if tenant := sessionTenant(session_id); tenant != "" {
+			tenants[session_id] = tenant
+		}
+	}
+
+	return tenants
 }
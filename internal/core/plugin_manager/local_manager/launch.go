@@ -0,0 +1,87 @@
+package local_manager
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// LaunchStage is a step of launching the plugin
+type LaunchStage string
+
+const (
+	LAUNCH_STAGE_EXTRACTING              LaunchStage = "extracting"
+	LAUNCH_STAGE_CREATING_VENV           LaunchStage = "creating_venv"
+	LAUNCH_STAGE_INSTALLING_DEPENDENCIES LaunchStage = "installing_dependencies"
+	LAUNCH_STAGE_STARTING                LaunchStage = "starting"
+	LAUNCH_STAGE_READY                   LaunchStage = "ready"
+)
+
+const (
+	// installing dependencies or building the plugin is given up after it
+	DEPENDENCY_INSTALL_TIMEOUT = 10 * time.Minute
+	// extracting the package and creating the environment besides installing dependencies
+	LAUNCH_PREPARE_TIMEOUT = 2 * time.Minute
+)
+
+// LaunchHandler is notified when the launch enters a stage, err is set if the stage failed
+// with the output of the failed command or the stderr of the crashed process
+type LaunchHandler func(stage LaunchStage, err error)
+
+// AddLaunchHandler adds a handler of the launch of the plugin, handlers are dropped once the plugin
+// is ready or the launch failed, a handler added in the middle of the launch is told the current stage first,
+// and it's told the plugin is ready right away if it has been ready
+func (r *LocalPluginRuntime) AddLaunchHandler(handler LaunchHandler) {
+	r.launchLock.Lock()
+	defer r.launchLock.Unlock()
+
+	if r.currentLaunchStage == LAUNCH_STAGE_READY {
+		handler(LAUNCH_STAGE_READY, nil)
+		return
+	}
+
+	if r.currentLaunchStage != "" {
+		handler(r.currentLaunchStage, nil)
+	}
+	r.launchHandlers = append(r.launchHandlers, handler)
+}
+
+// launchStage reports the stage the launch has entered
+func (r *LocalPluginRuntime) launchStage(stage LaunchStage) {
+	r.launchLock.Lock()
+	defer r.launchLock.Unlock()
+
+	r.currentLaunchStage = stage
+	for _, handler := range r.launchHandlers {
+		handler(stage, nil)
+	}
+	if stage == LAUNCH_STAGE_READY {
+		r.launchHandlers = nil
+	}
+}
+
+// launchFailed reports the current stage as failed
+func (r *LocalPluginRuntime) launchFailed(err error) {
+	r.launchLock.Lock()
+	defer r.launchLock.Unlock()
+
+	for _, handler := range r.launchHandlers {
+		handler(r.currentLaunchStage, err)
+	}
+	r.launchHandlers = nil
+}
+
+// launchCrashed reports a crash of a process before the plugin is ready
+func (r *LocalPluginRuntime) launchCrashed(report CrashReport) {
+	message := fmt.Sprintf("plugin process crashed, %s", report.Summary())
+	if len(report.Stderr) > 0 {
+		message += ", stderr:\n" + strings.Join(report.Stderr, "\n")
+	}
+
+	r.launchFailed(fmt.Errorf("%s", message))
+}
+
+// LaunchTimeout is how long the launch could take at most until the plugin is ready
+func (r *LocalPluginRuntime) LaunchTimeout() time.Duration {
+	return LAUNCH_PREPARE_TIMEOUT + DEPENDENCY_INSTALL_TIMEOUT + r.healthConfig.resolve(r.Config.Meta.Health).readinessTimeout
+}
@@ -0,0 +1,102 @@
+package local_manager
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLaunchHandler(t *testing.T) {
+	r := &LocalPluginRuntime{}
+
+	stages := []LaunchStage{}
+	var failure error
+	r.AddLaunchHandler(func(stage LaunchStage, err error) {
+		if err != nil {
+			failure = err
+		}
+		stages = append(stages, stage)
+	})
+
+	r.launchStage(LAUNCH_STAGE_CREATING_VENV)
+	r.launchStage(LAUNCH_STAGE_INSTALLING_DEPENDENCIES)
+	r.launchFailed(errors.New("pip failed"))
+
+	if len(stages) != 3 || stages[2] != LAUNCH_STAGE_INSTALLING_DEPENDENCIES || failure == nil {
+		t.Fatalf("expected the dependencies stage to fail, got %v %v", stages, failure)
+	}
+
+	// the launch is over, later stages are not reported
+	r.launchStage(LAUNCH_STAGE_STARTING)
+	if len(stages) != 3 {
+		t.Fatalf("expected no more stages, got %v", stages)
+	}
+
+	stages = []LaunchStage{}
+	failure = nil
+	r.AddLaunchHandler(func(stage LaunchStage, err error) {
+		if err != nil {
+			failure = err
+		}
+		stages = append(stages, stage)
+	})
+
+	r.launchStage(LAUNCH_STAGE_STARTING)
+	r.launchCrashed(CrashReport{
+		ExitCode: 1,
+		Stderr:   []string{"Traceback (most recent call last):", "ModuleNotFoundError: No module named 'foo'"},
+	})
+	if failure == nil || !strings.Contains(failure.Error(), "ModuleNotFoundError") {
+		t.Fatalf("expected stderr of the crash, got %v", failure)
+	}
+
+	// a crash after the plugin is ready is not a failed launch
+	failure = nil
+	r.AddLaunchHandler(func(stage LaunchStage, err error) {
+		if err != nil {
+			failure = err
+		}
+	})
+	r.launchStage(LAUNCH_STAGE_READY)
+	r.launchCrashed(CrashReport{ExitCode: 1})
+	if failure != nil {
+		t.Fatalf("expected no failure after ready, got %v", failure)
+	}
+}
+
+func TestLaunchHandlersFollowTheSameLaunch(t *testing.T) {
+	r := &LocalPluginRuntime{}
+
+	first := []LaunchStage{}
+	r.AddLaunchHandler(func(stage LaunchStage, err error) {
+		first = append(first, stage)
+	})
+	r.launchStage(LAUNCH_STAGE_CREATING_VENV)
+
+	// a second install of the plugin joins the launch in progress
+	second := []LaunchStage{}
+	r.AddLaunchHandler(func(stage LaunchStage, err error) {
+		second = append(second, stage)
+	})
+	r.launchStage(LAUNCH_STAGE_STARTING)
+	r.launchStage(LAUNCH_STAGE_READY)
+
+	if len(first) != 3 || first[2] != LAUNCH_STAGE_READY {
+		t.Fatalf("expected the first handler to see the plugin ready, got %v", first)
+	}
+	if len(second) != 3 || second[0] != LAUNCH_STAGE_CREATING_VENV || second[2] != LAUNCH_STAGE_READY {
+		t.Fatalf("expected the second handler to be told the current stage first, got %v", second)
+	}
+
+	// a plugin which has been ready is not launched again
+	late := []LaunchStage{}
+	r.AddLaunchHandler(func(stage LaunchStage, err error) {
+		late = append(late, stage)
+	})
+	if len(late) != 1 || late[0] != LAUNCH_STAGE_READY {
+		t.Fatalf("expected a late handler to be told the plugin is ready, got %v", late)
+	}
+	if len(r.launchHandlers) != 0 {
+		t.Fatal("expected no handler to be kept once the plugin is ready")
+	}
+}
@@ -0,0 +1,405 @@
+package local_manager
+
+import (
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/log_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+)
+
+const (
+	// interval to check whether the pool needs to grow or shrink
+	POOL_SCALE_INTERVAL = 5 * time.Second
+	// how long a session waits for a parked pool to start again
+	POOL_COLD_START_TIMEOUT = 60 * time.Second
+
+	// wait before starting an instance after a crash, doubled for every following crash
+	INSTANCE_RESTART_INITIAL_BACKOFF = time.Second
+	INSTANCE_RESTART_MAX_BACKOFF     = time.Minute
+	// a crashed instance which had been running for this long resets the backoff
+	INSTANCE_HEALTHY_UPTIME = time.Minute
+)
+
+// leastLoadedInstance returns the ready instance with the fewest in-flight sessions,
+// replaced instances are only used until a replacement is ready, caller should hold the instance lock
+func (r *LocalPluginRuntime) leastLoadedInstance() *pluginInstance {
+	var selected, replaced *pluginInstance
+	for _, instance := range r.instances {
+		if instance.retiring || !instance.ready {
+			continue
+		}
+		if instance.replaced {
+			if replaced == nil || instance.sessions < replaced.sessions {
+				replaced = instance
+			}
+			continue
+		}
+		if selected == nil || instance.sessions < selected.sessions {
+			selected = instance
+		}
+	}
+
+	if selected == nil {
+		return replaced
+	}
+	return selected
+}
+
+func (r *LocalPluginRuntime) hasReadyInstance() bool {
+	r.instanceLock.RLock()
+	defer r.instanceLock.RUnlock()
+	return r.leastLoadedInstance() != nil
+}
+
+// markReady makes the instance accept sessions once the plugin reports ready
+func (r *LocalPluginRuntime) markReady(instance *pluginInstance) {
+	r.instanceLock.Lock()
+	instance.ready = true
+	r.instanceLock.Unlock()
+
+	log.Info("plugin %s instance %s is ready", r.Config.Identity(), instance.ioIdentity)
+	r.lifecycle(log_manager.LOG_LEVEL_INFO, "instance %s is ready", instance.ioIdentity)
+
+	r.notifyStarted()
+	r.stopReplacedInstances()
+}
+
+// notifyStarted marks the plugin active and sends the started event once an instance is ready
+func (r *LocalPluginRuntime) notifyStarted() {
+	if !r.hasReadyInstance() {
+		return
+	}
+
+	if !r.isDraining() && !r.Stopped() {
+		r.SetActive()
+	}
+	r.launchStage(LAUNCH_STAGE_READY)
+
+	r.waitChanLock.Lock()
+	for _, c := range r.waitStartedChan {
+		select {
+		case c <- true:
+		default:
+		}
+	}
+	r.waitChanLock.Unlock()
+}
+
+// wakeAndBindSession starts a parked pool and dispatches the session to it, the pool is
+// not parked again until the session is bound
+func (r *LocalPluginRuntime) wakeAndBindSession(sessionId string) *pluginInstance {
+	r.instanceLock.Lock()
+	r.pendingBinds++
+	r.instanceLock.Unlock()
+
+	defer func() {
+		r.instanceLock.Lock()
+		r.pendingBinds--
+		r.instanceLock.Unlock()
+	}()
+
+	r.wake()
+	return r.bindSession(sessionId)
+}
+
+// bindSession dispatches the session to the least loaded instance
+// returns nil if there is no instance available
+func (r *LocalPluginRuntime) bindSession(sessionId string) *pluginInstance {
+	r.instanceLock.Lock()
+	defer r.instanceLock.Unlock()
+
+	if instance, ok := r.sessionInstances[sessionId]; ok {
+		return instance
+	}
+
+	if r.draining {
+		return nil
+	}
+
+	instance := r.leastLoadedInstance()
+	if instance == nil {
+		return nil
+	}
+
+	instance.sessions++
+	r.sessionInstances[sessionId] = instance
+	r.lastSessionAt = time.Now()
+
+	// every instance is busy, ask the pool to grow
+	if instance.sessions >= r.poolConfig.ScaleUpThreshold &&
+		len(r.instances) < r.poolConfig.MaxInstances {
+		select {
+		case r.scaleUpChan <- true:
+		default:
+		}
+	}
+
+	return instance
+}
+
+// releaseSession unbinds the session from its instance
+func (r *LocalPluginRuntime) releaseSession(sessionId string) {
+	r.instanceLock.Lock()
+	defer r.instanceLock.Unlock()
+
+	instance, ok := r.sessionInstances[sessionId]
+	if !ok {
+		return
+	}
+
+	delete(r.sessionInstances, sessionId)
+	instance.sessions--
+	r.lastSessionAt = time.Now()
+	if instance.sessions == 0 {
+		instance.idleSince = time.Now()
+	}
+}
+
+// sessionInstance returns the instance the session is bound to
+// returns nil if the session is not bound, no other process has seen the session
+func (r *LocalPluginRuntime) sessionInstance(sessionId string) *pluginInstance {
+	r.instanceLock.RLock()
+	defer r.instanceLock.RUnlock()
+
+	return r.sessionInstances[sessionId]
+}
+
+// SetInstanceRestartHandler sets the restart policy of crashed instances, it returns how long to wait
+// before the next instance is started, or false if the plugin is crash looping, the pool is stopped then
+func (r *LocalPluginRuntime) SetInstanceRestartHandler(handler func(uptime time.Duration) (time.Duration, bool)) {
+	r.instanceRestartHandler = handler
+}
+
+// instanceCrashed delays the start of the next instance, so a crashing plugin is not restarted in a busy loop
+func (r *LocalPluginRuntime) instanceCrashed(uptime time.Duration) {
+	if r.instanceRestartHandler != nil {
+		delay, ok := r.instanceRestartHandler(uptime)
+		if !ok {
+			r.stopCrashLoopingPool()
+			return
+		}
+
+		r.instanceLock.Lock()
+		r.instanceRestartAt = time.Now().Add(delay)
+		r.instanceLock.Unlock()
+		return
+	}
+
+	r.instanceLock.Lock()
+	defer r.instanceLock.Unlock()
+
+	if uptime >= INSTANCE_HEALTHY_UPTIME || r.instanceRestartBackoff == 0 {
+		r.instanceRestartBackoff = INSTANCE_RESTART_INITIAL_BACKOFF
+	} else {
+		r.instanceRestartBackoff = min(r.instanceRestartBackoff*2, INSTANCE_RESTART_MAX_BACKOFF)
+	}
+	r.instanceRestartAt = time.Now().Add(r.instanceRestartBackoff)
+}
+
+// stopCrashLoopingPool stops all the instances, StartPlugin returns once they have exited
+// and the plugin is put in crash loop
+func (r *LocalPluginRuntime) stopCrashLoopingPool() {
+	r.instanceLock.Lock()
+	r.crashLooping = true
+	instances := append([]*pluginInstance{}, r.instances...)
+	for _, instance := range instances {
+		instance.retiring = true
+	}
+	r.instanceLock.Unlock()
+
+	log.Error("instances of plugin %s keep crashing, stopping the pool", r.Config.Identity())
+	r.lifecycle(log_manager.LOG_LEVEL_ERROR, "instances keep crashing, stopping the pool")
+
+	for _, instance := range instances {
+		r.stopInstance(instance)
+	}
+}
+
+// instanceRestartWait returns how long to wait before starting an instance after the last crash
+func (r *LocalPluginRuntime) instanceRestartWait() time.Duration {
+	r.instanceLock.RLock()
+	defer r.instanceLock.RUnlock()
+	return time.Until(r.instanceRestartAt)
+}
+
+func (r *LocalPluginRuntime) instanceCount() int {
+	r.instanceLock.RLock()
+	defer r.instanceLock.RUnlock()
+	return len(r.instances)
+}
+
+// autoscale grows the pool when all instances are busy or fewer than the minimum are alive,
+// and retires idle instances beyond the minimum
+func (r *LocalPluginRuntime) autoscale(exited chan<- *pluginInstance) {
+	if r.Stopped() || r.isParked() || r.isCrashLooping() {
+		return
+	}
+
+	if r.park() {
+		return
+	}
+
+	r.stopReplacedInstances()
+
+	r.instanceLock.Lock()
+	count := len(r.instances)
+	busy := true
+	var idle *pluginInstance
+	for _, instance := range r.instances {
+		if instance.retiring {
+			count--
+			continue
+		}
+		if instance.sessions < r.poolConfig.ScaleUpThreshold {
+			busy = false
+		}
+		if instance.sessions == 0 && time.Since(instance.idleSince) > r.poolConfig.ScaleDownIdleTimeout {
+			idle = instance
+		}
+	}
+
+	// retire one idle instance at a time
+	if idle != nil && count > r.poolConfig.MinInstances && !busy {
+		idle.retiring = true
+	} else {
+		idle = nil
+	}
+	r.instanceLock.Unlock()
+
+	if idle != nil {
+		log.Info("plugin %s is idle, scaling down to %d instances", r.Config.Identity(), count-1)
+		r.stopInstance(idle)
+		return
+	}
+
+	if count < r.poolConfig.MinInstances || (busy && count < r.poolConfig.MaxInstances) {
+		if wait := r.instanceRestartWait(); wait > 0 {
+			log.Info("plugin %s instance crashed recently, next instance starts in %s", r.Config.Identity(), wait)
+			return
+		}
+
+		log.Info("scaling up plugin %s to %d instances", r.Config.Identity(), count+1)
+		if _, err := r.startInstance(exited); err != nil {
+			log.Error("failed to scale up plugin %s: %s", r.Config.Identity(), err.Error())
+		}
+	}
+}
+
+func (r *LocalPluginRuntime) isCrashLooping() bool {
+	r.instanceLock.RLock()
+	defer r.instanceLock.RUnlock()
+	return r.crashLooping
+}
+
+func (r *LocalPluginRuntime) isParked() bool {
+	r.instanceLock.RLock()
+	defer r.instanceLock.RUnlock()
+	return r.parked
+}
+
+// park stops all instances once the pool has served no session for IdleTimeout,
+// the plugin stays registered and is started again by the next session
+func (r *LocalPluginRuntime) park() bool {
+	if r.poolConfig.IdleTimeout <= 0 {
+		return false
+	}
+
+	r.instanceLock.Lock()
+	// a draining pool is stopped by Drain, a session waking the pool is about to be bound
+	if r.draining || r.pendingBinds > 0 ||
+		len(r.instances) == 0 || time.Since(r.lastSessionAt) < r.poolConfig.IdleTimeout {
+		r.instanceLock.Unlock()
+		return false
+	}
+	for _, instance := range r.instances {
+		if instance.sessions > 0 {
+			r.instanceLock.Unlock()
+			return false
+		}
+	}
+
+	r.parked = true
+	instances := append([]*pluginInstance{}, r.instances...)
+	for _, instance := range instances {
+		instance.retiring = true
+	}
+	r.instanceLock.Unlock()
+
+	r.SetParked()
+	log.Info("plugin %s has been idle for %s, parking it", r.Config.Identity(), r.poolConfig.IdleTimeout)
+	r.lifecycle(log_manager.LOG_LEVEL_INFO, "parked after being idle for %s", r.poolConfig.IdleTimeout)
+
+	for _, instance := range instances {
+		r.stopInstance(instance)
+	}
+
+	return true
+}
+
+// unpark starts the pool of a parked plugin again
+func (r *LocalPluginRuntime) unpark(exited chan<- *pluginInstance) {
+	if !r.isParked() || r.isDraining() || r.Stopped() {
+		return
+	}
+
+	r.instanceLock.Lock()
+	r.parked = false
+	r.lastSessionAt = time.Now()
+	r.instanceLock.Unlock()
+
+	r.SetLaunching()
+	log.Info("starting parked plugin %s", r.Config.Identity())
+	r.lifecycle(log_manager.LOG_LEVEL_INFO, "starting from parked")
+
+	if err := r.startMinInstances(exited); err != nil {
+		// nothing is running, StartPlugin returns and the lifecycle restarts the plugin
+		log.Error("failed to start parked plugin %s: %s", r.Config.Identity(), err.Error())
+	}
+}
+
+// wake starts a parked pool and waits until it's ready to accept sessions,
+// it also waits for a pool whose instances have not reported ready yet
+func (r *LocalPluginRuntime) wake() {
+	if r.isDraining() {
+		return
+	}
+
+	parked := r.isParked()
+	if !parked && (r.instanceCount() == 0 || r.hasReadyInstance()) {
+		return
+	}
+
+	started := r.WaitStarted()
+
+	if parked {
+		select {
+		case r.wakeChan <- true:
+		default:
+		}
+	}
+
+	// a plugin which declares readiness may take longer than a cold start
+	wait := POOL_COLD_START_TIMEOUT
+	if health := r.healthConfig.resolve(r.Config.Meta.Health); health.readiness && health.readinessTimeout > wait {
+		wait = health.readinessTimeout
+	}
+
+	// the started event is missed if the pool starts before the wait begins, check it as well
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	timeout := time.After(wait)
+
+	for {
+		select {
+		case <-started:
+			return
+		case <-ticker.C:
+			if !r.isParked() && r.hasReadyInstance() {
+				return
+			}
+		case <-timeout:
+			log.Error("plugin %s was not ready within %s", r.Config.Identity(), wait)
+			return
+		}
+	}
+}
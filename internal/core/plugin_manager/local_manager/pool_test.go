@@ -0,0 +1,286 @@
+package local_manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+)
+
+func newTestPoolRuntime(instances int) *LocalPluginRuntime {
+	r := NewLocalPluginRuntime("", "", "", PoolConfig{
+		MinInstances:         1,
+		MaxInstances:         instances + 1,
+		ScaleUpThreshold:     2,
+		ScaleDownIdleTimeout: time.Minute,
+	}, CgroupConfig{}, SandboxConfig{}, PythonDependencyConfig{}, HealthConfig{})
+	r.scaleUpChan = make(chan bool, 1)
+	for i := 0; i < instances; i++ {
+		r.instances = append(r.instances, &pluginInstance{ready: true, idleSince: time.Now()})
+	}
+	return r
+}
+
+func TestBindSessionSpreadsByLoad(t *testing.T) {
+	r := newTestPoolRuntime(2)
+
+	first := r.bindSession("session-1")
+	second := r.bindSession("session-2")
+	if first == nil || second == nil {
+		t.Fatal("expected sessions to be bound")
+	}
+
+	if first == second {
+		t.Fatal("expected sessions to be spread across instances")
+	}
+
+	if r.bindSession("session-1") != first {
+		t.Fatal("expected a session to stay on its instance")
+	}
+
+	if r.sessionInstance("session-2") != second {
+		t.Fatal("expected writes to follow the bound instance")
+	}
+
+	r.releaseSession("session-1")
+	if first.sessions != 0 {
+		t.Fatalf("expected released instance to be idle, got %d sessions", first.sessions)
+	}
+
+	if r.bindSession("session-3") != first {
+		t.Fatal("expected the idle instance to be selected")
+	}
+}
+
+func TestBindSessionSkipsRetiringInstance(t *testing.T) {
+	r := newTestPoolRuntime(2)
+	r.instances[0].retiring = true
+
+	for i := 0; i < 3; i++ {
+		if r.bindSession(string(rune('a'+i))) != r.instances[1] {
+			t.Fatal("expected retiring instance to be skipped")
+		}
+	}
+}
+
+func TestBindSessionRequestsScaleUp(t *testing.T) {
+	r := newTestPoolRuntime(1)
+
+	r.bindSession("session-1")
+	select {
+	case <-r.scaleUpChan:
+		t.Fatal("expected no scale up below threshold")
+	default:
+	}
+
+	r.bindSession("session-2")
+	select {
+	case <-r.scaleUpChan:
+	default:
+		t.Fatal("expected scale up once the threshold is reached")
+	}
+}
+
+func TestParkIdlePool(t *testing.T) {
+	r := newTestPoolRuntime(2)
+	r.poolConfig.IdleTimeout = time.Minute
+
+	r.lastSessionAt = time.Now()
+	if r.park() {
+		t.Fatal("expected a recently used pool not to be parked")
+	}
+
+	r.lastSessionAt = time.Now().Add(-2 * time.Minute)
+	r.instances[0].sessions = 1
+	if r.park() {
+		t.Fatal("expected a pool with sessions not to be parked")
+	}
+
+	r.instances[0].sessions = 0
+	if !r.park() {
+		t.Fatal("expected an idle pool to be parked")
+	}
+
+	if !r.isParked() {
+		t.Fatal("expected the pool to be parked")
+	}
+
+	for _, instance := range r.instances {
+		if !instance.retiring {
+			t.Fatal("expected all instances to be retired")
+		}
+	}
+}
+
+func TestParkSkipsWakingOrDrainingPool(t *testing.T) {
+	r := newTestPoolRuntime(1)
+	r.poolConfig.IdleTimeout = time.Minute
+	r.lastSessionAt = time.Now().Add(-time.Hour)
+
+	r.pendingBinds = 1
+	if r.park() {
+		t.Fatal("expected a pool with a session waiting to be bound not to be parked")
+	}
+
+	r.pendingBinds = 0
+	r.draining = true
+	if r.park() {
+		t.Fatal("expected a draining pool not to be parked")
+	}
+}
+
+func TestParkDisabled(t *testing.T) {
+	r := newTestPoolRuntime(1)
+	r.lastSessionAt = time.Now().Add(-time.Hour)
+
+	if r.park() {
+		t.Fatal("expected pool not to be parked without idle timeout")
+	}
+}
+
+func TestRuntimeStateReportsPool(t *testing.T) {
+	r := newTestPoolRuntime(2)
+	r.instances[0].pid = 100
+	r.instances[1].pid = 101
+
+	r.bindSession("session-1")
+	r.bindSession("session-2")
+
+	state := r.RuntimeState()
+	if len(state.Pids) != 2 || state.Pids[0] != 100 || state.Pids[1] != 101 {
+		t.Fatalf("expected pids of the pool, got %v", state.Pids)
+	}
+	if state.Sessions != 2 {
+		t.Fatalf("expected 2 sessions, got %d", state.Sessions)
+	}
+}
+
+func TestBindSessionWaitsForReadiness(t *testing.T) {
+	r := newTestPoolRuntime(1)
+	r.instances[0].ready = false
+
+	if r.bindSession("session-1") != nil {
+		t.Fatal("expected no session to be dispatched before the instance is ready")
+	}
+
+	r.markReady(r.instances[0])
+	if r.bindSession("session-1") != r.instances[0] {
+		t.Fatal("expected the session to be dispatched once the instance is ready")
+	}
+}
+
+func TestStopReplacedInstancesWaitsForSessions(t *testing.T) {
+	r := newTestPoolRuntime(3)
+	replacement := r.instances[2]
+	replacement.ready = false
+
+	r.bindSession("session-1")
+	busy := r.sessionInstance("session-1")
+	for _, instance := range r.instances[:2] {
+		instance.replaced = true
+	}
+
+	// old instances keep serving until the replacement is ready
+	r.stopReplacedInstances()
+	if busy.retiring || r.bindSession("session-2") == replacement {
+		t.Fatal("expected replaced instances to keep serving")
+	}
+	r.releaseSession("session-2")
+
+	r.markReady(replacement)
+	for _, instance := range r.instances[:2] {
+		if !instance.retiring {
+			t.Fatal("expected replaced instances to retire once the replacement is ready")
+		}
+		if instance == busy && !instance.replaced {
+			t.Fatal("expected an instance with sessions to keep running")
+		}
+		if instance != busy && instance.replaced {
+			t.Fatal("expected an idle replaced instance to be stopped")
+		}
+	}
+
+	if r.bindSession("session-3") != replacement {
+		t.Fatal("expected new sessions to go to the replacement")
+	}
+
+	r.releaseSession("session-1")
+	r.stopReplacedInstances()
+	if busy.replaced {
+		t.Fatal("expected the instance to be stopped once its sessions finish")
+	}
+}
+
+func TestUnboundSessionFailsOnWrite(t *testing.T) {
+	r := newTestPoolRuntime(1)
+	r.draining = true
+
+	listener := r.Listen("session-1")
+	messages := []plugin_entities.SessionMessage{}
+	listener.Listen(func(message plugin_entities.SessionMessage) {
+		messages = append(messages, message)
+	})
+
+	if r.sessionInstance("session-1") != nil {
+		t.Fatal("expected writes of an unbound session not to reach any instance")
+	}
+
+	r.Write("session-1", []byte("{}"))
+	if len(messages) != 1 || messages[0].Type != plugin_entities.SESSION_MESSAGE_TYPE_ERROR {
+		t.Fatalf("expected the session to be failed, got %v", messages)
+	}
+
+	if r.unboundSessions.Exists("session-1") {
+		t.Fatal("expected the failed session to be forgotten")
+	}
+
+	// the session has already been failed
+	r.Write("session-1", []byte("{}"))
+	if len(messages) != 1 {
+		t.Fatalf("expected no more messages, got %v", messages)
+	}
+}
+
+func TestInstanceRestartBackoff(t *testing.T) {
+	r := newTestPoolRuntime(1)
+
+	r.instanceCrashed(time.Second)
+	if r.instanceRestartBackoff != INSTANCE_RESTART_INITIAL_BACKOFF || r.instanceRestartWait() <= 0 {
+		t.Fatal("expected the next instance to wait after a crash")
+	}
+
+	r.instanceCrashed(time.Second)
+	if r.instanceRestartBackoff != 2*INSTANCE_RESTART_INITIAL_BACKOFF {
+		t.Fatalf("expected the backoff to double, got %s", r.instanceRestartBackoff)
+	}
+
+	r.instanceCrashed(INSTANCE_HEALTHY_UPTIME)
+	if r.instanceRestartBackoff != INSTANCE_RESTART_INITIAL_BACKOFF {
+		t.Fatalf("expected a healthy instance to reset the backoff, got %s", r.instanceRestartBackoff)
+	}
+}
+
+func TestInstanceCrashLoopStopsPool(t *testing.T) {
+	r := newTestPoolRuntime(2)
+
+	crashes := 0
+	r.SetInstanceRestartHandler(func(uptime time.Duration) (time.Duration, bool) {
+		crashes++
+		return time.Minute, crashes < 2
+	})
+
+	r.instanceCrashed(time.Second)
+	if r.isCrashLooping() || r.instanceRestartWait() <= 0 {
+		t.Fatal("expected the next instance to wait for the backoff of the handler")
+	}
+
+	r.instanceCrashed(time.Second)
+	if !r.isCrashLooping() {
+		t.Fatal("expected the pool to be crash looping")
+	}
+	for _, instance := range r.instances {
+		if !instance.retiring || !instance.stopping.Load() {
+			t.Fatal("expected all instances to be stopped")
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package local_manager
+
+import (
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/log_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+)
+
+// RollingRestart replaces all the instances of the pool without dropping sessions,
+// a parked pool picks up the changes the next time it's started
+func (r *LocalPluginRuntime) RollingRestart() {
+	select {
+	case r.restartChan <- true:
+	default:
+	}
+}
+
+// rollingRestart starts a replacement for every instance, the old instances keep serving
+// until a replacement is ready and are stopped once their in-flight sessions finish
+func (r *LocalPluginRuntime) rollingRestart(exited chan<- *pluginInstance) {
+	if r.isParked() || r.isDraining() || r.isCrashLooping() || r.Stopped() {
+		return
+	}
+
+	r.instanceLock.RLock()
+	old := []*pluginInstance{}
+	for _, instance := range r.instances {
+		if !instance.retiring && !instance.replaced {
+			old = append(old, instance)
+		}
+	}
+	r.instanceLock.RUnlock()
+
+	log.Info("rolling restart plugin %s, replacing %d instances", r.Config.Identity(), len(old))
+	r.lifecycle(log_manager.LOG_LEVEL_INFO, "rolling restart, replacing %d instances", len(old))
+
+	// mark them first, a replacement may report ready before all of them are started
+	r.instanceLock.Lock()
+	for _, instance := range old {
+		instance.replaced = true
+	}
+	r.instanceLock.Unlock()
+
+	// the pool may exceed MaxInstances until the old instances are stopped
+	started := 0
+	for range old {
+		if _, err := r.startInstance(exited); err != nil {
+			log.Error("failed to start replacement of plugin %s: %s", r.Config.Identity(), err.Error())
+			r.lifecycle(log_manager.LOG_LEVEL_ERROR, "failed to start replacement: %s", err.Error())
+			break
+		}
+		started++
+	}
+
+	// instances without a replacement keep serving
+	r.instanceLock.Lock()
+	for _, instance := range old[started:] {
+		instance.replaced = false
+	}
+	r.instanceLock.Unlock()
+
+	r.stopReplacedInstances()
+}
+
+// stopReplacedInstances retires replaced instances once a replacement is ready
+// and stops the ones which have no session left
+func (r *LocalPluginRuntime) stopReplacedInstances() {
+	r.instanceLock.Lock()
+	ready := false
+	for _, instance := range r.instances {
+		if instance.ready && !instance.retiring && !instance.replaced {
+			ready = true
+			break
+		}
+	}
+	if !ready {
+		r.instanceLock.Unlock()
+		return
+	}
+
+	idle := []*pluginInstance{}
+	for _, instance := range r.instances {
+		if !instance.replaced {
+			continue
+		}
+		instance.retiring = true
+		if instance.sessions == 0 {
+			instance.replaced = false
+			idle = append(idle, instance)
+		}
+	}
+	r.instanceLock.Unlock()
+
+	for _, instance := range idle {
+		r.stopInstance(instance)
+	}
+}
@@ -4,22 +4,17 @@ package local_manager
 import (
 	"errors"
 	"fmt"
-	"os"
 	"os/exec"
-	"sync"
+	"time"
 
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/log_manager"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/constants"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
-	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/routine"
 )
 
 // gc performs garbage collection for the LocalPluginRuntime
 func (r *LocalPluginRuntime) gc() {
-	if r.ioIdentity != "" {
-		removeStdioHandler(r.ioIdentity)
-	}
-
 	if r.waitChan != nil {
 		close(r.waitChan)
 		r.waitChan = nil
@@ -37,14 +32,24 @@ func (r *LocalPluginRuntime) getCmd() (*exec.Cmd, error) {
 		cmd := exec.Command(r.pythonInterpreterPath, "-m", r.Config.Meta.Runner.Entrypoint)
 		cmd.Dir = r.State.WorkingPath
 		return cmd, nil
+	} else if r.Config.Meta.Runner.Language == constants.Go {
+		cmd := exec.Command(r.goBinaryPath)
+		cmd.Dir = r.State.WorkingPath
+		return cmd, nil
+	} else if r.Config.Meta.Runner.Language == constants.NodeJS {
+		cmd := exec.Command(r.nodeExecutablePath, r.Config.Meta.Runner.Entrypoint)
+		cmd.Dir = r.State.WorkingPath
+		return cmd, nil
 	}
 
 	return nil, fmt.Errorf("unsupported language: %s", r.Config.Meta.Runner.Language)
 }
 
-// StartPlugin starts the plugin and manages its lifecycle
+// StartPlugin starts the process pool of the plugin and manages its lifecycle
+// it hangs until all the instances of the pool have exited
 func (r *LocalPluginRuntime) StartPlugin() error {
 	defer log.Info("plugin %s stopped", r.Config.Identity())
+	defer r.lifecycle(log_manager.LOG_LEVEL_INFO, "stopped")
 	defer func() {
 		r.waitChanLock.Lock()
 		for _, c := range r.waitStoppedChan {
@@ -65,104 +70,76 @@ func (r *LocalPluginRuntime) StartPlugin() error {
 
 	// reset wait chan
 	r.waitChan = make(chan bool)
-	// reset wait launched chan
-
-	// start plugin
-	e, err := r.getCmd()
-	if err != nil {
-		return err
-	}
+	defer r.gc()
 
-	e.Dir = r.State.WorkingPath
-	// add env INSTALL_METHOD=local
-	e.Env = append(e.Env, "INSTALL_METHOD=local", "PATH="+os.Getenv("PATH"))
-
-	// get writer
-	stdin, err := e.StdinPipe()
+	// sandboxed instances share one egress proxy
+	egress, err := r.startEgressProxy()
 	if err != nil {
-		return fmt.Errorf("get stdin pipe failed: %s", err.Error())
+		return fmt.Errorf("start egress proxy failed: %s", err.Error())
 	}
-	defer stdin.Close()
-
-	// get stdout
-	stdout, err := e.StdoutPipe()
-	if err != nil {
-		return fmt.Errorf("get stdout pipe failed: %s", err.Error())
+	if egress != nil {
+		defer egress.Close()
 	}
-	defer stdout.Close()
 
-	// get stderr
-	stderr, err := e.StderrPipe()
-	if err != nil {
-		return fmt.Errorf("get stderr pipe failed: %s", err.Error())
+	// the pool could exceed MaxInstances while instances are replaced, so exits are reported
+	// without a buffer, instances stop reporting once poolDone is closed
+	exited := make(chan *pluginInstance)
+	poolDone := make(chan bool)
+	defer close(poolDone)
+	r.instanceLock.Lock()
+	r.poolDone = poolDone
+	r.crashLooping = false
+	r.instanceRestartAt = time.Time{}
+	r.scaleUpChan = make(chan bool, 1)
+	scaleUpChan := r.scaleUpChan
+	r.parked = false
+	r.lastSessionAt = time.Now()
+	r.instanceLock.Unlock()
+
+	r.launchStage(LAUNCH_STAGE_STARTING)
+	if err := r.startMinInstances(exited); err != nil {
+		r.launchFailed(err)
+		return err
 	}
-	defer stderr.Close()
 
-	if err := e.Start(); err != nil {
-		return fmt.Errorf("start plugin failed: %s", err.Error())
-	}
+	ticker := time.NewTicker(POOL_SCALE_INTERVAL)
+	defer ticker.Stop()
 
-	defer func() {
-		// wait for plugin to exit
-		err = e.Wait()
-		if err != nil {
-			log.Error("plugin %s exited with error: %s", r.Config.Identity(), err.Error())
+	// keep scaling the pool until all instances have exited, a parked pool waits for sessions
+	for r.instanceCount() > 0 || (r.isParked() && !r.Stopped()) {
+		select {
+		case <-ticker.C:
+			r.collectPoolCgroupEvents()
+			r.autoscale(exited)
+		case <-scaleUpChan:
+			r.autoscale(exited)
+		case <-r.wakeChan:
+			r.unpark(exited)
+		case <-r.restartChan:
+			r.rollingRestart(exited)
+		case <-exited:
 		}
+	}
 
-		r.gc()
-	}()
+	// plugin has exited
+	return nil
+}
 
-	// ensure the plugin process is killed after the plugin exits
-	defer e.Process.Kill()
-
-	log.Info("plugin %s started", r.Config.Identity())
-
-	// setup stdio
-	stdio := registerStdioHandler(r.Config.Identity(), stdin, stdout, stderr)
-	r.ioIdentity = stdio.GetID()
-	defer stdio.Stop()
-
-	wg := sync.WaitGroup{}
-	wg.Add(2)
-
-	// listen to plugin stdout
-	routine.Submit(map[string]string{
-		"module":   "plugin_manager",
-		"type":     "local",
-		"function": "StartStdout",
-	}, func() {
-		defer wg.Done()
-		stdio.StartStdout(func() {})
-	})
-
-	// listen to plugin stderr
-	routine.Submit(map[string]string{
-		"module":   "plugin_manager",
-		"type":     "local",
-		"function": "StartStderr",
-	}, func() {
-		defer wg.Done()
-		stdio.StartStderr()
-	})
-
-	// send started event
-	r.waitChanLock.Lock()
-	for _, c := range r.waitStartedChan {
-		select {
-		case c <- true:
-		default:
+// startMinInstances starts the minimum number of instances, the started event is sent
+// once an instance is ready
+func (r *LocalPluginRuntime) startMinInstances(exited chan<- *pluginInstance) error {
+	for i := 0; i < r.poolConfig.MinInstances; i++ {
+		if _, err := r.startInstance(exited); err != nil {
+			if r.instanceCount() == 0 {
+				return err
+			}
+			log.Error("failed to start instance of plugin %s: %s", r.Config.Identity(), err.Error())
+			break
 		}
 	}
-	r.waitChanLock.Unlock()
 
-	// wait for plugin to exit
-	err = stdio.Wait()
-	if err != nil {
-		return err
-	}
-	wg.Wait()
+	r.notifyStarted()
 
-	// plugin has exited
 	return nil
 }
 
@@ -197,9 +174,12 @@ func (r *LocalPluginRuntime) Stop() {
 	// inherit from PluginRuntime
 	r.PluginRuntime.Stop()
 
-	// get stdio
-	stdio := getStdioHandler(r.ioIdentity)
-	if stdio != nil {
-		stdio.Stop()
+	// stop all instances
+	r.instanceLock.RLock()
+	instances := append([]*pluginInstance{}, r.instances...)
+	r.instanceLock.RUnlock()
+
+	for _, instance := range instances {
+		r.stopInstance(instance)
 	}
 }
@@ -0,0 +1,110 @@
+package local_manager
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/sandbox"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/constants"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+)
+
+// SandboxConfig controls the isolation of local plugin processes
+type SandboxConfig struct {
+	Enabled bool
+	// extra host paths mounted read-only into the sandbox
+	ReadOnlyPaths []string
+}
+
+// sandboxCmd wraps the plugin command so that it runs inside the sandbox
+func (r *LocalPluginRuntime) sandboxCmd(cmd *exec.Cmd) (*exec.Cmd, error) {
+	policy := sandbox.Policy{
+		WorkingPath:   r.State.WorkingPath,
+		ReadOnlyPaths: r.sandboxReadOnlyPaths(),
+		Args:          cmd.Args,
+		Env:           cmd.Env,
+	}
+
+	if r.Config.Resource.Permission.AllowNetwork() {
+		policy.EgressSocket = sandbox.EgressSocketPath(r.State.WorkingPath)
+	}
+
+	// exec.Command keeps the name as argv[0], use the resolved path instead
+	policy.Args[0] = cmd.Path
+
+	sandboxed, err := sandbox.Command(policy)
+	if err != nil {
+		return nil, fmt.Errorf("create sandbox failed: %s", err.Error())
+	}
+
+	return sandboxed, nil
+}
+
+// sandboxReadOnlyPaths returns host paths the plugin needs, including where its runtime is installed
+func (r *LocalPluginRuntime) sandboxReadOnlyPaths() []string {
+	paths := append([]string{}, sandbox.DefaultReadOnlyPaths...)
+	paths = append(paths, r.sandboxConfig.ReadOnlyPaths...)
+
+	// a shared virtual environment lives outside the working path
+	if r.Config.Meta.Runner.Language == constants.Python {
+		if target, err := os.Readlink(filepath.Join(r.State.WorkingPath, ".venv")); err == nil {
+			paths = append(paths, target)
+		}
+	}
+
+	// the runtime may be installed outside the default paths, like pyenv or nvm, its prefix must be visible
+	var executable string
+	switch r.Config.Meta.Runner.Language {
+	case constants.Python:
+		executable = r.defaultPythonInterpreterPath
+	case constants.NodeJS:
+		executable = r.nodeExecutablePath
+	}
+
+	if executable == "" {
+		return paths
+	}
+
+	executable, err := exec.LookPath(executable)
+	if err != nil {
+		return paths
+	}
+
+	if resolved, err := filepath.EvalSymlinks(executable); err == nil {
+		prefix := filepath.Dir(filepath.Dir(resolved))
+		covered := false
+		for _, p := range paths {
+			if prefix == p || strings.HasPrefix(prefix, strings.TrimSuffix(p, "/")+"/") {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			paths = append(paths, prefix)
+		}
+	}
+
+	return paths
+}
+
+// startEgressProxy starts the proxy through which the sandboxed plugin reaches declared hosts
+// returns nil if the plugin is not sandboxed or has no network permission
+func (r *LocalPluginRuntime) startEgressProxy() (*sandbox.EgressProxy, error) {
+	if !r.sandboxConfig.Enabled || !r.Config.Resource.Permission.AllowNetwork() {
+		return nil, nil
+	}
+
+	hosts := r.Config.Resource.Permission.Network.Egress
+	if len(hosts) == 0 {
+		log.Warn("plugin %s requires network but declares no egress host, all requests will be rejected", r.Config.Identity())
+	}
+
+	return sandbox.NewEgressProxy(
+		r.Config.Identity(),
+		sandbox.EgressSocketPath(r.State.WorkingPath),
+		hosts,
+	)
+}
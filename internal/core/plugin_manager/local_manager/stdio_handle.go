@@ -2,12 +2,17 @@ package local_manager
 
 import (
 	"bufio"
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/log_manager"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/plugin_errors"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
@@ -26,7 +31,7 @@ type stdioHolder struct {
 	reader                 io.ReadCloser
 	errReader              io.ReadCloser
 	l                      *sync.Mutex
-	listener               map[string]func([]byte)
+	listener               map[string]func([]byte, *plugin_entities.SessionBlob)
 	errorListener          map[string]func([]byte)
 	started                bool
 
@@ -34,6 +39,10 @@ type stdioHolder struct {
 	errMessage              string
 	lastErrMessageUpdatedAt time.Time
 
+	// the last lines of stderr, kept for crash reports
+	stderrTail     []string
+	stderrTailLock sync.Mutex
+
 	// waiting controller channel to notify the exit signal to the Wait() function
 	waitingControllerChan       chan bool
 	waitingControllerChanClosed bool
@@ -41,6 +50,29 @@ type stdioHolder struct {
 
 	// the last time the plugin sent a heartbeat
 	lastActiveAt time.Time
+	// set while a response is handed to a session which reads slowly, stdout is not read then,
+	// so heartbeats are held back in the pipe rather than missed
+	delivering atomic.Bool
+
+	// how the process is checked, a process which declares readiness is checked against
+	// the readiness timeout since it's started until it reports ready
+	health    healthCheck
+	startedAt time.Time
+	ready     atomic.Bool
+
+	// switched once the plugin asks for the framed protocol, writes are serialized
+	// so that frames of concurrent sessions never interleave
+	framed    atomic.Bool
+	writeLock sync.Mutex
+	// set once the plugin announces its protocol, only sdks which do handle cancel events
+	protocolAnnounced atomic.Bool
+	// a json event of a frame larger than this stops the plugin
+	maxEventSize int
+	// the blob frame whose raw bytes are being read, only used by the stdout reader
+	blob *blobFrame
+
+	// logs of the plugin are kept in it if set
+	logStore *log_manager.LogStore
 }
 
 func (s *stdioHolder) Error() error {
@@ -55,10 +87,10 @@ func (s *stdioHolder) Error() error {
 
 // Stop stops the stdio, of course, it will shutdown the plugin asynchronously
 // by closing a channel to notify the `Wait()` function to exit
+// stderr is closed once the process is reaped, so the last lines before a crash are not lost
 func (s *stdioHolder) Stop() {
 	s.writer.Close()
 	s.reader.Close()
-	s.errReader.Close()
 
 	s.waitControllerChanLock.Lock()
 	if !s.waitingControllerChanClosed {
@@ -71,35 +103,70 @@ func (s *stdioHolder) Stop() {
 }
 
 // StartStdout starts to read the stdout of the plugin
-// it will notify the heartbeat function when the plugin is active
+// it will notify the heartbeat function when the plugin is active, the ready function once it reports ready
 // and parse the stdout data to trigger corresponding listeners
-func (s *stdioHolder) StartStdout(notify_heartbeat func()) {
+func (s *stdioHolder) StartStdout(notify_heartbeat func(), notify_ready func()) {
 	s.started = true
 	s.lastActiveAt = time.Now()
 	defer s.Stop()
 
-	scanner := bufio.NewScanner(s.reader)
+	reader := bufio.NewReaderSize(s.reader, 64*1024)
+	firstLine := true
 
-	// TODO: set a reasonable buffer size or use a reader, this is a temporary solution
-	scanner.Buffer(make([]byte, 1024), 5*1024*1024)
-
-	for scanner.Scan() {
-		data := scanner.Bytes()
+	for {
+		data, blob, err := s.readEvent(reader)
+		if err != nil {
+			// the stream is out of sync, the process is killed once stdout is stopped
+			if err != io.EOF && !errors.Is(err, os.ErrClosed) {
+				log.Error("plugin %s has an error on stdout: %s", s.pluginUniqueIdentifier, err)
+				s.WriteError(fmt.Sprintf("invalid stdout: %s\n", err))
+				s.appendLog(log_manager.LogEntry{
+					Level:   log_manager.LOG_LEVEL_ERROR,
+					Source:  log_manager.LOG_SOURCE_STDOUT,
+					Message: fmt.Sprintf("invalid stdout: %s", err),
+				})
+			}
+			return
+		}
 
 		if len(data) == 0 {
 			continue
 		}
 
+		// only the first line could switch the protocol
+		if firstLine {
+			firstLine = false
+			if version, ok := parseStdioProtocolEvent(data); ok {
+				s.protocolAnnounced.Store(true)
+				switch version {
+				case STDIO_PROTOCOL_NDJSON:
+				case STDIO_PROTOCOL_FRAMED:
+					s.framed.Store(true)
+				default:
+					log.Error("plugin %s asks for unsupported stdio protocol %d", s.pluginUniqueIdentifier, version)
+					return
+				}
+				continue
+			}
+		}
+
 		plugin_entities.ParsePluginUniversalEvent(
 			data,
 			func(session_id string, data []byte) {
-				for _, listener := range listeners {
+				for _, listener := range globalStdioListeners() {
 					listener(s.id, data)
 				}
-				for listener_session_id, listener := range s.listener {
-					if listener_session_id == session_id {
-						listener(data)
-					}
+
+				// listeners are added and removed by sessions concurrently, the lock is not held
+				// while a listener waits for a slow caller
+				s.l.Lock()
+				listener := s.listener[session_id]
+				s.l.Unlock()
+				if listener != nil {
+					s.delivering.Store(true)
+					listener(data, blob)
+					s.delivering.Store(false)
+					s.lastActiveAt = time.Now()
 				}
 			},
 			func() {
@@ -107,18 +174,88 @@ func (s *stdioHolder) StartStdout(notify_heartbeat func()) {
 				// notify launched
 				notify_heartbeat()
 			},
+			func() {
+				s.lastActiveAt = time.Now()
+				if !s.ready.Swap(true) {
+					notify_ready()
+				}
+			},
 			func(err string) {
 				log.Error("plugin %s: %s", s.pluginUniqueIdentifier, err)
+				s.appendLog(log_manager.LogEntry{
+					Level:   log_manager.LOG_LEVEL_ERROR,
+					Source:  log_manager.LOG_SOURCE_STDOUT,
+					Message: err,
+				})
 			},
-			func(message string) {
-				log.Info("plugin %s: %s", s.pluginUniqueIdentifier, message)
+			func(session_id string, event plugin_entities.PluginLogEvent) {
+				log.Info("plugin %s: %s", s.pluginUniqueIdentifier, event.Message)
+				s.appendLog(log_manager.LogEntry{
+					Time:      logEventTime(event.Timestamp),
+					Level:     strings.ToLower(event.Level),
+					Source:    log_manager.LOG_SOURCE_STDOUT,
+					SessionID: session_id,
+					TenantID:  sessionTenant(session_id),
+					Message:   event.Message,
+				})
 			},
 		)
 	}
+}
+
+// readEvent reads the next json event from stdout in the current protocol, and the raw bytes
+// sent along it if any
+func (s *stdioHolder) readEvent(reader *bufio.Reader) ([]byte, *plugin_entities.SessionBlob, error) {
+	if !s.framed.Load() {
+		line, err := readLine(reader)
+		return line, nil, err
+	}
+
+	// the rest of a blob frame is handed to the session before the next frame is read
+	if s.blob != nil {
+		event, blob, err := s.blob.next(reader)
+		if s.blob.done() {
+			s.blob = nil
+		}
+		return event, blob, err
+	}
+
+	frameType, length, err := readFrameHeader(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch frameType {
+	case FRAME_TYPE_EVENT:
+		payload, err := readFramePayload(reader, length, s.maxEventSize)
+		return payload, nil, err
+	case FRAME_TYPE_BLOB:
+		frame, err := openBlobFrame(reader, length, s.maxEventSize)
+		if errors.Is(err, errInvalidFrame) {
+			log.Error("plugin %s sent an invalid blob frame: %s", s.pluginUniqueIdentifier, err)
+			return nil, nil, nil
+		} else if err != nil {
+			return nil, nil, err
+		}
+		s.blob = frame
+		return s.readEvent(reader)
+	default:
+		log.Error("plugin %s sent an unknown frame type %#x", s.pluginUniqueIdentifier, frameType)
+		return nil, nil, skipFrame(reader, length)
+	}
+}
 
-	if err := scanner.Err(); err != nil {
-		log.Error("plugin %s has an error on stdout: %s", s.pluginUniqueIdentifier, err)
+// write sends a json event to stdin of the plugin in the current protocol
+func (s *stdioHolder) write(data []byte) error {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+
+	if s.framed.Load() {
+		return writeFrame(s.writer, FRAME_TYPE_EVENT, data)
 	}
+
+	_, err := s.writer.Write(append(data, '\n'))
+	return err
 }
 
 // WriteError writes the error message to the stdio holder
@@ -141,6 +278,12 @@ func (s *stdioHolder) WriteError(msg string) {
 // StartStderr starts to read the stderr of the plugin
 // it will write the error message to the stdio holder
 func (s *stdioHolder) StartStderr() {
+	// complete lines are kept in the log store
+	var pending []byte
+	defer func() {
+		s.appendStderrLog(pending)
+	}()
+
 	for {
 		buf := make([]byte, 1024)
 		n, err := s.errReader.Read(buf)
@@ -148,15 +291,82 @@ func (s *stdioHolder) StartStderr() {
 			break
 		} else if err != nil {
 			s.WriteError(fmt.Sprintf("%s\n", buf[:n]))
+			pending = append(pending, buf[:n]...)
 			break
 		}
 
 		if n > 0 {
 			s.WriteError(fmt.Sprintf("%s\n", buf[:n]))
+			pending = append(pending, buf[:n]...)
+			if i := bytes.LastIndexByte(pending, '\n'); i >= 0 {
+				for _, line := range bytes.Split(pending[:i], []byte{'\n'}) {
+					s.appendStderrLog(line)
+				}
+				pending = append([]byte{}, pending[i+1:]...)
+			}
 		}
 	}
 }
 
+func (s *stdioHolder) appendStderrLog(line []byte) {
+	if len(bytes.TrimSpace(line)) == 0 {
+		return
+	}
+
+	s.stderrTailLock.Lock()
+	if len(s.stderrTail) >= CRASH_STDERR_LINES {
+		s.stderrTail = s.stderrTail[1:]
+	}
+	s.stderrTail = append(s.stderrTail, string(line))
+	s.stderrTailLock.Unlock()
+
+	s.appendLog(log_manager.LogEntry{
+		Level:   log_manager.LOG_LEVEL_ERROR,
+		Source:  log_manager.LOG_SOURCE_STDERR,
+		Message: string(line),
+	})
+}
+
+// StderrTail returns the last lines of stderr
+func (s *stdioHolder) StderrTail() []string {
+	s.stderrTailLock.Lock()
+	defer s.stderrTailLock.Unlock()
+	return append([]string{}, s.stderrTail...)
+}
+
+// failSessions sends the message to every session listening on the stdio
+func (s *stdioHolder) failSessions(message []byte) {
+	s.l.Lock()
+	listeners := make([]func([]byte, *plugin_entities.SessionBlob), 0, len(s.listener))
+	for _, listener := range s.listener {
+		listeners = append(listeners, listener)
+	}
+	s.l.Unlock()
+
+	for _, listener := range listeners {
+		listener(message, nil)
+	}
+}
+
+func (s *stdioHolder) appendLog(entry log_manager.LogEntry) {
+	if s.logStore == nil {
+		return
+	}
+
+	if err := s.logStore.Append(entry); err != nil {
+		log.Error("write logs of plugin %s failed: %s", s.pluginUniqueIdentifier, err.Error())
+	}
+}
+
+// logEventTime converts the timestamp of a log event in seconds, it's the current time if not set
+func logEventTime(timestamp float64) time.Time {
+	if timestamp <= 0 {
+		return time.Now()
+	}
+
+	return time.Unix(0, int64(timestamp*float64(time.Second)))
+}
+
 // Wait waits for the plugin to exit
 // it will return an error if the plugin is not active
 // you can also call `Stop()` to stop the waiting process
@@ -168,10 +378,10 @@ func (s *stdioHolder) Wait() error {
 	}
 	s.waitControllerChanLock.Unlock()
 
-	ticker := time.NewTicker(5 * time.Second)
+	ticker := time.NewTicker(s.health.heartbeatInterval)
 	defer ticker.Stop()
 
-	// check status of plugin every 5 seconds
+	// check status of plugin every heartbeat interval
 	for {
 		s.waitControllerChanLock.Lock()
 		if s.waitingControllerChanClosed {
@@ -181,8 +391,16 @@ func (s *stdioHolder) Wait() error {
 		s.waitControllerChanLock.Unlock()
 		select {
 		case <-ticker.C:
-			// check heartbeat
-			if time.Since(s.lastActiveAt) > 60*time.Second {
+			// a process still loading is not expected to send heartbeats
+			if s.health.readiness && !s.ready.Load() {
+				if time.Since(s.startedAt) > s.health.readinessTimeout {
+					return plugin_errors.ErrPluginNotReady
+				}
+				continue
+			}
+
+			// check heartbeat, unless the caller of a session holds the plugin back
+			if !s.delivering.Load() && time.Since(s.lastActiveAt) > s.health.heartbeatTimeout {
 				return plugin_errors.ErrPluginNotActive
 			}
 		case <-s.waitingControllerChan:
@@ -0,0 +1,259 @@
+package local_manager
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/parser"
+)
+
+// the stdio protocol is advertised to the plugin through STDIO_PROTOCOLS_ENV,
+// an sdk which supports framing sends a protocol event as its first line on stdout:
+//
+//	{"event": "protocol", "data": {"version": 2}}
+//
+// everything after the line is framed in both directions, messages written to stdin before the
+// daemon receives the line are still newline-delimited, they are told apart by FRAME_MAGIC.
+// old sdks never send the line and keep using newline-delimited json.
+const (
+	STDIO_PROTOCOLS_ENV = "MLCHAIN_PLUGIN_STDIO_PROTOCOLS"
+
+	// newline-delimited json, every message is a single line
+	STDIO_PROTOCOL_NDJSON = 1
+	// length-prefixed frames, raw bytes are sent as they are
+	STDIO_PROTOCOL_FRAMED = 2
+
+	// a single line of newline-delimited json could not be larger than this
+	STDIO_MAX_LINE_SIZE = 5 * 1024 * 1024
+	// a json event of a frame is read as a whole, it could not be larger than
+	// PLUGIN_STDIO_MAX_EVENT_SIZE, this is the default of it
+	STDIO_DEFAULT_MAX_EVENT_SIZE = 64 * 1024 * 1024
+	// raw bytes of a blob frame are never read as a whole, they are handed to the session
+	// in pieces of this size, so a blob could be as large as the plugin needs
+	STDIO_BLOB_PIECE_SIZE = 1024 * 1024
+)
+
+// a frame is FRAME_MAGIC, a frame type and the uint64 big-endian length of the payload,
+// followed by the payload
+const (
+	FRAME_MAGIC       byte = 0x1e
+	FRAME_HEADER_SIZE      = 10
+
+	// payload is a json event, the same as a line of newline-delimited json
+	FRAME_TYPE_EVENT byte = 0x01
+	// payload is the uint32 big-endian length of a json session event, the event and raw bytes,
+	// the raw bytes belong at the dot-separated blob_path of the event, like `data.data.blob`,
+	// they are handed to the session as they are rather than encoded into the json
+	FRAME_TYPE_BLOB byte = 0x02
+)
+
+// errInvalidFrame is returned for a frame which is skipped as a whole, the stream is still in sync
+var errInvalidFrame = errors.New("invalid frame")
+
+// StdioConfig bounds what a plugin sends through stdio
+type StdioConfig struct {
+	// a json event of a frame larger than this stops the plugin, STDIO_DEFAULT_MAX_EVENT_SIZE if 0
+	MaxEventSize int
+}
+
+var stdioProtocolsAdvertised = fmt.Sprintf(
+	"%s=%d,%d", STDIO_PROTOCOLS_ENV, STDIO_PROTOCOL_NDJSON, STDIO_PROTOCOL_FRAMED,
+)
+
+type stdioProtocolEvent struct {
+	Event string `json:"event"`
+	Data  struct {
+		Version int `json:"version"`
+	} `json:"data"`
+}
+
+// parseStdioProtocolEvent returns the protocol version the plugin asks for,
+// ok is false if the line is not a protocol event
+func parseStdioProtocolEvent(line []byte) (int, bool) {
+	event, err := parser.UnmarshalJsonBytes[stdioProtocolEvent](line)
+	if err != nil || event.Event != string(plugin_entities.PLUGIN_EVENT_PROTOCOL) {
+		return 0, false
+	}
+
+	return event.Data.Version, true
+}
+
+func writeFrame(w io.Writer, frameType byte, payload []byte) error {
+	header := make([]byte, FRAME_HEADER_SIZE)
+	header[0] = FRAME_MAGIC
+	header[1] = frameType
+	binary.BigEndian.PutUint64(header[2:], uint64(len(payload)))
+
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	_, err := w.Write(payload)
+	return err
+}
+
+// readFrameHeader returns the type of the next frame and the length of its payload
+func readFrameHeader(r *bufio.Reader) (byte, uint64, error) {
+	header := make([]byte, FRAME_HEADER_SIZE)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, 0, err
+	}
+
+	if header[0] != FRAME_MAGIC {
+		return 0, 0, fmt.Errorf("invalid frame magic: %#x", header[0])
+	}
+
+	return header[1], binary.BigEndian.Uint64(header[2:]), nil
+}
+
+// readFramePayload reads a payload which is used as a whole, a plugin sending a larger one
+// than maxSize is stopped as the stream could not be trusted anymore
+func readFramePayload(r *bufio.Reader, length uint64, maxSize int) ([]byte, error) {
+	if length > uint64(maxSize) {
+		return nil, fmt.Errorf("frame of %d bytes exceeds %d bytes", length, maxSize)
+	}
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// skipFrame discards the rest of a frame which is not used
+func skipFrame(r *bufio.Reader, length uint64) error {
+	_, err := io.CopyN(io.Discard, r, int64(length))
+	return err
+}
+
+// readLine reads a line of newline-delimited json without the trailing newline
+func readLine(r *bufio.Reader) ([]byte, error) {
+	var line []byte
+	for {
+		chunk, err := r.ReadSlice('\n')
+		line = append(line, chunk...)
+		if len(line) > STDIO_MAX_LINE_SIZE {
+			return nil, fmt.Errorf("line exceeds %d bytes, use the framed protocol instead", STDIO_MAX_LINE_SIZE)
+		}
+		if err == bufio.ErrBufferFull {
+			continue
+		}
+		if err == io.EOF && len(line) > 0 {
+			// the last line without a trailing newline
+			return line, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return line[:len(line)-1], nil
+	}
+}
+
+// blobFrame is a blob frame whose raw bytes are being handed to the session piece by piece
+type blobFrame struct {
+	event    []byte
+	id       string
+	path     []string
+	total    uint64
+	read     uint64
+	sequence int
+}
+
+// openBlobFrame reads the session event of a blob frame of length bytes, the raw bytes
+// following it are left in the reader for next
+func openBlobFrame(r *bufio.Reader, length uint64, maxHeaderSize int) (*blobFrame, error) {
+	if length < 4 {
+		if err := skipFrame(r, length); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: blob frame is too short", errInvalidFrame)
+	}
+
+	size := make([]byte, 4)
+	if _, err := io.ReadFull(r, size); err != nil {
+		return nil, err
+	}
+
+	headerLength := uint64(binary.BigEndian.Uint32(size))
+	if headerLength > length-4 {
+		return nil, errors.New("blob frame header exceeds the frame")
+	}
+
+	header, err := readFramePayload(r, headerLength, maxHeaderSize)
+	if err != nil {
+		return nil, err
+	}
+
+	event, path, err := decodeBlobFrameHeader(header)
+	if err != nil {
+		if err := skipFrame(r, length-4-headerLength); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %s", errInvalidFrame, err)
+	}
+
+	return &blobFrame{
+		event: event,
+		id:    uuid.NewString(),
+		path:  path,
+		total: length - 4 - headerLength,
+	}, nil
+}
+
+// next reads the next piece of the raw bytes, a blob of no bytes is a single empty piece
+func (f *blobFrame) next(r *bufio.Reader) ([]byte, *plugin_entities.SessionBlob, error) {
+	data := make([]byte, min(f.total-f.read, STDIO_BLOB_PIECE_SIZE))
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, nil, err
+	}
+
+	blob := &plugin_entities.SessionBlob{
+		Path:     f.path,
+		Data:     data,
+		ID:       f.id,
+		Sequence: f.sequence,
+		Offset:   f.read,
+		Total:    f.total,
+	}
+	f.read += uint64(len(data))
+	f.sequence++
+
+	return f.event, blob, nil
+}
+
+// done returns whether all the raw bytes are handed to the session
+func (f *blobFrame) done() bool {
+	return f.read >= f.total
+}
+
+// decodeBlobFrameHeader returns the session event of a blob frame and the path of the raw bytes in it
+func decodeBlobFrameHeader(header []byte) ([]byte, []string, error) {
+	event, err := parser.UnmarshalJsonBytes2Map(header)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid blob frame header: %s", err)
+	}
+
+	if event["event"] != string(plugin_entities.PLUGIN_EVENT_SESSION) {
+		return nil, nil, errors.New("blob frame is only allowed for session events")
+	}
+
+	blobPath, ok := event["blob_path"].(string)
+	if !ok || blobPath == "" {
+		return nil, nil, errors.New("blob_path is required in blob frame header")
+	}
+	delete(event, "blob_path")
+
+	// the path is relative to the data of the session message
+	keys := strings.Split(blobPath, ".")
+	if len(keys) < 3 || keys[0] != "data" || keys[1] != "data" {
+		return nil, nil, fmt.Errorf("blob_path %s is not in the data of the session message", blobPath)
+	}
+
+	return parser.MarshalJsonBytes(event), keys[2:], nil
+}
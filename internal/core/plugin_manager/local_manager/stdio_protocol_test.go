@@ -0,0 +1,230 @@
+package local_manager
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/parser"
+)
+
+func TestStdioFramedProtocol(t *testing.T) {
+	stdinReader, stdinWriter := io.Pipe()
+	stdoutReader, stdoutWriter := io.Pipe()
+	stderrReader, stderrWriter := io.Pipe()
+	defer stderrWriter.Close()
+
+	holder := registerStdioHandler("test", stdinWriter, stdoutReader, stderrReader)
+	defer removeStdioHandler(holder.GetID())
+
+	received := make(chan []byte, 2)
+	blobs := make(chan *plugin_entities.SessionBlob, 2)
+	setupStdioEventListener(holder.GetID(), "session-1", func(data []byte, blob *plugin_entities.SessionBlob) {
+		received <- data
+		blobs <- blob
+	})
+
+	heartbeat := make(chan bool, 1)
+	go holder.StartStdout(func() {
+		select {
+		case heartbeat <- true:
+		default:
+		}
+	}, func() {})
+
+	// messages written before the plugin switches the protocol are newline-delimited
+	go holder.write([]byte(`{"before":true}`))
+	line := make([]byte, len(`{"before":true}`)+1)
+	if _, err := io.ReadFull(stdinReader, line); err != nil || string(line) != "{\"before\":true}\n" {
+		t.Fatalf("expected a newline-delimited message, got %q", line)
+	}
+
+	if holder.protocolAnnounced.Load() {
+		t.Fatal("expected no protocol before the plugin announces it")
+	}
+	stdoutWriter.Write([]byte(`{"event":"protocol","data":{"version":2}}` + "\n"))
+
+	// larger than any line could be
+	large := strings.Repeat("a", STDIO_MAX_LINE_SIZE+1)
+	event := parser.MarshalJsonBytes(map[string]any{
+		"event":      "session",
+		"session_id": "session-1",
+		"data":       map[string]any{"type": "stream", "data": map[string]any{"text": large}},
+	})
+	go writeFrame(stdoutWriter, FRAME_TYPE_EVENT, event)
+
+	select {
+	case data := <-received:
+		<-blobs
+		if !bytes.Contains(data, []byte(large)) {
+			t.Fatal("expected the large message to be received")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the framed event to be received")
+	}
+
+	header := parser.MarshalJsonBytes(map[string]any{
+		"event":      "session",
+		"session_id": "session-1",
+		"data":       map[string]any{"type": "stream", "data": map[string]any{}},
+		"blob_path":  "data.data.blob",
+	})
+	blob := binary.BigEndian.AppendUint32(nil, uint32(len(header)))
+	blob = append(blob, header...)
+	blob = append(blob, 0x00, '\n', 0xff)
+	go writeFrame(stdoutWriter, FRAME_TYPE_BLOB, blob)
+
+	select {
+	case data := <-received:
+		if bytes.Contains(data, []byte(`blob`)) {
+			t.Fatalf("expected raw bytes to be kept out of the event, got %s", data)
+		}
+		blob := <-blobs
+		if blob == nil || strings.Join(blob.Path, ".") != "blob" || !bytes.Equal(blob.Data, []byte{0x00, '\n', 0xff}) {
+			t.Fatalf("expected raw bytes to be handed to the session, got %+v", blob)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the blob frame to be received")
+	}
+
+	go writeFrame(stdoutWriter, FRAME_TYPE_EVENT, []byte(`{"event":"heartbeat","session_id":"","data":{}}`))
+	select {
+	case <-heartbeat:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the heartbeat to be received")
+	}
+
+	// an sdk which announces its protocol handles cancel events
+	if !holder.protocolAnnounced.Load() {
+		t.Fatal("expected the protocol to be announced")
+	}
+
+	// once switched, writes are framed
+	go holder.write([]byte(`{"after":true}`))
+	stdin := bufio.NewReader(stdinReader)
+	frameType, length, err := readFrameHeader(stdin)
+	if err != nil || frameType != FRAME_TYPE_EVENT {
+		t.Fatalf("expected a framed message, got %#x %v", frameType, err)
+	}
+	payload, err := readFramePayload(stdin, length, STDIO_DEFAULT_MAX_EVENT_SIZE)
+	if err != nil || string(payload) != `{"after":true}` {
+		t.Fatalf("expected a framed message, got %q %v", payload, err)
+	}
+
+	stdoutWriter.Close()
+}
+
+func TestStdioLineTooLong(t *testing.T) {
+	reader := bufio.NewReader(strings.NewReader(strings.Repeat("a", STDIO_MAX_LINE_SIZE+1) + "\n"))
+	if _, err := readLine(reader); err == nil {
+		t.Fatal("expected a line exceeding the limit to be rejected")
+	}
+}
+
+func TestStdioEventFrameTooLarge(t *testing.T) {
+	reader := bufio.NewReader(bytes.NewReader(nil))
+	if _, err := readFramePayload(reader, 1025, 1024); err == nil {
+		t.Fatal("expected an event exceeding the limit to be rejected")
+	}
+
+	// a length which could not even be allocated
+	if _, err := readFramePayload(reader, ^uint64(0), 1024); err == nil {
+		t.Fatal("expected an event exceeding the limit to be rejected")
+	}
+}
+
+func blobFramePayload(header map[string]any, data []byte) []byte {
+	encoded := parser.MarshalJsonBytes(header)
+	payload := binary.BigEndian.AppendUint32(nil, uint32(len(encoded)))
+	payload = append(payload, encoded...)
+	return append(payload, data...)
+}
+
+func TestStdioBlobFrameInPieces(t *testing.T) {
+	holder := &stdioHolder{maxEventSize: STDIO_DEFAULT_MAX_EVENT_SIZE}
+	holder.framed.Store(true)
+
+	data := bytes.Repeat([]byte{0x00, '\n', 0xff}, STDIO_BLOB_PIECE_SIZE)
+	stdout := &bytes.Buffer{}
+	// an invalid frame is skipped as a whole, the frames after it are still read
+	writeFrame(stdout, FRAME_TYPE_BLOB, blobFramePayload(map[string]any{"event": "heartbeat"}, data))
+	writeFrame(stdout, FRAME_TYPE_BLOB, blobFramePayload(map[string]any{
+		"event":      "session",
+		"session_id": "session-1",
+		"data":       map[string]any{"type": "stream", "data": map[string]any{}},
+		"blob_path":  "data.data.blob",
+	}, data))
+	writeFrame(stdout, FRAME_TYPE_EVENT, []byte(`{"event":"heartbeat"}`))
+	reader := bufio.NewReader(stdout)
+
+	event, blob, err := holder.readEvent(reader)
+	if err != nil || event != nil || blob != nil {
+		t.Fatalf("expected the invalid frame to be skipped, got %s %+v %v", event, blob, err)
+	}
+
+	received := []byte{}
+	for sequence := 0; ; sequence++ {
+		event, blob, err := holder.readEvent(reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if blob == nil || bytes.Contains(event, []byte(`blob`)) {
+			t.Fatalf("expected a piece of the blob, got %s %+v", event, blob)
+		}
+		if len(blob.Data) > STDIO_BLOB_PIECE_SIZE {
+			t.Fatalf("expected pieces of at most %d bytes, got %d", STDIO_BLOB_PIECE_SIZE, len(blob.Data))
+		}
+		if blob.Sequence != sequence || blob.Offset != uint64(len(received)) || blob.Total != uint64(len(data)) {
+			t.Fatalf("expected piece %d at %d of %d, got %+v", sequence, len(received), len(data), blob)
+		}
+		received = append(received, blob.Data...)
+		if blob.Last() {
+			break
+		}
+	}
+	if !bytes.Equal(received, data) {
+		t.Fatal("expected the pieces to make up the blob")
+	}
+
+	event, blob, err = holder.readEvent(reader)
+	if err != nil || blob != nil || string(event) != `{"event":"heartbeat"}` {
+		t.Fatalf("expected the event after the blob, got %s %+v %v", event, blob, err)
+	}
+}
+
+func TestStdioBlobFrameWithoutCeiling(t *testing.T) {
+	header := parser.MarshalJsonBytes(map[string]any{
+		"event":      "session",
+		"session_id": "session-1",
+		"data":       map[string]any{"type": "stream", "data": map[string]any{}},
+		"blob_path":  "data.data.blob",
+	})
+	payload := binary.BigEndian.AppendUint32(nil, uint32(len(header)))
+	payload = append(payload, header...)
+
+	// the raw bytes are left in the reader rather than allocated
+	frame, err := openBlobFrame(bufio.NewReader(bytes.NewReader(payload)), ^uint64(0), 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if frame.total != ^uint64(0)-4-uint64(len(header)) {
+		t.Fatalf("expected the rest of the frame to be the blob, got %d bytes", frame.total)
+	}
+}
+
+func TestStdioBlobFrameOutsideSessionData(t *testing.T) {
+	header := parser.MarshalJsonBytes(map[string]any{
+		"event":      "session",
+		"session_id": "session-1",
+		"data":       map[string]any{"type": "stream", "data": map[string]any{}},
+		"blob_path":  "session_id",
+	})
+	if _, _, err := decodeBlobFrameHeader(header); err == nil {
+		t.Fatal("expected a blob outside of the data of the session message to be rejected")
+	}
+}
@@ -3,8 +3,10 @@ package local_manager
 import (
 	"io"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
 )
 
 func registerStdioHandler(
@@ -23,6 +25,11 @@ func registerStdioHandler(
 
 		waitControllerChanLock: &sync.Mutex{},
 		waitingControllerChan:  make(chan bool),
+
+		health:       defaultHealthCheck(),
+		startedAt:    time.Now(),
+		lastActiveAt: time.Now(),
+		maxEventSize: STDIO_DEFAULT_MAX_EVENT_SIZE,
 	}
 
 	stdio_holder.Store(id, holder)
@@ -43,13 +50,15 @@ func removeStdioHandler(id string) {
 	stdio_holder.Delete(id)
 }
 
-func setupStdioEventListener(id string, session_id string, listener func([]byte)) {
+func setupStdioEventListener(
+	id string, session_id string, listener func([]byte, *plugin_entities.SessionBlob),
+) {
 	if v, ok := stdio_holder.Load(id); ok {
 		if holder, ok := v.(*stdioHolder); ok {
 			holder.l.Lock()
 			defer holder.l.Unlock()
 			if holder.listener == nil {
-				holder.listener = map[string]func([]byte){}
+				holder.listener = map[string]func([]byte, *plugin_entities.SessionBlob){}
 			}
 
 			holder.listener[session_id] = listener
@@ -88,12 +97,22 @@ func OnGlobalEvent(listener func(string, []byte)) {
 	listeners[uuid.New().String()] = listener
 }
 
+// globalStdioListeners returns a copy of the listeners of every stdio
+func globalStdioListeners() []func(string, []byte) {
+	l.Lock()
+	defer l.Unlock()
+
+	copied := make([]func(string, []byte), 0, len(listeners))
+	for _, listener := range listeners {
+		copied = append(copied, listener)
+	}
+	return copied
+}
+
 func writeToStdioHandler(id string, data []byte) error {
 	if v, ok := stdio_holder.Load(id); ok {
 		if holder, ok := v.(*stdioHolder); ok {
-			_, err := holder.writer.Write(data)
-
-			return err
+			return holder.write(data)
 		}
 	}
 
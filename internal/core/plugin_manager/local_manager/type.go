@@ -2,17 +2,20 @@ package local_manager
 
 import (
 	"sync"
+	"time"
 
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/log_manager"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/positive_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/mapping"
 )
 
 type LocalPluginRuntime struct {
 	positive_manager.PositivePluginRuntime
 	plugin_entities.PluginRuntime
 
-	waitChan   chan bool
-	ioIdentity string
+	waitChan chan bool
 
 	// python interpreter path, currently only support python
 	pythonInterpreterPath string
@@ -21,17 +24,202 @@ type LocalPluginRuntime struct {
 	// by using its venv module
 	defaultPythonInterpreterPath string
 
+	// go toolchain to build the plugin and the binary it produces
+	goCompilerPath string
+	goBinaryPath   string
+
+	// node executable to launch the plugin
+	nodeExecutablePath string
+
 	waitChanLock    sync.Mutex
 	waitStartedChan []chan bool
 	waitStoppedChan []chan bool
 
 	isNotFirstStart bool
+
+	// process pool of the plugin, sessions are dispatched across the instances
+	poolConfig       PoolConfig
+	instances        []*pluginInstance
+	sessionInstances map[string]*pluginInstance
+	instanceLock     sync.RWMutex
+
+	// sessions which could not be bound to any instance, they are failed by their first write
+	unboundSessions mapping.Map[string, *entities.Broadcast[plugin_entities.SessionMessage]]
+
+	// closed once StartPlugin returns, exited instances are no longer reported then
+	poolDone chan bool
+
+	// crashed instances are started again after a backoff, decided by the handler if set
+	instanceRestartHandler func(uptime time.Duration) (time.Duration, bool)
+	instanceRestartBackoff time.Duration
+	instanceRestartAt      time.Time
+	// set once instances crash too often, the pool is stopped and no instance is started
+	crashLooping bool
+
+	// notified when the pool needs to scale up
+	scaleUpChan chan bool
+
+	// a parked pool has no process, it's woken up by the next session
+	parked   bool
+	wakeChan chan bool
+	// sessions waiting for the pool to wake up, the pool is not parked while there are any
+	pendingBinds int
+	// the last time a session was dispatched or finished
+	lastSessionAt time.Time
+
+	// a draining pool accepts no new session, it's stopped once in-flight sessions finish
+	draining bool
+
+	// extra environment variables of the processes, `KEY=VALUE` pairs
+	environment []string
+	// notified when all the instances need to be replaced
+	restartChan chan bool
+
+	// resource limits applied to each process
+	cgroupConfig CgroupConfig
+
+	// isolation applied to each process
+	sandboxConfig SandboxConfig
+
+	// installation of python dependencies
+	pythonDependencyConfig PythonDependencyConfig
+
+	// bounds of the liveness and readiness checks declared by the plugin
+	healthConfig HealthConfig
+
+	// bounds of what the processes send through stdio
+	stdioConfig StdioConfig
+
+	// logs and lifecycle events of the plugin are kept in it if set
+	logStore *log_manager.LogStore
+	// reports unexpected exits of the processes if set
+	crashHandler func(CrashReport)
+
+	// stages of the launch in progress are reported to them
+	launchHandlers     []LaunchHandler
+	currentLaunchStage LaunchStage
+	launchLock         sync.Mutex
+
+	// the working path is a copy of a source directory kept across restarts rather than an installed package,
+	// it's never removed
+	fromSource bool
+}
+
+// PoolConfig controls how many processes a local plugin runtime holds
+type PoolConfig struct {
+	// minimum number of processes kept alive while the plugin is healthy
+	MinInstances int
+	// maximum number of processes the pool could grow to
+	MaxInstances int
+	// in-flight sessions per instance before the pool grows
+	ScaleUpThreshold int
+	// an instance beyond MinInstances is stopped after being idle for this long
+	ScaleDownIdleTimeout time.Duration
+	// all the instances are stopped after the plugin receives no session for this long,
+	// the next session starts them again, 0 keeps the plugin running forever
+	IdleTimeout time.Duration
 }
 
 func NewLocalPluginRuntime(
 	pythonInterpreterPath string,
+	goCompilerPath string,
+	nodeExecutablePath string,
+	poolConfig PoolConfig,
+	cgroupConfig CgroupConfig,
+	sandboxConfig SandboxConfig,
+	pythonDependencyConfig PythonDependencyConfig,
+	healthConfig HealthConfig,
 ) *LocalPluginRuntime {
+	if poolConfig.MinInstances < 1 {
+		poolConfig.MinInstances = 1
+	}
+
+	if poolConfig.MaxInstances < poolConfig.MinInstances {
+		poolConfig.MaxInstances = poolConfig.MinInstances
+	}
+
+	if poolConfig.ScaleUpThreshold < 1 {
+		poolConfig.ScaleUpThreshold = 1
+	}
+
 	return &LocalPluginRuntime{
 		defaultPythonInterpreterPath: pythonInterpreterPath,
+		goCompilerPath:               goCompilerPath,
+		nodeExecutablePath:           nodeExecutablePath,
+		poolConfig:                   poolConfig,
+		cgroupConfig:                 cgroupConfig,
+		sandboxConfig:                sandboxConfig,
+		pythonDependencyConfig:       pythonDependencyConfig,
+		healthConfig:                 healthConfig,
+		sessionInstances:             map[string]*pluginInstance{},
+		wakeChan:                     make(chan bool, 1),
+		restartChan:                  make(chan bool, 1),
 	}
 }
+
+// SetStdioConfig sets the bounds of what the processes send through stdio
+func (r *LocalPluginRuntime) SetStdioConfig(config StdioConfig) {
+	r.stdioConfig = config
+}
+
+// SetLogStore sets where logs and lifecycle events of the plugin are kept
+func (r *LocalPluginRuntime) SetLogStore(store *log_manager.LogStore) {
+	r.logStore = store
+}
+
+// SetFromSource runs the plugin from a directory it's not installed to, the directory is kept after the plugin stops
+func (r *LocalPluginRuntime) SetFromSource() {
+	r.fromSource = true
+}
+
+// FromSource returns whether the plugin runs from a directory it's not installed to
+func (r *LocalPluginRuntime) FromSource() bool {
+	return r.fromSource
+}
+
+// Cleanup removes the working directory unless the plugin runs from source
+func (r *LocalPluginRuntime) Cleanup() {
+	if r.fromSource {
+		return
+	}
+
+	r.PositivePluginRuntime.Cleanup()
+}
+
+// SetEnvironment sets extra environment variables of the processes started afterwards
+func (r *LocalPluginRuntime) SetEnvironment(environment []string) {
+	r.instanceLock.Lock()
+	defer r.instanceLock.Unlock()
+	r.environment = environment
+}
+
+func (r *LocalPluginRuntime) getEnvironment() []string {
+	r.instanceLock.RLock()
+	defer r.instanceLock.RUnlock()
+	return r.environment
+}
+
+// lifecycle keeps a lifecycle event of the plugin in the log store
+func (r *LocalPluginRuntime) lifecycle(level string, format string, args ...any) {
+	if r.logStore == nil {
+		return
+	}
+
+	r.logStore.Lifecycle(level, format, args...)
+}
+
+// RuntimeState returns the runtime state of the plugin with processes and sessions of the pool
+func (r *LocalPluginRuntime) RuntimeState() plugin_entities.PluginRuntimeState {
+	state := r.PluginRuntime.RuntimeState()
+
+	r.instanceLock.RLock()
+	defer r.instanceLock.RUnlock()
+
+	state.Pids = make([]int, 0, len(r.instances))
+	for _, instance := range r.instances {
+		state.Pids = append(state.Pids, instance.pid)
+	}
+	state.Sessions = len(r.sessionInstances)
+
+	return state
+}
@@ -0,0 +1,90 @@
+package log_manager
+
+import (
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/mapping"
+)
+
+// Config controls where logs of plugins are kept and for how long
+type Config struct {
+	// logs of every plugin are kept in a directory under it
+	Path string
+	// max size of logs of a plugin in bytes
+	MaxSize int64
+	// logs older than this are removed
+	Retention time.Duration
+}
+
+// LogManager holds the log stores of plugins
+type LogManager struct {
+	config Config
+	stores mapping.Map[string, *LogStore]
+}
+
+func NewLogManager(config Config) *LogManager {
+	return &LogManager{
+		config: config,
+	}
+}
+
+// Store returns the log store of the plugin, it's created on first use
+func (m *LogManager) Store(pluginUniqueIdentifier string) *LogStore {
+	if store, ok := m.stores.Load(pluginUniqueIdentifier); ok {
+		return store
+	}
+
+	store, _ := m.stores.LoadOrStore(
+		pluginUniqueIdentifier,
+		newLogStore(m.storePath(pluginUniqueIdentifier), m.config),
+	)
+	return store
+}
+
+func (m *LogManager) storePath(pluginUniqueIdentifier string) string {
+	return path.Join(m.config.Path, strings.NewReplacer(":", "-", "/", "-").Replace(pluginUniqueIdentifier))
+}
+
+// Collect removes expired segments of all plugins, including uninstalled ones
+func (m *LogManager) Collect() {
+	entries, err := os.ReadDir(m.config.Path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Error("read plugin logs failed: %s", err.Error())
+		}
+		return
+	}
+
+	opened := map[string]*LogStore{}
+	m.stores.Range(func(key string, store *LogStore) bool {
+		opened[store.path] = store
+		return true
+	})
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		storePath := path.Join(m.config.Path, entry.Name())
+		store, ok := opened[storePath]
+		if !ok {
+			store = newLogStore(storePath, m.config)
+		}
+
+		store.lock.Lock()
+		if err := store.expire(); err != nil {
+			log.Error("remove expired logs of %s failed: %s", entry.Name(), err.Error())
+		}
+		store.lock.Unlock()
+
+		// nothing left for an uninstalled plugin
+		if !ok {
+			os.Remove(storePath)
+		}
+	}
+}
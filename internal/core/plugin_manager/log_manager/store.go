@@ -0,0 +1,268 @@
+package log_manager
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/parser"
+)
+
+const (
+	LOG_SOURCE_STDOUT    = "stdout"
+	LOG_SOURCE_STDERR    = "stderr"
+	LOG_SOURCE_LIFECYCLE = "lifecycle"
+
+	LOG_LEVEL_INFO  = "info"
+	LOG_LEVEL_WARN  = "warn"
+	LOG_LEVEL_ERROR = "error"
+
+	// logs of a plugin are split into segments, the oldest segment is removed
+	// once the logs exceed the max size
+	LOG_SEGMENTS       = 8
+	LOG_SEGMENT_SUFFIX = ".log"
+)
+
+type LogEntry struct {
+	Time      time.Time `json:"time"`
+	Level     string    `json:"level"`
+	Source    string    `json:"source"`
+	SessionID string    `json:"session_id,omitempty"`
+	// the tenant which owns the session
+	TenantID string `json:"tenant_id,omitempty"`
+	Message  string `json:"message"`
+}
+
+type LogFilter struct {
+	// exact level, case insensitive, empty matches all
+	Level     string
+	SessionID string
+	// entries of sessions of the tenant and lifecycle events of the plugin, empty matches all,
+	// stderr and other entries of no session are never matched as they could carry data of any tenant
+	TenantID string
+	Since    time.Time
+	Limit    int
+	// returns the latest entries instead of the earliest ones
+	Tail bool
+}
+
+func (f *LogFilter) match(entry *LogEntry) bool {
+	if f.Level != "" && !strings.EqualFold(f.Level, entry.Level) {
+		return false
+	}
+	if f.SessionID != "" && f.SessionID != entry.SessionID {
+		return false
+	}
+	if f.TenantID != "" && entry.Source != LOG_SOURCE_LIFECYCLE && f.TenantID != entry.TenantID {
+		return false
+	}
+	if !f.Since.IsZero() && entry.Time.Before(f.Since) {
+		return false
+	}
+	return true
+}
+
+// LogStore is a ring buffer of logs of a plugin on disk
+type LogStore struct {
+	path   string
+	config Config
+
+	lock        sync.Mutex
+	segment     *os.File
+	segmentSize int64
+}
+
+func newLogStore(path string, config Config) *LogStore {
+	return &LogStore{
+		path:   path,
+		config: config,
+	}
+}
+
+// Append writes an entry to the current segment, rotating it if it's full
+func (s *LogStore) Append(entry LogEntry) error {
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+	line := append(parser.MarshalJsonBytes(entry), '\n')
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.segment == nil || s.segmentSize+int64(len(line)) > s.config.MaxSize/LOG_SEGMENTS {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := s.segment.Write(line)
+	s.segmentSize += int64(n)
+	return err
+}
+
+// Lifecycle appends a lifecycle event of the plugin
+func (s *LogStore) Lifecycle(level string, format string, args ...any) {
+	s.Append(LogEntry{
+		Level:   level,
+		Source:  LOG_SOURCE_LIFECYCLE,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// rotate starts a new segment and removes segments beyond the retention, caller should hold the lock
+func (s *LogStore) rotate() error {
+	if s.segment != nil {
+		s.segment.Close()
+		s.segment = nil
+	}
+
+	if err := os.MkdirAll(s.path, 0755); err != nil {
+		return fmt.Errorf("failed to create log directory: %s", err)
+	}
+
+	segment, err := os.OpenFile(
+		path.Join(s.path, fmt.Sprintf("%020d%s", time.Now().UnixNano(), LOG_SEGMENT_SUFFIX)),
+		os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create log segment: %s", err)
+	}
+
+	s.segment = segment
+	s.segmentSize = 0
+
+	return s.expire()
+}
+
+// expire removes segments older than the retention and the oldest segments beyond the max size,
+// the current segment is never removed, caller should hold the lock
+func (s *LogStore) expire() error {
+	segments, err := s.segments()
+	if err != nil {
+		return err
+	}
+
+	var total int64
+	sizes := make([]int64, len(segments))
+	modified := make([]time.Time, len(segments))
+	for i, segment := range segments {
+		info, err := os.Stat(segment)
+		if err != nil {
+			continue
+		}
+		sizes[i] = info.Size()
+		modified[i] = info.ModTime()
+		total += info.Size()
+	}
+
+	for i, segment := range segments {
+		if s.segment != nil && segment == s.segment.Name() {
+			break
+		}
+		if total <= s.config.MaxSize && time.Since(modified[i]) <= s.config.Retention {
+			break
+		}
+		if err := os.Remove(segment); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		total -= sizes[i]
+	}
+
+	return nil
+}
+
+// segments returns segment files of the plugin from the oldest to the latest
+func (s *LogStore) segments() ([]string, error) {
+	entries, err := os.ReadDir(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	segments := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), LOG_SEGMENT_SUFFIX) {
+			continue
+		}
+		segments = append(segments, path.Join(s.path, entry.Name()))
+	}
+	sort.Strings(segments)
+
+	return segments, nil
+}
+
+// Query returns entries matching the filter in chronological order
+func (s *LogStore) Query(filter LogFilter) ([]LogEntry, error) {
+	s.lock.Lock()
+	segments, err := s.segments()
+	s.lock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	retention := time.Now().Add(-s.config.Retention)
+	entries := []LogEntry{}
+
+	for _, segment := range segments {
+		done, err := func() (bool, error) {
+			file, err := os.Open(segment)
+			if err != nil {
+				if os.IsNotExist(err) {
+					// removed by rotation
+					return false, nil
+				}
+				return false, err
+			}
+			defer file.Close()
+
+			reader := bufio.NewReader(file)
+			for {
+				line, err := reader.ReadBytes('\n')
+				if err != nil {
+					// the last line may be partially written
+					return false, nil
+				}
+
+				entry, err := parser.UnmarshalJsonBytes[LogEntry](line)
+				if err != nil || entry.Time.Before(retention) || !filter.match(&entry) {
+					continue
+				}
+
+				entries = append(entries, entry)
+				if filter.Limit > 0 && len(entries) >= filter.Limit {
+					if !filter.Tail {
+						return true, nil
+					}
+					// only keep the latest entries
+					entries = entries[len(entries)-filter.Limit:]
+				}
+			}
+		}()
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			break
+		}
+	}
+
+	return entries, nil
+}
+
+// Close closes the current segment
+func (s *LogStore) Close() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.segment != nil {
+		s.segment.Close()
+		s.segment = nil
+	}
+}
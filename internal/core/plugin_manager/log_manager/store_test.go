@@ -0,0 +1,128 @@
+package log_manager
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestLogStoreQuery(t *testing.T) {
+	manager := NewLogManager(Config{
+		Path:      t.TempDir(),
+		MaxSize:   1024 * 1024,
+		Retention: time.Hour,
+	})
+	store := manager.Store("langgenius/test:0.0.1@abc")
+
+	for i := 0; i < 10; i++ {
+		level := LOG_LEVEL_INFO
+		if i%2 == 1 {
+			level = LOG_LEVEL_ERROR
+		}
+		if err := store.Append(LogEntry{
+			Level:     level,
+			Source:    LOG_SOURCE_STDOUT,
+			SessionID: fmt.Sprintf("session-%d", i%3),
+			Message:   fmt.Sprintf("message %d", i),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, err := store.Query(LogFilter{Limit: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 || entries[0].Message != "message 0" || entries[2].Message != "message 2" {
+		t.Fatalf("expected the earliest 3 entries, got %v", entries)
+	}
+
+	entries, err = store.Query(LogFilter{Limit: 3, Tail: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 || entries[0].Message != "message 7" || entries[2].Message != "message 9" {
+		t.Fatalf("expected the latest 3 entries, got %v", entries)
+	}
+
+	entries, err = store.Query(LogFilter{Level: "ERROR", SessionID: "session-0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// errors are odd, session-0 are multiples of 3
+	if len(entries) != 2 || entries[0].Message != "message 3" || entries[1].Message != "message 9" {
+		t.Fatalf("expected entries filtered by level and session, got %v", entries)
+	}
+}
+
+func TestLogStoreQueryOfTenant(t *testing.T) {
+	store := NewLogManager(Config{
+		Path:      t.TempDir(),
+		MaxSize:   1024 * 1024,
+		Retention: time.Hour,
+	}).Store("langgenius/test:0.0.1@abc")
+
+	for _, entry := range []LogEntry{
+		{Source: LOG_SOURCE_STDOUT, SessionID: "session-1", TenantID: "tenant-1", Message: "own"},
+		{Source: LOG_SOURCE_STDOUT, SessionID: "session-2", TenantID: "tenant-2", Message: "other"},
+		{Source: LOG_SOURCE_STDERR, Message: "stderr"},
+		{Source: LOG_SOURCE_STDOUT, Message: "no session"},
+		{Source: LOG_SOURCE_LIFECYCLE, Message: "started"},
+	} {
+		if err := store.Append(entry); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, err := store.Query(LogFilter{TenantID: "tenant-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].Message != "own" || entries[1].Message != "started" {
+		t.Fatalf("expected entries of the tenant and lifecycle events only, got %v", entries)
+	}
+
+	entries, err = store.Query(LogFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 5 {
+		t.Fatalf("expected every entry without a tenant, got %v", entries)
+	}
+}
+
+func TestLogStoreMaxSize(t *testing.T) {
+	store := NewLogManager(Config{
+		Path:      t.TempDir(),
+		MaxSize:   8 * 1024,
+		Retention: time.Hour,
+	}).Store("test")
+
+	for i := 0; i < 1000; i++ {
+		store.Append(LogEntry{Level: LOG_LEVEL_INFO, Message: fmt.Sprintf("message %d", i)})
+	}
+
+	segments, err := store.segments()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(segments) > LOG_SEGMENTS+1 {
+		t.Fatalf("expected old segments to be removed, got %d segments", len(segments))
+	}
+
+	entries, err := store.Query(LogFilter{Limit: 1, Tail: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Message != "message 999" {
+		t.Fatalf("expected the latest entry to be kept, got %v", entries)
+	}
+
+	entries, err = store.Query(LogFilter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Message == "message 0" {
+		t.Fatalf("expected the earliest entries to be dropped, got %v", entries)
+	}
+}
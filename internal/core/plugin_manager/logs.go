@@ -0,0 +1,14 @@
+package plugin_manager
+
+import (
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/log_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+)
+
+// QueryLogs returns logs of the plugin kept on the current node
+func (p *PluginManager) QueryLogs(
+	identity plugin_entities.PluginUniqueIdentifier,
+	filter log_manager.LogFilter,
+) ([]log_manager.LogEntry, error) {
+	return p.logManager.Store(identity.String()).Query(filter)
+}
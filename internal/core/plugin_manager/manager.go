@@ -4,10 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/mlchain_invocation"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/mlchain_invocation/real"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/local_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/log_manager"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/media_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/plugin_errors"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/remote_manager"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/serverless"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_packager/decoder"
@@ -18,6 +22,7 @@ import (
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/models"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/cache"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/cache/helper"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/cgroup"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/lock"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/mapping"
@@ -56,6 +61,47 @@ type PluginManager struct {
 	// python interpreter path
 	pythonInterpreterPath string
 
+	// go toolchain path
+	goCompilerPath string
+
+	// node executable path
+	nodeExecutablePath string
+
+	// process pool settings of local plugins
+	localPluginPoolConfig local_manager.PoolConfig
+
+	// resource limits of local plugins
+	localPluginCgroupConfig local_manager.CgroupConfig
+
+	// isolation of local plugins
+	localPluginSandboxConfig local_manager.SandboxConfig
+
+	// installation of python dependencies of local plugins
+	localPluginPythonDependencyConfig local_manager.PythonDependencyConfig
+
+	// bounds of the health checks local plugins declare
+	localPluginHealthConfig local_manager.HealthConfig
+
+	// bounds of what local plugins send through stdio
+	localPluginStdioConfig local_manager.StdioConfig
+
+	// how plugins are restarted after exiting
+	restartPolicy RestartPolicy
+
+	// plugins in crash loop waiting for reset
+	crashLoopResets mapping.Map[string, chan bool]
+
+	// how long in-flight sessions of a stopping local plugin get to finish
+	drainGracePeriod time.Duration
+
+	// logs and lifecycle events of local plugins
+	logManager *log_manager.LogManager
+
+	// environment variables of plugins are encrypted with it
+	environmentSecretKey []byte
+	// decrypted tenant-scoped variables keyed by `plugin_id:tenant_id`, dropped once they change
+	tenantEnvironments mapping.Map[string, map[string]string]
+
 	// remote plugin server
 	remotePluginServer remote_manager.RemotePluginServerInterface
 
@@ -71,6 +117,12 @@ var (
 )
 
 func InitGlobalManager(oss oss.OSS, configuration *app.Config) *PluginManager {
+	// a configuration without defaults applied restarts forever
+	maxRestarts := 0
+	if configuration.PluginRestartMaxRestarts != nil {
+		maxRestarts = *configuration.PluginRestartMaxRestarts
+	}
+
 	manager = &PluginManager{
 		maxPluginPackageSize: configuration.MaxPluginPackageSize,
 		pluginStoragePath:    configuration.PluginInstalledPath,
@@ -91,7 +143,53 @@ func InitGlobalManager(oss oss.OSS, configuration *app.Config) *PluginManager {
 		localPluginLaunchingLock: lock.NewGranularityLock(),
 		maxLaunchingLock:         make(chan bool, 2), // by default, we allow 2 plugins launching at the same time
 		pythonInterpreterPath:    configuration.PythonInterpreterPath,
+		goCompilerPath:           configuration.GoCompilerPath,
+		nodeExecutablePath:       configuration.NodeExecutablePath,
 		platform:                 configuration.Platform,
+		localPluginPoolConfig: local_manager.PoolConfig{
+			MinInstances:         configuration.PluginLocalMinInstances,
+			MaxInstances:         configuration.PluginLocalMaxInstances,
+			ScaleUpThreshold:     configuration.PluginLocalScaleUpThreshold,
+			ScaleDownIdleTimeout: time.Duration(configuration.PluginLocalScaleDownIdleTimeout) * time.Second,
+			IdleTimeout:          time.Duration(configuration.PluginLocalIdleTimeout) * time.Second,
+		},
+		localPluginCgroupConfig: local_manager.CgroupConfig{
+			Enabled:  configuration.PluginCgroupEnabled,
+			Root:     configuration.PluginCgroupRoot,
+			CPUQuota: configuration.PluginCgroupCPUQuota,
+		},
+		localPluginSandboxConfig: local_manager.SandboxConfig{
+			Enabled:       configuration.PluginSandboxEnabled,
+			ReadOnlyPaths: configuration.PluginSandboxReadOnlyPaths,
+		},
+		localPluginHealthConfig: local_manager.HealthConfig{
+			HeartbeatIntervalMin: time.Duration(configuration.PluginHeartbeatIntervalMin) * time.Second,
+			HeartbeatIntervalMax: time.Duration(configuration.PluginHeartbeatIntervalMax) * time.Second,
+			HeartbeatTimeoutMin:  time.Duration(configuration.PluginHeartbeatTimeoutMin) * time.Second,
+			HeartbeatTimeoutMax:  time.Duration(configuration.PluginHeartbeatTimeoutMax) * time.Second,
+			ReadinessTimeoutMax:  time.Duration(configuration.PluginReadinessTimeoutMax) * time.Second,
+		},
+		localPluginStdioConfig: local_manager.StdioConfig{
+			MaxEventSize: configuration.PluginStdioMaxEventSize,
+		},
+		restartPolicy: RestartPolicy{
+			InitialBackoff: time.Duration(configuration.PluginRestartInitialBackoff) * time.Second,
+			MaxBackoff:     time.Duration(configuration.PluginRestartMaxBackoff) * time.Second,
+			MaxRestarts:    maxRestarts,
+			Window:         time.Duration(configuration.PluginRestartWindow) * time.Second,
+		},
+		drainGracePeriod: time.Duration(configuration.PluginDrainGracePeriod) * time.Second,
+		logManager: log_manager.NewLogManager(log_manager.Config{
+			Path:      configuration.PluginLogPath,
+			MaxSize:   configuration.PluginLogMaxSize,
+			Retention: time.Duration(configuration.PluginLogRetention) * time.Second,
+		}),
+		environmentSecretKey: environmentSecretKey(configuration.PluginEnvironmentSecret),
+		localPluginPythonDependencyConfig: local_manager.PythonDependencyConfig{
+			CachePath:      configuration.PythonDependencyCachePath,
+			LocalIndexPath: configuration.PythonLocalIndexPath,
+			PipFallback:    configuration.PythonDependencyPipFallback,
+		},
 	}
 
 	return manager
@@ -107,6 +205,14 @@ func (p *PluginManager) Get(
 	if identity.RemoteLike() || p.platform == app.PLATFORM_LOCAL {
 		// check if it's a debugging plugin or a local plugin
 		if v, ok := p.m.Load(identity.String()); ok {
+			// fail fast instead of waiting for a plugin which keeps crashing
+			state := v.RuntimeState()
+			if state.Draining {
+				return nil, plugin_errors.ErrPluginDraining
+			}
+			if state.Status == plugin_entities.PLUGIN_RUNTIME_STATUS_CRASH_LOOP {
+				return nil, plugin_errors.ErrPluginCrashLoop
+			}
 			return v, nil
 		}
 		return nil, errors.New("plugin not found")
@@ -144,9 +250,27 @@ func (p *PluginManager) Launch(configuration *app.Config) {
 	}
 	p.backwardsInvocation = invocation
 
+	// plugins in crash loop could be reset from any node
+	p.startCrashLoopResetListener()
+
+	// environment of plugins could be changed from any node
+	p.startEnvironmentChangedListener()
+
 	// start local watcher
 	if configuration.Platform == app.PLATFORM_LOCAL {
+		if configuration.PluginCgroupEnabled {
+			// local plugins fail to launch rather than running without their limits
+			if err := cgroup.Init(configuration.PluginCgroupRoot); err != nil {
+				log.Error("init cgroup failed, local plugins could not be launched: %s", err.Error())
+				p.localPluginCgroupConfig.InitError = err
+			}
+		}
+
 		p.startLocalWatcher()
+
+		if len(configuration.PluginDevPaths) > 0 {
+			p.startDevWatcher(configuration)
+		}
 	}
 
 	// launch serverless connector
@@ -3,5 +3,8 @@ package plugin_errors
 import "errors"
 
 var (
-	ErrPluginNotActive = errors.New("plugin is not active, does not respond to heartbeat in 20 seconds")
+	ErrPluginNotActive = errors.New("plugin is not active, does not respond to heartbeat in time")
+	ErrPluginNotReady  = errors.New("plugin does not report ready in time")
+	ErrPluginCrashLoop = errors.New("plugin keeps crashing after restarts and has been put in crash loop, fix it and reset its status")
+	ErrPluginDraining  = errors.New("plugin is being stopped and does not accept new requests")
 )
@@ -79,6 +79,8 @@ func (s *MlchainServer) OnOpen(c gnet.Conn) (out []byte, action gnet.Action) {
 
 		alive: true,
 	}
+	// stop reading the connection rather than dropping messages once sessions read slowly
+	runtime.response.SetBlocking()
 
 	// store plugin runtime
 	s.pluginsLock.Lock()
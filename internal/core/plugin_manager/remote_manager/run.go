@@ -50,7 +50,7 @@ func (r *RemotePluginRuntime) StartPlugin() error {
 		for {
 			select {
 			case <-ticker.C:
-				if time.Since(r.lastActiveAt) > 60*time.Second {
+				if !r.delivering.Load() && time.Since(r.lastActiveAt) > 60*time.Second {
 					// kill this connection if it's not active for a long time
 					r.conn.Close()
 					exitError = plugin_errors.ErrPluginNotActive
@@ -70,19 +70,23 @@ func (r *RemotePluginRuntime) StartPlugin() error {
 				listeners := r.callbacks[session_id][:]
 				r.callbacksLock.RUnlock()
 
-				// handle session event
+				// handle session event, a slow caller holds the connection back
+				r.delivering.Store(true)
 				for _, listener := range listeners {
 					listener(data)
 				}
+				r.delivering.Store(false)
+				r.lastActiveAt = time.Now()
 			},
 			func() {
 				r.lastActiveAt = time.Now()
 			},
+			func() {},
 			func(err string) {
 				log.Error("plugin %s: %s", r.Configuration().Identity(), err)
 			},
-			func(message string) {
-				log.Info("plugin %s: %s", r.Configuration().Identity(), message)
+			func(session_id string, event plugin_entities.PluginLogEvent) {
+				log.Info("plugin %s: %s", r.Configuration().Identity(), event.Message)
 			},
 		)
 	})
@@ -3,6 +3,7 @@ package remote_manager
 import (
 	"bytes"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/basic_manager"
@@ -35,6 +36,8 @@ type RemotePluginRuntime struct {
 
 	// heartbeat
 	lastActiveAt time.Time
+	// set while a response is handed to a session which reads slowly, the connection is not read then
+	delivering atomic.Bool
 
 	assets      map[string]*bytes.Buffer
 	assetsBytes int64
@@ -0,0 +1,101 @@
+package plugin_manager
+
+import (
+	"sync"
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+)
+
+// RestartPolicy decides how a plugin which has exited is restarted
+type RestartPolicy struct {
+	// wait before the first restart, doubled for every following restart
+	InitialBackoff time.Duration
+	// upper bound of the wait
+	MaxBackoff time.Duration
+	// a plugin restarted more than MaxRestarts times within Window is crash looping, 0 means never,
+	// a plugin which has been running for Window is considered healthy again
+	MaxRestarts int
+	Window      time.Duration
+}
+
+// resolve applies the restart policy declared in the manifest of a plugin, the policy set by the admin
+// is used for the values the plugin does not declare
+func (p RestartPolicy) resolve(declared *plugin_entities.PluginRestartPolicy) RestartPolicy {
+	if declared == nil {
+		return p
+	}
+
+	if declared.InitialBackoff > 0 {
+		p.InitialBackoff = time.Duration(declared.InitialBackoff) * time.Second
+	}
+	if declared.MaxBackoff > 0 {
+		p.MaxBackoff = time.Duration(declared.MaxBackoff) * time.Second
+	}
+	if declared.MaxRestarts > 0 {
+		p.MaxRestarts = declared.MaxRestarts
+	}
+	if declared.Window > 0 {
+		p.Window = time.Duration(declared.Window) * time.Second
+	}
+
+	p.MaxBackoff = max(p.MaxBackoff, p.InitialBackoff)
+	return p
+}
+
+// restartTracker applies the restart policy to one plugin, exits of the whole plugin
+// and crashes of single processes of it are tracked together
+type restartTracker struct {
+	lock     sync.Mutex
+	policy   RestartPolicy
+	restarts []time.Time
+	backoff  time.Duration
+}
+
+func newRestartTracker(policy RestartPolicy) *restartTracker {
+	return &restartTracker{policy: policy}
+}
+
+// next records an exit of the plugin after running for uptime,
+// returns how long to wait before restarting it, or false if the plugin is crash looping
+func (t *restartTracker) next(now time.Time, uptime time.Duration) (time.Duration, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if uptime >= t.policy.Window {
+		t.resetLocked()
+	}
+
+	// forget restarts out of the window
+	recent := t.restarts[:0]
+	for _, restart := range t.restarts {
+		if now.Sub(restart) < t.policy.Window {
+			recent = append(recent, restart)
+		}
+	}
+	t.restarts = append(recent, now)
+
+	if t.policy.MaxRestarts > 0 && len(t.restarts) > t.policy.MaxRestarts {
+		return 0, false
+	}
+
+	if t.backoff == 0 {
+		t.backoff = t.policy.InitialBackoff
+	}
+	delay := t.backoff
+	t.backoff = min(t.backoff*2, t.policy.MaxBackoff)
+
+	return delay, true
+}
+
+// reset forgets all the restarts
+func (t *restartTracker) reset() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.resetLocked()
+}
+
+func (t *restartTracker) resetLocked() {
+	t.restarts = nil
+	t.backoff = 0
+}
@@ -0,0 +1,105 @@
+package plugin_manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+)
+
+func TestRestartTrackerBackoff(t *testing.T) {
+	tracker := newRestartTracker(RestartPolicy{
+		InitialBackoff: time.Second,
+		MaxBackoff:     4 * time.Second,
+		MaxRestarts:    10,
+		Window:         time.Minute,
+	})
+
+	now := time.Now()
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second}
+	for i, want := range expected {
+		delay, ok := tracker.next(now.Add(time.Duration(i)*time.Second), time.Second)
+		if !ok {
+			t.Fatalf("restart %d should not be a crash loop", i)
+		}
+		if delay != want {
+			t.Fatalf("restart %d: expected backoff %s, got %s", i, want, delay)
+		}
+	}
+
+	// running for a whole window resets the backoff
+	delay, ok := tracker.next(now.Add(time.Hour), time.Minute)
+	if !ok || delay != time.Second {
+		t.Fatalf("backoff should be reset after a healthy run, got %s", delay)
+	}
+}
+
+func TestRestartTrackerCrashLoop(t *testing.T) {
+	tracker := newRestartTracker(RestartPolicy{
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+		MaxRestarts:    3,
+		Window:         time.Minute,
+	})
+
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, ok := tracker.next(now.Add(time.Duration(i)*time.Second), time.Second); !ok {
+			t.Fatalf("restart %d should be allowed", i)
+		}
+	}
+
+	if _, ok := tracker.next(now.Add(3*time.Second), time.Second); ok {
+		t.Fatal("exceeding max restarts within the window should be a crash loop")
+	}
+
+	// restarts out of the window are forgotten
+	tracker.reset()
+	for i := 0; i < 6; i++ {
+		if _, ok := tracker.next(now.Add(time.Duration(i)*30*time.Second), time.Second); !ok {
+			t.Fatalf("restart %d spread across windows should be allowed", i)
+		}
+	}
+}
+
+func TestRestartTrackerWithoutMaxRestarts(t *testing.T) {
+	tracker := newRestartTracker(RestartPolicy{
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+		MaxRestarts:    0,
+		Window:         time.Minute,
+	})
+
+	now := time.Now()
+	for i := 0; i < 100; i++ {
+		if _, ok := tracker.next(now.Add(time.Duration(i)*time.Millisecond), time.Millisecond); !ok {
+			t.Fatalf("restart %d should be allowed without max restarts", i)
+		}
+	}
+}
+
+func TestRestartPolicyDeclaredByPlugin(t *testing.T) {
+	defaults := RestartPolicy{
+		InitialBackoff: 5 * time.Second,
+		MaxBackoff:     5 * time.Minute,
+		MaxRestarts:    5,
+		Window:         10 * time.Minute,
+	}
+
+	if policy := defaults.resolve(nil); policy != defaults {
+		t.Fatalf("expected the defaults without a declared policy, got %+v", policy)
+	}
+
+	policy := defaults.resolve(&plugin_entities.PluginRestartPolicy{MaxRestarts: 10, Window: 60})
+	if policy.MaxRestarts != 10 || policy.Window != time.Minute {
+		t.Fatalf("expected the declared values to be used, got %+v", policy)
+	}
+	if policy.InitialBackoff != defaults.InitialBackoff || policy.MaxBackoff != defaults.MaxBackoff {
+		t.Fatalf("expected the defaults for values not declared, got %+v", policy)
+	}
+
+	policy = defaults.resolve(&plugin_entities.PluginRestartPolicy{InitialBackoff: 600})
+	if policy.MaxBackoff != 10*time.Minute {
+		t.Fatalf("expected the max backoff to be at least the initial backoff, got %+v", policy)
+	}
+}
@@ -1,11 +1,13 @@
 package plugin_manager
 
 import (
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/local_manager"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/routine"
 )
 
 // UninstallFromLocal uninstalls a plugin from local storage
-// once deleted, local runtime will automatically shutdown and exit after several time
+// once deleted, local runtime stops accepting new sessions and exits after in-flight sessions finish
 func (p *PluginManager) UninstallFromLocal(identity plugin_entities.PluginUniqueIdentifier) error {
 	if err := p.installedBucket.Delete(identity); err != nil {
 		return err
@@ -16,6 +18,23 @@ func (p *PluginManager) UninstallFromLocal(identity plugin_entities.PluginUnique
 		// no runtime to shutdown, already uninstalled
 		return nil
 	}
-	runtime.Stop()
+	p.drain(runtime)
 	return nil
 }
+
+// drain stops the runtime in the background, local runtimes get the grace period
+// to finish in-flight sessions while new sessions are rejected
+func (p *PluginManager) drain(runtime plugin_entities.PluginLifetime) {
+	localRuntime, ok := runtime.(*local_manager.LocalPluginRuntime)
+	if !ok {
+		runtime.Stop()
+		return
+	}
+
+	routine.Submit(map[string]string{
+		"module":   "plugin_manager",
+		"function": "drain",
+	}, func() {
+		localRuntime.Drain(p.drainGracePeriod)
+	})
+}
@@ -20,6 +20,20 @@ func (p *PluginManager) startLocalWatcher() {
 			p.removeUninstalledLocalPlugins()
 		}
 	}()
+
+	go func() {
+		for range time.NewTicker(time.Hour).C {
+			p.logManager.Collect()
+		}
+	}()
+
+	if p.localPluginPythonDependencyConfig.CachePath != "" {
+		go func() {
+			for range time.NewTicker(time.Hour).C {
+				p.collectPythonDependencyCache()
+			}
+		}()
+	}
 }
 
 func (p *PluginManager) initRemotePluginServer(config *app.Config) {
@@ -75,7 +89,7 @@ func (p *PluginManager) handleNewLocalPlugins() {
 	}
 
 	for _, plugin := range plugins {
-		_, launchedChan, errChan, err := p.launchLocal(plugin)
+		_, launchedChan, errChan, err := p.launchLocal(plugin, nil)
 		if err != nil {
 			log.Error("launch local plugin failed: %s", err.Error())
 		}
@@ -100,6 +114,11 @@ func (p *PluginManager) removeUninstalledLocalPlugins() {
 			return true
 		}
 
+		// plugins run from source are not installed from packages
+		if runtime.FromSource() {
+			return true
+		}
+
 		pluginUniqueIdentifier, err := runtime.Identity()
 		if err != nil {
 			log.Error("get plugin identity failed: %s", err.Error())
@@ -114,9 +133,31 @@ func (p *PluginManager) removeUninstalledLocalPlugins() {
 		}
 
 		if !exists {
-			runtime.Stop()
+			p.drain(runtime)
 		}
 
 		return true
 	})
 }
+
+// collectPythonDependencyCache removes python dependencies which no installed plugin uses anymore
+func (p *PluginManager) collectPythonDependencyCache() {
+	plugins, err := p.installedBucket.List()
+	if err != nil {
+		// without the full list, every snapshot would look unreferenced
+		log.Error("list installed plugins failed: %s", err.Error())
+		return
+	}
+
+	workingPaths := make([]string, 0, len(plugins))
+	for _, plugin := range plugins {
+		workingPaths = append(workingPaths, p.localPluginWorkingPath(plugin))
+	}
+
+	if err := local_manager.GarbageCollectPythonDependencyCache(
+		p.localPluginPythonDependencyConfig.CachePath,
+		workingPaths,
+	); err != nil {
+		log.Error("collect python dependency cache failed: %s", err.Error())
+	}
+}
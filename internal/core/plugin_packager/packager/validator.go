@@ -3,11 +3,14 @@ package packager
 import (
 	"errors"
 	"fmt"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/constants"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
 )
 
 func (p *Packager) Validate() error {
 	// read manifest
-	_, err := p.fetchManifest()
+	manifest, err := p.fetchManifest()
 	if err != nil {
 		return err
 	}
@@ -18,5 +21,76 @@ func (p *Packager) Validate() error {
 		return errors.Join(err, fmt.Errorf("assets invalid"))
 	}
 
+	// check runner files
+	err = p.validateRunner(manifest)
+	if err != nil {
+		return errors.Join(err, fmt.Errorf("runner invalid"))
+	}
+
 	return nil
 }
+
+// validateRunner checks the package contains what the runner needs to launch the plugin
+func (p *Packager) validateRunner(manifest *plugin_entities.PluginDeclaration) error {
+	switch manifest.Meta.Runner.Language {
+	case constants.Go:
+		return p.validateGoRunner(manifest)
+	case constants.NodeJS:
+		return p.validateNodeRunner()
+	case constants.Python:
+		return p.validatePythonRunner()
+	}
+
+	return nil
+}
+
+func (p *Packager) validateGoRunner(manifest *plugin_entities.PluginDeclaration) error {
+	// a go module could be built by the daemon
+	if _, err := p.decoder.Stat("go.mod"); err == nil {
+		return nil
+	}
+
+	// otherwise every declared arch needs a prebuilt binary
+	for _, arch := range manifest.Meta.Arch {
+		binary := plugin_entities.GoPrebuiltBinary(arch)
+		if _, err := p.decoder.Stat(binary); err != nil {
+			return fmt.Errorf("go plugin requires go.mod or prebuilt binary %s", binary)
+		}
+	}
+
+	return nil
+}
+
+func (p *Packager) validatePythonRunner() error {
+	// requirements.txt is installed as it is
+	if _, err := p.decoder.Stat("pyproject.toml"); err != nil {
+		return nil
+	}
+	if _, err := p.decoder.Stat("requirements.txt"); err == nil {
+		return nil
+	}
+
+	// pyproject.toml has to be locked, otherwise daemons resolve different versions
+	for _, lockfile := range []string{"uv.lock", "poetry.lock"} {
+		if _, err := p.decoder.Stat(lockfile); err == nil {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("python plugin with pyproject.toml requires uv.lock or poetry.lock")
+}
+
+func (p *Packager) validateNodeRunner() error {
+	if _, err := p.decoder.Stat("package.json"); err != nil {
+		return fmt.Errorf("nodejs plugin requires package.json")
+	}
+
+	// dependencies are installed from the lockfile, never resolved at install time
+	for _, lockfile := range []string{"package-lock.json", "pnpm-lock.yaml"} {
+		if _, err := p.decoder.Stat(lockfile); err == nil {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("nodejs plugin requires package-lock.json or pnpm-lock.yaml")
+}
@@ -4,6 +4,7 @@ import (
 	_ "embed"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -233,3 +234,107 @@ func TestWrongSign(t *testing.T) {
 		return
 	}
 }
+
+func TestPackGoPlugin(t *testing.T) {
+	dir := t.TempDir()
+
+	goManifest := strings.Replace(string(manifest), `language: "python"`, `language: "go"`, 1)
+	goManifest = strings.Replace(goManifest, `entrypoint: "main"`, `entrypoint: "."`, 1)
+
+	files := map[string][]byte{
+		"manifest.yaml":    []byte(goManifest),
+		"neko.yaml":        neko,
+		"_assets/test.svg": test_svg,
+	}
+	for name, content := range files {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), content, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pack := func() error {
+		originDecoder, err := decoder.NewFSPluginDecoder(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = packager.NewPackager(originDecoder).Pack(52428800)
+		return err
+	}
+
+	// neither go.mod nor prebuilt binaries
+	if err := pack(); err == nil {
+		t.Fatal("go plugin without go.mod or binaries should not be packed")
+	}
+
+	// binary for amd64 only, arm64 is also declared
+	if err := os.MkdirAll(filepath.Join(dir, "bin"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "bin/plugin-linux-amd64"), []byte("binary"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := pack(); err == nil {
+		t.Fatal("go plugin missing binary for arm64 should not be packed")
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "bin/plugin-linux-arm64"), []byte("binary"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := pack(); err != nil {
+		t.Fatalf("go plugin with prebuilt binaries should be packed: %s", err)
+	}
+
+	// a go module is enough
+	os.RemoveAll(filepath.Join(dir, "bin"))
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module neko\n\ngo 1.21\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := pack(); err != nil {
+		t.Fatalf("go module should be packed: %s", err)
+	}
+}
+
+func TestPackNodePlugin(t *testing.T) {
+	dir := t.TempDir()
+
+	nodeManifest := strings.Replace(string(manifest), `language: "python"`, `language: "nodejs"`, 1)
+	nodeManifest = strings.Replace(nodeManifest, `entrypoint: "main"`, `entrypoint: "dist/main.js"`, 1)
+
+	files := map[string][]byte{
+		"manifest.yaml":    []byte(nodeManifest),
+		"neko.yaml":        neko,
+		"_assets/test.svg": test_svg,
+		"package.json":     []byte(`{"name": "neko"}`),
+	}
+	for name, content := range files {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), content, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pack := func() error {
+		originDecoder, err := decoder.NewFSPluginDecoder(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = packager.NewPackager(originDecoder).Pack(52428800)
+		return err
+	}
+
+	if err := pack(); err == nil {
+		t.Fatal("nodejs plugin without lockfile should not be packed")
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "pnpm-lock.yaml"), []byte("lockfileVersion: '9.0'\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := pack(); err != nil {
+		t.Fatalf("nodejs plugin with lockfile should be packed: %s", err)
+	}
+}
@@ -0,0 +1,157 @@
+package sandbox
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/routine"
+)
+
+// EgressProxy is a http proxy listening on a unix socket, the only way out of the sandbox
+// it forwards requests to the hosts declared in the manifest and rejects everything else
+type EgressProxy struct {
+	identity string
+	allowed  []string
+	listener net.Listener
+
+	closeOnce sync.Once
+}
+
+// NewEgressProxy starts an egress proxy at `socket` which allows `hosts`
+func NewEgressProxy(identity string, socket string, hosts []string) (*EgressProxy, error) {
+	if err := os.MkdirAll(filepath.Dir(socket), 0755); err != nil {
+		return nil, err
+	}
+
+	// remove the socket left by last run
+	os.Remove(socket)
+
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &EgressProxy{
+		identity: identity,
+		allowed:  hosts,
+		listener: listener,
+	}
+
+	routine.Submit(map[string]string{
+		"module":   "sandbox",
+		"function": "EgressProxy",
+	}, p.serve)
+
+	return p, nil
+}
+
+// Close stops accepting new connections, established ones are kept until they finish
+func (p *EgressProxy) Close() {
+	p.closeOnce.Do(func() {
+		p.listener.Close()
+	})
+}
+
+// Allowed checks if the host is declared by the plugin
+func (p *EgressProxy) Allowed(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, pattern := range p.allowed {
+		pattern = strings.ToLower(pattern)
+		if pattern == "*" || pattern == host {
+			return true
+		}
+		if strings.HasPrefix(pattern, "*.") && strings.HasSuffix(host, pattern[1:]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *EgressProxy) serve() {
+	for {
+		conn, err := p.listener.Accept()
+		if err != nil {
+			return
+		}
+		go p.handle(conn)
+	}
+}
+
+func (p *EgressProxy) handle(conn net.Conn) {
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	request, err := http.ReadRequest(reader)
+	if err != nil {
+		return
+	}
+
+	host := request.URL.Hostname()
+	if host == "" {
+		host, _, _ = net.SplitHostPort(request.Host)
+	}
+
+	if !p.Allowed(host) {
+		log.Warn("plugin %s tried to reach undeclared host %s", p.identity, host)
+		writeStatus(conn, http.StatusForbidden, "host is not declared in plugin manifest")
+		return
+	}
+
+	address := request.Host
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		if request.Method == http.MethodConnect || request.URL.Scheme == "https" {
+			address = net.JoinHostPort(address, "443")
+		} else {
+			address = net.JoinHostPort(address, "80")
+		}
+	}
+
+	upstream, err := net.DialTimeout("tcp", address, 30*time.Second)
+	if err != nil {
+		writeStatus(conn, http.StatusBadGateway, err.Error())
+		return
+	}
+	defer upstream.Close()
+
+	if request.Method == http.MethodConnect {
+		if _, err := io.WriteString(conn, "HTTP/1.1 200 Connection Established\r\n\r\n"); err != nil {
+			return
+		}
+	} else {
+		// forward the request in origin form, keep-alive is not supported
+		request.Header.Del("Proxy-Connection")
+		request.Header.Del("Proxy-Authorization")
+		request.Close = true
+		if err := request.Write(upstream); err != nil {
+			return
+		}
+	}
+
+	go func() {
+		io.Copy(upstream, reader)
+		if tcp, ok := upstream.(*net.TCPConn); ok {
+			tcp.CloseWrite()
+		}
+	}()
+	io.Copy(conn, upstream)
+}
+
+func writeStatus(conn net.Conn, status int, message string) {
+	response := &http.Response{
+		StatusCode:    status,
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Body:          io.NopCloser(strings.NewReader(message)),
+		ContentLength: int64(len(message)),
+		Close:         true,
+	}
+	response.Write(conn)
+}
@@ -0,0 +1,81 @@
+package sandbox
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"testing"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/routine"
+)
+
+func TestEgressProxyAllowed(t *testing.T) {
+	p := &EgressProxy{allowed: []string{"api.openai.com", "*.example.com"}}
+
+	cases := map[string]bool{
+		"api.openai.com":    true,
+		"API.OPENAI.COM":    true,
+		"openai.com":        false,
+		"a.example.com":     true,
+		"a.b.example.com":   true,
+		"example.com":       false,
+		"evil-example.com":  false,
+		"example.com.evil":  false,
+		"api.openai.com.":   true,
+		"api.openai.com.cn": false,
+	}
+
+	for host, expected := range cases {
+		if p.Allowed(host) != expected {
+			t.Errorf("host %s: expected %v", host, expected)
+		}
+	}
+}
+
+func TestEgressProxyForward(t *testing.T) {
+	routine.InitPool(100)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("hello"))
+	}))
+	defer server.Close()
+
+	socket := path.Join(t.TempDir(), "egress.sock")
+	proxy, err := NewEgressProxy("test", socket, []string{"127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer proxy.Close()
+
+	request := func(url string) *http.Response {
+		conn, err := net.Dial("unix", socket)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+
+		fmt.Fprintf(conn, "GET %s HTTP/1.1\r\nHost: %s\r\n\r\n", url, server.Listener.Addr().String())
+		response, err := http.ReadResponse(bufio.NewReader(conn), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(response.Body)
+		if response.StatusCode == http.StatusOK && string(body) != "hello" {
+			t.Fatalf("unexpected body %s", body)
+		}
+		return response
+	}
+
+	if response := request(server.URL + "/"); response.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", response.StatusCode)
+	}
+
+	proxy.allowed = []string{"api.openai.com"}
+	if response := request(server.URL + "/"); response.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403, got %d", response.StatusCode)
+	}
+}
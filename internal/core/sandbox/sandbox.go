@@ -0,0 +1,62 @@
+// Package sandbox runs local plugins in isolated linux namespaces with a seccomp profile
+//
+// the daemon binary re-executes itself as the init process of the sandbox,
+// which prepares a minimal root filesystem before starting the plugin,
+// so `Init` must be called at the very beginning of `main`
+package sandbox
+
+import (
+	"errors"
+	"path"
+)
+
+const (
+	// argv[0] of the sandbox init process
+	INIT_COMMAND = "mlchain-plugin-sandbox-init"
+
+	// environment variable carrying the policy to the init process
+	POLICY_ENV = "MLCHAIN_SANDBOX_POLICY"
+
+	// directory under the working path which holds sandbox internals
+	SANDBOX_DIR = ".sandbox"
+)
+
+var ErrNotSupported = errors.New("sandbox is only supported on linux")
+
+// Policy describes what a sandboxed plugin could see and do
+type Policy struct {
+	// the only writable host directory, the venv lives inside it
+	WorkingPath string `json:"working_path"`
+	// host paths mounted read-only, like the python runtime and shared libraries
+	ReadOnlyPaths []string `json:"read_only_paths"`
+	// unix socket of the egress proxy, empty means the plugin has no network at all
+	EgressSocket string `json:"egress_socket"`
+
+	// command to run inside the sandbox
+	Args []string `json:"args"`
+	Env  []string `json:"env"`
+}
+
+// DefaultReadOnlyPaths are the host paths a python plugin needs to run
+var DefaultReadOnlyPaths = []string{
+	"/usr",
+	"/lib",
+	"/lib64",
+	"/bin",
+	"/sbin",
+	"/etc/alternatives",
+	"/etc/ssl",
+	"/etc/ca-certificates",
+	"/etc/pki",
+	"/etc/localtime",
+}
+
+// EgressSocketPath returns where the egress proxy of a plugin listens
+func EgressSocketPath(workingPath string) string {
+	return path.Join(workingPath, SANDBOX_DIR, "egress.sock")
+}
+
+// rootPath returns the mount point of the sandbox root filesystem
+func rootPath(workingPath string) string {
+	return path.Join(workingPath, SANDBOX_DIR, "root")
+}
@@ -0,0 +1,311 @@
+package sandbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path"
+	"runtime"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// Command returns a command which runs `policy.Args` inside the sandbox
+func Command(policy Policy) (*exec.Cmd, error) {
+	if len(policy.Args) == 0 {
+		return nil, errors.New("no command to run in sandbox")
+	}
+
+	if err := os.MkdirAll(rootPath(policy.WorkingPath), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create sandbox root: %s", err)
+	}
+
+	policyJson, err := json.Marshal(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := &exec.Cmd{
+		Path: "/proc/self/exe",
+		Args: []string{INIT_COMMAND},
+		Env:  []string{fmt.Sprintf("%s=%s", POLICY_ENV, policyJson)},
+		Dir:  policy.WorkingPath,
+		SysProcAttr: &syscall.SysProcAttr{
+			Cloneflags: syscall.CLONE_NEWUSER |
+				syscall.CLONE_NEWNS |
+				syscall.CLONE_NEWPID |
+				syscall.CLONE_NEWNET |
+				syscall.CLONE_NEWIPC |
+				syscall.CLONE_NEWUTS,
+			// the plugin is root inside the user namespace and the daemon user outside
+			UidMappings: []syscall.SysProcIDMap{
+				{ContainerID: 0, HostID: os.Getuid(), Size: 1},
+			},
+			GidMappings: []syscall.SysProcIDMap{
+				{ContainerID: 0, HostID: os.Getgid(), Size: 1},
+			},
+			GidMappingsEnableSetgroups: false,
+			Pdeathsig:                  syscall.SIGKILL,
+		},
+	}
+
+	return cmd, nil
+}
+
+// Init runs the sandbox init process and exits if the current process is launched as one
+func Init() {
+	if len(os.Args) == 0 || os.Args[0] != INIT_COMMAND {
+		return
+	}
+
+	code, err := runInit()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "sandbox: %s\n", err)
+		os.Exit(1)
+	}
+	os.Exit(code)
+}
+
+func runInit() (int, error) {
+	// namespaces and seccomp are per thread, keep everything on the main thread
+	runtime.LockOSThread()
+
+	var policy Policy
+	if err := json.Unmarshal([]byte(os.Getenv(POLICY_ENV)), &policy); err != nil {
+		return 0, fmt.Errorf("invalid policy: %s", err)
+	}
+	os.Unsetenv(POLICY_ENV)
+
+	if err := setupRootfs(policy); err != nil {
+		return 0, fmt.Errorf("failed to setup root filesystem: %s", err)
+	}
+
+	env := policy.Env
+	if policy.EgressSocket != "" {
+		proxy, err := setupEgress(policy.EgressSocket)
+		if err != nil {
+			return 0, fmt.Errorf("failed to setup egress: %s", err)
+		}
+		env = append(env,
+			"HTTP_PROXY="+proxy, "HTTPS_PROXY="+proxy, "http_proxy="+proxy, "https_proxy="+proxy,
+			"NO_PROXY=localhost,127.0.0.1", "no_proxy=localhost,127.0.0.1",
+		)
+	}
+
+	unix.Sethostname([]byte("sandbox"))
+
+	if err := installSeccomp(); err != nil {
+		return 0, fmt.Errorf("failed to install seccomp profile: %s", err)
+	}
+
+	cmd := exec.Command(policy.Args[0], policy.Args[1:]...)
+	cmd.Env = env
+	cmd.Dir = policy.WorkingPath
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		return 0, err
+	}
+
+	// forward termination signals to the plugin
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT)
+	go func() {
+		for sig := range signals {
+			cmd.Process.Signal(sig)
+		}
+	}()
+
+	if err := cmd.Wait(); err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return exitErr.ExitCode(), nil
+		}
+		return 0, err
+	}
+
+	return 0, nil
+}
+
+// setupRootfs pivots into a tmpfs root which only contains read-only host paths and the working path
+func setupRootfs(policy Policy) error {
+	// ensure no mount event propagates back to the host
+	if err := unix.Mount("", "/", "", unix.MS_REC|unix.MS_PRIVATE, ""); err != nil {
+		return fmt.Errorf("failed to make mounts private: %s", err)
+	}
+
+	root := rootPath(policy.WorkingPath)
+	if err := unix.Mount("tmpfs", root, "tmpfs", unix.MS_NOSUID|unix.MS_NODEV, "mode=0755"); err != nil {
+		return fmt.Errorf("failed to mount root: %s", err)
+	}
+
+	// mounted before the working path, which may live under /tmp
+	if err := os.MkdirAll(path.Join(root, "tmp"), 0755); err != nil {
+		return err
+	}
+	if err := unix.Mount("tmpfs", path.Join(root, "tmp"), "tmpfs", unix.MS_NOSUID|unix.MS_NODEV, "mode=1777"); err != nil {
+		return fmt.Errorf("failed to mount /tmp: %s", err)
+	}
+
+	for _, p := range policy.ReadOnlyPaths {
+		if err := bindMount(p, path.Join(root, p), true); err != nil {
+			return err
+		}
+	}
+
+	if err := bindMount(policy.WorkingPath, path.Join(root, policy.WorkingPath), false); err != nil {
+		return err
+	}
+
+	// only expose harmless devices
+	for _, device := range []string{"/dev/null", "/dev/zero", "/dev/random", "/dev/urandom"} {
+		if err := bindMount(device, path.Join(root, device), false); err != nil {
+			return err
+		}
+	}
+
+	for _, dir := range []string{"proc", ".oldroot"} {
+		if err := os.MkdirAll(path.Join(root, dir), 0755); err != nil {
+			return err
+		}
+	}
+
+	if err := unix.PivotRoot(root, path.Join(root, ".oldroot")); err != nil {
+		return fmt.Errorf("failed to pivot root: %s", err)
+	}
+
+	if err := unix.Chdir("/"); err != nil {
+		return err
+	}
+
+	if err := unix.Unmount("/.oldroot", unix.MNT_DETACH); err != nil {
+		return fmt.Errorf("failed to unmount old root: %s", err)
+	}
+	os.Remove("/.oldroot")
+
+	// the kernel refuses to mount proc if the host proc is partially masked, like inside a container,
+	// plugins still work without it
+	if err := unix.Mount("proc", "/proc", "proc", unix.MS_NOSUID|unix.MS_NODEV|unix.MS_NOEXEC, ""); err == unix.EPERM {
+		fmt.Fprintln(os.Stderr, "sandbox: /proc is not available, the host proc is masked")
+	} else if err != nil {
+		return fmt.Errorf("failed to mount /proc: %s", err)
+	}
+
+	// nothing but the mounts above could be written
+	if err := unix.Mount("", "/", "", unix.MS_REMOUNT|unix.MS_RDONLY|unix.MS_NOSUID|unix.MS_NODEV, ""); err != nil {
+		return fmt.Errorf("failed to remount root as read-only: %s", err)
+	}
+
+	return nil
+}
+
+// bindMount mounts the host path `source` to `target`, missing sources are skipped
+func bindMount(source string, target string, readonly bool) error {
+	info, err := os.Lstat(source)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(path.Dir(target), 0755); err != nil {
+		return err
+	}
+
+	// keep symlinks like /lib -> usr/lib as they are
+	if info.Mode()&os.ModeSymlink != 0 {
+		link, err := os.Readlink(source)
+		if err != nil {
+			return err
+		}
+		return os.Symlink(link, target)
+	}
+
+	if info.IsDir() {
+		err = os.MkdirAll(target, 0755)
+	} else {
+		var f *os.File
+		f, err = os.Create(target)
+		if f != nil {
+			f.Close()
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := unix.Mount(source, target, "", unix.MS_BIND|unix.MS_REC, ""); err != nil {
+		return fmt.Errorf("failed to mount %s: %s", source, err)
+	}
+
+	if readonly {
+		flags := uintptr(unix.MS_BIND | unix.MS_REMOUNT | unix.MS_RDONLY | unix.MS_NOSUID | unix.MS_NODEV)
+		if err := unix.Mount("", target, "", flags, ""); err != nil {
+			return fmt.Errorf("failed to remount %s as read-only: %s", source, err)
+		}
+	}
+
+	return nil
+}
+
+// setupEgress brings up the loopback interface and forwards a local proxy port to the egress socket
+// returns the proxy url for the plugin
+func setupEgress(socket string) (string, error) {
+	if err := bringUpLoopback(); err != nil {
+		return "", err
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+				upstream, err := net.Dial("unix", socket)
+				if err != nil {
+					return
+				}
+				defer upstream.Close()
+
+				go io.Copy(upstream, conn)
+				io.Copy(conn, upstream)
+			}()
+		}
+	}()
+
+	return fmt.Sprintf("http://%s", listener.Addr().String()), nil
+}
+
+func bringUpLoopback() error {
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	defer unix.Close(fd)
+
+	ifr, err := unix.NewIfreq("lo")
+	if err != nil {
+		return err
+	}
+
+	if err := unix.IoctlIfreq(fd, unix.SIOCGIFFLAGS, ifr); err != nil {
+		return err
+	}
+
+	ifr.SetUint16(ifr.Uint16() | unix.IFF_UP)
+	return unix.IoctlIfreq(fd, unix.SIOCSIFFLAGS, ifr)
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+
+package sandbox
+
+import "os/exec"
+
+// Command is not supported on this platform
+func Command(policy Policy) (*exec.Cmd, error) {
+	return nil, ErrNotSupported
+}
+
+// Init does nothing on this platform
+func Init() {}
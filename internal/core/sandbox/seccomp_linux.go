@@ -0,0 +1,161 @@
+package sandbox
+
+import (
+	"errors"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// deniedSyscalls are rejected with EPERM, they are able to escape or tamper with the sandbox
+// or inspect other processes and the kernel
+var deniedSyscalls = []uint32{
+	unix.SYS_MOUNT,
+	unix.SYS_UMOUNT2,
+	// the new mount api could clear the read-only flags of the bind mounts as well
+	unix.SYS_OPEN_TREE,
+	unix.SYS_MOVE_MOUNT,
+	unix.SYS_MOUNT_SETATTR,
+	unix.SYS_FSOPEN,
+	unix.SYS_FSCONFIG,
+	unix.SYS_FSMOUNT,
+	unix.SYS_FSPICK,
+	unix.SYS_PIVOT_ROOT,
+	unix.SYS_CHROOT,
+	unix.SYS_UNSHARE,
+	unix.SYS_SETNS,
+	unix.SYS_PTRACE,
+	unix.SYS_PROCESS_VM_READV,
+	unix.SYS_PROCESS_VM_WRITEV,
+	unix.SYS_KEXEC_LOAD,
+	unix.SYS_KEXEC_FILE_LOAD,
+	unix.SYS_INIT_MODULE,
+	unix.SYS_FINIT_MODULE,
+	unix.SYS_DELETE_MODULE,
+	unix.SYS_BPF,
+	unix.SYS_PERF_EVENT_OPEN,
+	unix.SYS_KEYCTL,
+	unix.SYS_ADD_KEY,
+	unix.SYS_REQUEST_KEY,
+	unix.SYS_REBOOT,
+	unix.SYS_SWAPON,
+	unix.SYS_SWAPOFF,
+	unix.SYS_OPEN_BY_HANDLE_AT,
+	unix.SYS_NAME_TO_HANDLE_AT,
+	unix.SYS_USERFAULTFD,
+	unix.SYS_ACCT,
+	unix.SYS_QUOTACTL,
+	unix.SYS_SYSLOG,
+	unix.SYS_SETTIMEOFDAY,
+	unix.SYS_CLOCK_SETTIME,
+	unix.SYS_SETHOSTNAME,
+	unix.SYS_SETDOMAINNAME,
+}
+
+// offsets of struct seccomp_data, the low half of the first argument on little endian architectures
+const (
+	seccompDataNr   = 0
+	seccompDataArch = 4
+	seccompDataArg0 = 16
+)
+
+// cloneNamespaceFlags are rejected by clone, a new namespace nested in the sandbox is not bound by
+// the sandbox anymore, CLONE_NEWTIME overlaps the exit signal of clone and is only accepted by clone3
+const cloneNamespaceFlags = unix.CLONE_NEWNS |
+	unix.CLONE_NEWCGROUP |
+	unix.CLONE_NEWUTS |
+	unix.CLONE_NEWIPC |
+	unix.CLONE_NEWUSER |
+	unix.CLONE_NEWPID |
+	unix.CLONE_NEWNET
+
+// buildSeccompFilter assembles a BPF program which kills foreign architectures,
+// rejects denied syscalls and clones into new namespaces, and allows everything else,
+// clone3 passes its flags in memory which seccomp could not inspect, it fails with ENOSYS
+// for libc and runtimes to fall back to clone
+func buildSeccompFilter() ([]unix.SockFilter, error) {
+	if nativeAuditArch == 0 {
+		return nil, errors.New("seccomp is not supported on this architecture")
+	}
+
+	if len(deniedSyscalls) > 248 {
+		return nil, errors.New("too many denied syscalls")
+	}
+
+	stmt := func(code uint16, k uint32) unix.SockFilter {
+		return unix.SockFilter{Code: code, K: k}
+	}
+	jump := func(code uint16, k uint32, jt uint8, jf uint8) unix.SockFilter {
+		return unix.SockFilter{Code: code, Jt: jt, Jf: jf, K: k}
+	}
+
+	// jumps are relative to the next instruction and could only go forward
+	n := len(deniedSyscalls)
+	var (
+		denied    = 7
+		allow     = denied + n
+		cloneArgs = allow + 1
+		deny      = cloneArgs + 3
+		enosys    = deny + 1
+	)
+	to := func(from int, target int) uint8 {
+		return uint8(target - from - 1)
+	}
+
+	filter := []unix.SockFilter{
+		stmt(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, seccompDataArch),
+		jump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, nativeAuditArch, 1, 0),
+		stmt(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_KILL_PROCESS),
+		stmt(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, seccompDataNr),
+		// reject the x32 abi which shares the arch of x86_64
+		jump(unix.BPF_JMP|unix.BPF_JGE|unix.BPF_K, 0x40000000, to(4, deny), 0),
+		jump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, unix.SYS_CLONE3, to(5, enosys), 0),
+		jump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, unix.SYS_CLONE, to(6, cloneArgs), 0),
+	}
+
+	for i, nr := range deniedSyscalls {
+		filter = append(filter, jump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, nr, to(denied+i, deny), 0))
+	}
+
+	filter = append(filter,
+		stmt(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_ALLOW),
+		// clone, the flags are the first argument
+		stmt(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, seccompDataArg0),
+		jump(unix.BPF_JMP|unix.BPF_JSET|unix.BPF_K, cloneNamespaceFlags, 1, 0),
+		stmt(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_ALLOW),
+		stmt(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_ERRNO|uint32(unix.EPERM)),
+		stmt(unix.BPF_RET|unix.BPF_K, unix.SECCOMP_RET_ERRNO|uint32(unix.ENOSYS)),
+	)
+
+	return filter, nil
+}
+
+// installSeccomp applies the filter to all threads of the current process,
+// it's inherited by every child process
+func installSeccomp() error {
+	filter, err := buildSeccompFilter()
+	if err != nil {
+		return err
+	}
+
+	if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+		return err
+	}
+
+	prog := unix.SockFprog{
+		Len:    uint16(len(filter)),
+		Filter: &filter[0],
+	}
+
+	_, _, errno := unix.Syscall(
+		unix.SYS_SECCOMP,
+		unix.SECCOMP_SET_MODE_FILTER,
+		unix.SECCOMP_FILTER_FLAG_TSYNC,
+		uintptr(unsafe.Pointer(&prog)),
+	)
+	if errno != 0 {
+		return errno
+	}
+
+	return nil
+}
@@ -0,0 +1,5 @@
+package sandbox
+
+import "golang.org/x/sys/unix"
+
+const nativeAuditArch = unix.AUDIT_ARCH_X86_64
@@ -0,0 +1,6 @@
+//go:build linux && !amd64 && !arm64
+
+package sandbox
+
+// seccomp is only available on the architectures plugins support
+const nativeAuditArch = 0
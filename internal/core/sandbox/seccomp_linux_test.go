@@ -0,0 +1,89 @@
+package sandbox
+
+import (
+	"testing"
+
+	"golang.org/x/sys/unix"
+)
+
+// runSeccompFilter evaluates the instructions used by buildSeccompFilter against a syscall
+func runSeccompFilter(t *testing.T, filter []unix.SockFilter, arch uint32, nr uint32, arg0 uint32) uint32 {
+	var accumulator uint32
+	for pc := 0; pc < len(filter); pc++ {
+		instruction := filter[pc]
+		switch instruction.Code {
+		case unix.BPF_LD | unix.BPF_W | unix.BPF_ABS:
+			switch instruction.K {
+			case seccompDataNr:
+				accumulator = nr
+			case seccompDataArch:
+				accumulator = arch
+			case seccompDataArg0:
+				accumulator = arg0
+			default:
+				t.Fatalf("unexpected offset %d", instruction.K)
+			}
+		case unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K:
+			if accumulator == instruction.K {
+				pc += int(instruction.Jt)
+			} else {
+				pc += int(instruction.Jf)
+			}
+		case unix.BPF_JMP | unix.BPF_JGE | unix.BPF_K:
+			if accumulator >= instruction.K {
+				pc += int(instruction.Jt)
+			} else {
+				pc += int(instruction.Jf)
+			}
+		case unix.BPF_JMP | unix.BPF_JSET | unix.BPF_K:
+			if accumulator&instruction.K != 0 {
+				pc += int(instruction.Jt)
+			} else {
+				pc += int(instruction.Jf)
+			}
+		case unix.BPF_RET | unix.BPF_K:
+			return instruction.K
+		default:
+			t.Fatalf("unexpected instruction %#x", instruction.Code)
+		}
+	}
+
+	t.Fatal("the filter does not return")
+	return 0
+}
+
+func TestSeccompFilter(t *testing.T) {
+	filter, err := buildSeccompFilter()
+	if err != nil {
+		t.Skip(err)
+	}
+
+	eperm := uint32(unix.SECCOMP_RET_ERRNO | uint32(unix.EPERM))
+	enosys := uint32(unix.SECCOMP_RET_ERRNO | uint32(unix.ENOSYS))
+
+	cases := []struct {
+		name     string
+		arch     uint32
+		nr       uint32
+		arg0     uint32
+		expected uint32
+	}{
+		{"read", nativeAuditArch, unix.SYS_READ, 0, unix.SECCOMP_RET_ALLOW},
+		{"foreign arch", nativeAuditArch + 1, unix.SYS_READ, 0, unix.SECCOMP_RET_KILL_PROCESS},
+		{"mount", nativeAuditArch, unix.SYS_MOUNT, 0, eperm},
+		{"mount_setattr", nativeAuditArch, unix.SYS_MOUNT_SETATTR, 0, eperm},
+		{"open_tree", nativeAuditArch, unix.SYS_OPEN_TREE, 0, eperm},
+		{"last denied", nativeAuditArch, deniedSyscalls[len(deniedSyscalls)-1], 0, eperm},
+		{"fork", nativeAuditArch, unix.SYS_CLONE, unix.CLONE_VM | unix.CLONE_VFORK | uint32(unix.SIGCHLD), unix.SECCOMP_RET_ALLOW},
+		{"thread", nativeAuditArch, unix.SYS_CLONE, unix.CLONE_VM | unix.CLONE_THREAD | unix.CLONE_SIGHAND, unix.SECCOMP_RET_ALLOW},
+		{"new user namespace", nativeAuditArch, unix.SYS_CLONE, unix.CLONE_NEWUSER | uint32(unix.SIGCHLD), eperm},
+		{"new mount namespace", nativeAuditArch, unix.SYS_CLONE, unix.CLONE_NEWNS, eperm},
+		{"clone3", nativeAuditArch, unix.SYS_CLONE3, 0, enosys},
+	}
+
+	for _, c := range cases {
+		if result := runSeccompFilter(t, filter, c.arch, c.nr, c.arg0); result != c.expected {
+			t.Errorf("%s: expected %#x, got %#x", c.name, c.expected, result)
+		}
+	}
+}
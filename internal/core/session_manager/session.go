@@ -1,6 +1,7 @@
 package session_manager
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
@@ -39,12 +40,40 @@ type Session struct {
 	MessageID      *string `json:"message_id"`
 	AppID          *string `json:"app_id"`
 	EndpointID     *string `json:"endpoint_id"`
+
+	// the caller needs the result by then, backwards invocations of the session are bounded by it
+	Deadline time.Time `json:"deadline"`
+
+	// variables of the plugin scoped to the tenant, sent along the request, never cached
+	Environment map[string]string `json:"-"`
+
+	// closed once nobody waits for the result of the session
+	cancelled  chan bool
+	cancelLock sync.Mutex
+	// done once the session is cancelled or its deadline is exceeded
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+
+	// the session is kept in cache, a cancellation is published through it for the other nodes
+	cached bool
+	// the session is restored from cache on a node which does not run it, like a backwards invocation
+	// of a serverless plugin, it's cancelled by the node running it
+	restored bool
 }
 
+const (
+	// how often a session restored from cache checks if it has been cancelled
+	SESSION_CANCEL_POLL_INTERVAL = time.Second
+)
+
 func sessionKey(id string) string {
 	return fmt.Sprintf("session_info:%s", id)
 }
 
+func sessionCancelledKey(id string) string {
+	return fmt.Sprintf("session_cancelled:%s", id)
+}
+
 type NewSessionPayload struct {
 	TenantID               string                                 `json:"tenant_id"`
 	UserID                 string                                 `json:"user_id"`
@@ -59,6 +88,8 @@ type NewSessionPayload struct {
 	MessageID              *string                                `json:"message_id"`
 	AppID                  *string                                `json:"app_id"`
 	EndpointID             *string                                `json:"endpoint_id"`
+	Deadline               time.Time                              `json:"deadline"`
+	Environment            map[string]string                      `json:"-"`
 }
 
 func NewSession(payload NewSessionPayload) *Session {
@@ -76,6 +107,8 @@ func NewSession(payload NewSessionPayload) *Session {
 		MessageID:              payload.MessageID,
 		AppID:                  payload.AppID,
 		EndpointID:             payload.EndpointID,
+		Deadline:               payload.Deadline,
+		Environment:            payload.Environment,
 	}
 
 	session_lock.Lock()
@@ -83,6 +116,7 @@ func NewSession(payload NewSessionPayload) *Session {
 	session_lock.Unlock()
 
 	if !payload.IgnoreCache {
+		s.cached = true
 		if err := cache.Store(sessionKey(s.ID), s, time.Minute*30); err != nil {
 			log.Error("set session info to cache failed, %s", err)
 		}
@@ -108,6 +142,8 @@ func GetSession(payload GetSessionPayload) *Session {
 			log.Error("get session info from cache failed, %s", err)
 			return nil
 		}
+		session.cached = true
+		session.restored = true
 		return session
 	}
 
@@ -163,10 +199,12 @@ type PLUGIN_IN_STREAM_EVENT string
 const (
 	PLUGIN_IN_STREAM_EVENT_REQUEST  PLUGIN_IN_STREAM_EVENT = "request"
 	PLUGIN_IN_STREAM_EVENT_RESPONSE PLUGIN_IN_STREAM_EVENT = "backwards_response"
+	PLUGIN_IN_STREAM_EVENT_CANCEL   PLUGIN_IN_STREAM_EVENT = "cancel"
+	PLUGIN_IN_STREAM_EVENT_INPUT    PLUGIN_IN_STREAM_EVENT = "input"
 )
 
 func (s *Session) Message(event PLUGIN_IN_STREAM_EVENT, data any) []byte {
-	return parser.MarshalJsonBytes(map[string]any{
+	message := map[string]any{
 		"session_id":      s.ID,
 		"conversation_id": s.ConversationID,
 		"message_id":      s.MessageID,
@@ -174,7 +212,24 @@ func (s *Session) Message(event PLUGIN_IN_STREAM_EVENT, data any) []byte {
 		"endpoint_id":     s.EndpointID,
 		"event":           event,
 		"data":            data,
-	})
+	}
+
+	// milliseconds since the unix epoch, lets the plugin stop working once the caller gives up
+	if !s.Deadline.IsZero() {
+		message["deadline"] = s.Deadline.UnixMilli()
+	}
+
+	// only the request carries the variables of the tenant, the plugin keeps them for the session
+	if event == PLUGIN_IN_STREAM_EVENT_REQUEST && len(s.Environment) > 0 {
+		message["environment"] = s.Environment
+	}
+
+	return parser.MarshalJsonBytes(message)
+}
+
+// DeadlineExceeded returns true once the deadline of the session has passed
+func (s *Session) DeadlineExceeded() bool {
+	return !s.Deadline.IsZero() && !time.Now().Before(s.Deadline)
 }
 
 func (s *Session) Write(event PLUGIN_IN_STREAM_EVENT, data any) error {
@@ -184,3 +239,135 @@ func (s *Session) Write(event PLUGIN_IN_STREAM_EVENT, data any) error {
 	s.runtime.Write(s.ID, s.Message(event, data))
 	return nil
 }
+
+// cancelledChan returns the channel closed on cancellation, sessions restored from cache get theirs lazily
+func (s *Session) cancelledChan() chan bool {
+	if s.cancelled == nil {
+		s.cancelled = make(chan bool)
+	}
+	return s.cancelled
+}
+
+// Context returns a context which is done once the session is cancelled or its deadline is exceeded,
+// writers waiting for a slow caller give up with it
+func (s *Session) Context() context.Context {
+	s.cancelLock.Lock()
+	defer s.cancelLock.Unlock()
+
+	if s.ctx == nil {
+		if s.Deadline.IsZero() {
+			s.ctx, s.ctxCancel = context.WithCancel(context.Background())
+		} else {
+			s.ctx, s.ctxCancel = context.WithDeadline(context.Background(), s.Deadline)
+		}
+
+		select {
+		case <-s.cancelledChan():
+			s.ctxCancel()
+		default:
+		}
+	}
+
+	return s.ctx
+}
+
+// markCancelled closes the channel of the cancellation, it returns false if it has been closed
+func (s *Session) markCancelled() bool {
+	s.cancelLock.Lock()
+	defer s.cancelLock.Unlock()
+
+	cancelled := s.cancelledChan()
+	select {
+	case <-cancelled:
+		return false
+	default:
+		close(cancelled)
+		if s.ctxCancel != nil {
+			s.ctxCancel()
+		}
+		return true
+	}
+}
+
+// Cancel tells the plugin to stop working on the session as the caller is gone,
+// backwards invocations of the session are cancelled as well, on every node, it's safe to call more than once
+func (s *Session) Cancel() {
+	if !s.markCancelled() {
+		return
+	}
+
+	if s.cached {
+		if err := cache.Store(sessionCancelledKey(s.ID), true, time.Minute*30); err != nil {
+			log.Error("publish cancellation of session %s failed, %s", s.ID, err)
+		}
+	}
+
+	// plugins built with an sdk which does not know the event are not told
+	if s.runtime == nil {
+		return
+	}
+	if runtime, ok := s.runtime.(plugin_entities.PluginCancelAware); !ok || !runtime.AcceptsCancel(s.ID) {
+		return
+	}
+
+	if err := s.Write(PLUGIN_IN_STREAM_EVENT_CANCEL, map[string]any{}); err != nil {
+		log.Error("send cancel event of session %s failed, %s", s.ID, err)
+	}
+}
+
+// Cancelled returns a channel which is closed once the session is cancelled on this node,
+// use WatchCancelled to follow sessions restored from cache
+func (s *Session) Cancelled() <-chan bool {
+	s.cancelLock.Lock()
+	defer s.cancelLock.Unlock()
+	return s.cancelledChan()
+}
+
+// IsCancelled returns true once the session is cancelled, sessions restored from cache
+// check the cancellation published by the node running them
+func (s *Session) IsCancelled() bool {
+	select {
+	case <-s.Cancelled():
+		return true
+	default:
+	}
+
+	if !s.restored {
+		return false
+	}
+
+	exists, err := cache.Exist(sessionCancelledKey(s.ID))
+	if err != nil || exists == 0 {
+		return false
+	}
+
+	s.markCancelled()
+	return true
+}
+
+// WatchCancelled returns a channel which is closed once the session is cancelled, sessions restored
+// from cache poll the cancellation published by the node running them until stop is called
+func (s *Session) WatchCancelled() (cancelled <-chan bool, stop func()) {
+	if !s.restored {
+		return s.Cancelled(), func() {}
+	}
+
+	done := make(chan bool)
+	go func() {
+		ticker := time.NewTicker(SESSION_CANCEL_POLL_INTERVAL)
+		defer ticker.Stop()
+
+		for !s.IsCancelled() {
+			select {
+			case <-ticker.C:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return s.Cancelled(), func() {
+		once.Do(func() { close(done) })
+	}
+}
@@ -88,6 +88,8 @@ func autoMigrate() error {
 		models.InstallTask{},
 		models.TenantStorage{},
 		models.AgentStrategyInstallation{},
+		models.PluginEnvironment{},
+		models.PluginCrash{},
 	)
 }
 
@@ -0,0 +1,31 @@
+package controllers
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/server/constants"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/service"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/app"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/exception"
+)
+
+// DispatchWebSocket serves the dispatch apis over a websocket connection, requests
+// of sessions are bound by the service as they arrive
+func DispatchWebSocket(config *app.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !c.IsWebsocket() {
+			c.JSON(400, exception.BadRequestError(errors.New("websocket upgrade is required")).ToResponse())
+			return
+		}
+
+		identity, ok := c.MustGet(constants.CONTEXT_KEY_PLUGIN_UNIQUE_IDENTIFIER).(plugin_entities.PluginUniqueIdentifier)
+		if !ok {
+			c.JSON(400, exception.PluginUniqueIdentifierError(errors.New("Plugin unique identifier is not valid")).ToResponse())
+			return
+		}
+
+		service.DispatchWebSocket(c, identity, config.PluginMaxExecutionTimeout)
+	}
+}
@@ -4,11 +4,15 @@ import (
 	"errors"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/cluster"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/log_manager"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/service"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/app"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/exception"
 )
@@ -53,7 +57,7 @@ func UploadPlugin(app *app.Config) gin.HandlerFunc {
 		}
 		defer mlchainPkgFile.Close()
 
-		c.JSON(http.StatusOK, service.UploadPluginPkg(app, c, tenantId, mlchainPkgFile, verifySignature))
+		c.JSON(http.StatusOK, service.UploadPluginPkg(app, tenantId, mlchainPkgFile, verifySignature))
 	}
 }
 
@@ -85,7 +89,7 @@ func UploadBundle(app *app.Config) gin.HandlerFunc {
 		}
 		defer mlchainBundleFile.Close()
 
-		c.JSON(http.StatusOK, service.UploadPluginBundle(app, c, tenantId, mlchainBundleFile, verifySignature))
+		c.JSON(http.StatusOK, service.UploadPluginBundle(app, tenantId, mlchainBundleFile, verifySignature))
 	}
 }
 
@@ -97,6 +101,7 @@ func UpgradePlugin(app *app.Config) gin.HandlerFunc {
 			NewPluginUniqueIdentifier      plugin_entities.PluginUniqueIdentifier `json:"new_plugin_unique_identifier" validate:"required,plugin_unique_identifier"`
 			Source                         string                                 `json:"source" validate:"required"`
 			Meta                           map[string]any                         `json:"meta" validate:"omitempty"`
+			WaitForReady                   bool                                   `json:"wait_for_ready"`
 		}) {
 			c.JSON(http.StatusOK, service.UpgradePlugin(
 				app,
@@ -105,6 +110,7 @@ func UpgradePlugin(app *app.Config) gin.HandlerFunc {
 				request.Meta,
 				request.OriginalPluginUniqueIdentifier,
 				request.NewPluginUniqueIdentifier,
+				request.WaitForReady,
 			))
 		})
 	}
@@ -117,12 +123,13 @@ func InstallPluginFromIdentifiers(app *app.Config) gin.HandlerFunc {
 			PluginUniqueIdentifiers []plugin_entities.PluginUniqueIdentifier `json:"plugin_unique_identifiers" validate:"required,max=64,dive,plugin_unique_identifier"`
 			Source                  string                                   `json:"source" validate:"required"`
 			Meta                    map[string]any                           `json:"meta" validate:"omitempty"`
+			WaitForReady            bool                                     `json:"wait_for_ready"`
 		}) {
 			if request.Meta == nil {
 				request.Meta = map[string]any{}
 			}
 			c.JSON(http.StatusOK, service.InstallPluginFromIdentifiers(
-				app, request.TenantID, request.PluginUniqueIdentifiers, request.Source, request.Meta,
+				app, request.TenantID, request.PluginUniqueIdentifiers, request.Source, request.Meta, request.WaitForReady,
 			))
 		})
 	}
@@ -199,6 +206,57 @@ func UninstallPlugin(c *gin.Context) {
 	})
 }
 
+func ListPluginLogs(c *gin.Context) {
+	queryPluginLogs(c, false)
+}
+
+func TailPluginLogs(c *gin.Context) {
+	queryPluginLogs(c, true)
+}
+
+func queryPluginLogs(c *gin.Context, tail bool) {
+	BindRequest(c, func(request struct {
+		TenantID               string                                 `uri:"tenant_id" validate:"required"`
+		PluginUniqueIdentifier plugin_entities.PluginUniqueIdentifier `form:"plugin_unique_identifier" validate:"required,plugin_unique_identifier"`
+		Level                  string                                 `form:"level"`
+		SessionID              string                                 `form:"session_id"`
+		Since                  time.Time                              `form:"since" time_format:"2006-01-02T15:04:05Z07:00"`
+		Limit                  int                                    `form:"limit" validate:"omitempty,min=1,max=1000"`
+	}) {
+		if request.Limit == 0 {
+			request.Limit = 100
+		}
+
+		c.JSON(http.StatusOK, service.QueryPluginLogs(
+			request.TenantID,
+			request.PluginUniqueIdentifier,
+			log_manager.LogFilter{
+				Level:     request.Level,
+				SessionID: request.SessionID,
+				Since:     request.Since,
+				Limit:     request.Limit,
+				Tail:      tail,
+			},
+		))
+	})
+}
+
+func ListPluginCrashes(c *gin.Context) {
+	BindRequest(c, func(request struct {
+		TenantID               string                                 `uri:"tenant_id" validate:"required"`
+		PluginUniqueIdentifier plugin_entities.PluginUniqueIdentifier `form:"plugin_unique_identifier" validate:"required,plugin_unique_identifier"`
+		Limit                  int                                    `form:"limit" validate:"omitempty,min=1,max=100"`
+	}) {
+		if request.Limit == 0 {
+			request.Limit = 20
+		}
+
+		c.JSON(http.StatusOK, service.ListPluginCrashes(
+			request.TenantID, request.PluginUniqueIdentifier, request.Limit,
+		))
+	})
+}
+
 func FetchPluginFromIdentifier(c *gin.Context) {
 	BindRequest(c, func(request struct {
 		PluginUniqueIdentifier plugin_entities.PluginUniqueIdentifier `form:"plugin_unique_identifier" validate:"required,plugin_unique_identifier"`
@@ -234,3 +292,75 @@ func FetchMissingPluginInstallations(c *gin.Context) {
 		c.JSON(http.StatusOK, service.FetchMissingPluginInstallations(request.TenantID, request.PluginUniqueIdentifiers))
 	})
 }
+
+// PluginRuntimeLister lists runtimes of plugins hosted by all the nodes of the cluster
+type PluginRuntimeLister interface {
+	ListPluginRuntimes() ([]cluster.PluginRuntime, error)
+}
+
+func ListPluginRuntimes(lister PluginRuntimeLister) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		BindRequest(c, func(request struct {
+			TenantID string `uri:"tenant_id" validate:"required"`
+		}) {
+			runtimes, err := lister.ListPluginRuntimes()
+			if err != nil {
+				c.JSON(http.StatusOK, exception.InternalServerError(err).ToResponse())
+				return
+			}
+
+			c.JSON(http.StatusOK, service.ListPluginRuntimes(request.TenantID, runtimes))
+		})
+	}
+}
+
+func ListAllPluginRuntimes(lister PluginRuntimeLister) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		runtimes, err := lister.ListPluginRuntimes()
+		if err != nil {
+			c.JSON(http.StatusOK, exception.InternalServerError(err).ToResponse())
+			return
+		}
+
+		c.JSON(http.StatusOK, entities.NewSuccessResponse(runtimes))
+	}
+}
+
+func ResetPluginCrashLoop(c *gin.Context) {
+	BindRequest(c, func(request struct {
+		PluginUniqueIdentifier plugin_entities.PluginUniqueIdentifier `json:"plugin_unique_identifier" validate:"required,plugin_unique_identifier"`
+	}) {
+		c.JSON(http.StatusOK, service.ResetPluginCrashLoop(request.PluginUniqueIdentifier))
+	})
+}
+
+func ListPluginEnvironment(c *gin.Context) {
+	BindRequest(c, func(request struct {
+		PluginID string `form:"plugin_id" validate:"required"`
+	}) {
+		c.JSON(http.StatusOK, service.ListPluginEnvironment(request.PluginID))
+	})
+}
+
+func SetPluginEnvironment(c *gin.Context) {
+	BindRequest(c, func(request struct {
+		PluginID string `json:"plugin_id" validate:"required"`
+		TenantID string `json:"tenant_id"`
+		Key      string `json:"key" validate:"required"`
+		Value    string `json:"value"`
+	}) {
+		c.JSON(http.StatusOK, service.SetPluginEnvironment(
+			request.PluginID, request.TenantID, request.Key, request.Value,
+		))
+	})
+}
+
+func DeletePluginEnvironment(c *gin.Context) {
+	BindRequest(c, func(request struct {
+		PluginID string `json:"plugin_id" validate:"required"`
+		TenantID string `json:"tenant_id"`
+		Key      string `json:"key" validate:"required"`
+	}) {
+		c.JSON(http.StatusOK, service.DeletePluginEnvironment(request.PluginID, request.TenantID, request.Key))
+	})
+}
@@ -0,0 +1,60 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/cluster"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/parser"
+)
+
+type fakeRuntimeLister struct {
+	runtimes []cluster.PluginRuntime
+	err      error
+}
+
+func (l fakeRuntimeLister) ListPluginRuntimes() ([]cluster.PluginRuntime, error) {
+	return l.runtimes, l.err
+}
+
+func listAllPluginRuntimes(t *testing.T, lister PluginRuntimeLister) entities.GenericResponse[[]cluster.PluginRuntime] {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.GET("/admin/runtimes", ListAllPluginRuntimes(lister))
+
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/admin/runtimes", nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", recorder.Code)
+	}
+
+	response, err := parser.UnmarshalJsonBytes[entities.GenericResponse[[]cluster.PluginRuntime]](recorder.Body.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return response
+}
+
+func TestListAllPluginRuntimes(t *testing.T) {
+	// runtimes of plugins installed by different tenants, the admin api is not scoped to any of them
+	runtimes := []cluster.PluginRuntime{
+		{PluginUniqueIdentifier: "langgenius/a:0.0.1@a", NodeID: "node-a"},
+		{PluginUniqueIdentifier: "langgenius/b:0.0.1@b", NodeID: "node-b"},
+	}
+
+	response := listAllPluginRuntimes(t, fakeRuntimeLister{runtimes: runtimes})
+	if response.Code != 0 || len(response.Data) != 2 ||
+		response.Data[0].PluginUniqueIdentifier != "langgenius/a:0.0.1@a" ||
+		response.Data[1].PluginUniqueIdentifier != "langgenius/b:0.0.1@b" {
+		t.Fatalf("expected every runtime of the cluster, got %+v", response)
+	}
+
+	response = listAllPluginRuntimes(t, fakeRuntimeLister{err: errors.New("redis is down")})
+	if response.Code == 0 {
+		t.Fatalf("expected an error once runtimes could not be listed, got %+v", response)
+	}
+}
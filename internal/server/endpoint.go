@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/db"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/service"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/app"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/exception"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/models"
@@ -19,7 +20,7 @@ import (
 // EndpointHandler is a function type that can be used to handle endpoint requests
 type EndpointHandler func(ctx *gin.Context, hookId string, path string)
 
-func (app *App) Endpoint() func(c *gin.Context) {
+func (app *App) Endpoint(config *app.Config) func(c *gin.Context) {
 	return func(c *gin.Context) {
 		hookId := c.Param("hook_id")
 		path := c.Param("path")
@@ -27,12 +28,12 @@ func (app *App) Endpoint() func(c *gin.Context) {
 		if app.endpointHandler != nil {
 			app.endpointHandler(c, hookId, path)
 		} else {
-			app.EndpointHandler(c, hookId, path)
+			app.EndpointHandler(c, hookId, path, config.PluginMaxExecutionTimeout)
 		}
 	}
 }
 
-func (app *App) EndpointHandler(ctx *gin.Context, hookId string, path string) {
+func (app *App) EndpointHandler(ctx *gin.Context, hookId string, path string, max_timeout_seconds int) {
 	endpoint, err := db.GetOne[models.Endpoint](
 		db.Equal("hook_id", hookId),
 	)
@@ -71,6 +72,6 @@ func (app *App) EndpointHandler(ctx *gin.Context, hookId string, path string) {
 	if ok, originalError := app.cluster.IsPluginOnCurrentNode(pluginUniqueIdentifier); !ok {
 		app.redirectPluginInvokeByPluginIdentifier(ctx, pluginUniqueIdentifier, originalError)
 	} else {
-		service.Endpoint(ctx, &endpoint, &pluginInstallation, path)
+		service.Endpoint(ctx, &endpoint, &pluginInstallation, path, max_timeout_seconds)
 	}
 }
@@ -0,0 +1,316 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_daemon"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_daemon/access_types"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/session_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/server/constants"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/service"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/app"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/exception"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/pb"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/validators"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/stream"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// grpcDispatchServer serves the dispatch apis over grpc, requests and responses are converted
+// to the entities of the http api through their json forms, fields of the messages are named after them
+type grpcDispatchServer struct {
+	pb.UnimplementedPluginDispatchServer
+
+	app    *App
+	config *app.Config
+}
+
+// grpcDispatch binds the request like BindPluginDispatchRequest, invokes the plugin on this node or
+// redirects the call to the node the plugin is running on, and sends the responses to the stream
+func grpcDispatch[Req any, Rsp any, T any, PT interface {
+	*T
+	proto.Message
+}](
+	s *grpcDispatchServer,
+	ctx context.Context,
+	path string,
+	invokeContext *pb.InvokeContext,
+	data proto.Message,
+	access_type access_types.PluginAccessType,
+	access_action access_types.PluginAccessAction,
+	invoke func(*session_manager.Session, *Req) (*stream.Stream[Rsp], error),
+	send func(PT) error,
+) error {
+	pluginId := grpcMetadata(ctx, constants.X_PLUGIN_ID)
+
+	var r plugin_entities.InvokePluginRequest[Req]
+	if invokeContext != nil {
+		r.TenantId = invokeContext.TenantId
+		r.UserId = invokeContext.UserId
+		r.ConversationID = invokeContext.ConversationId
+		r.MessageID = invokeContext.MessageId
+		r.AppID = invokeContext.AppId
+		r.EndpointID = invokeContext.EndpointId
+	}
+
+	payload, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(data)
+	if err != nil {
+		return grpcError(exception.BadRequestError(err).ToResponse())
+	}
+	if err := json.Unmarshal(payload, &r.Data); err != nil {
+		return grpcError(exception.BadRequestError(err).ToResponse())
+	}
+
+	_, identity, daemonErr := fetchPluginInstallation(r.TenantId, pluginId)
+	if daemonErr != nil {
+		return grpcError(daemonErr.ToResponse())
+	}
+	r.UniqueIdentifier = identity
+
+	if err := validators.GlobalEntitiesValidator.Struct(r); err != nil {
+		return grpcError(exception.BadRequestError(err).ToResponse())
+	}
+
+	writer := func(chunk json.RawMessage) error {
+		message := PT(new(T))
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(chunk, message); err != nil {
+			return err
+		}
+		return send(message)
+	}
+
+	if ok, originalError := s.app.cluster.IsPluginOnCurrentNode(identity); !ok {
+		header := http.Header{}
+		header.Set(constants.X_API_KEY, s.config.ServerKey)
+		header.Set(constants.X_PLUGIN_ID, pluginId)
+		// the time left is sent rather than the deadline, the clocks of the nodes may differ
+		if deadline, ok := ctx.Deadline(); ok {
+			header.Set(service.TIMEOUT_HEADER, service.FormatTimeout(deadline))
+		}
+
+		body, err := s.app.grpcRedirect(
+			ctx,
+			identity,
+			originalError,
+			http.MethodPost,
+			"/plugin/"+r.TenantId+"/dispatch/"+path,
+			header,
+			r,
+		)
+		if err != nil {
+			return err
+		}
+		defer body.Close()
+
+		// the caller is gone
+		stop := context.AfterFunc(ctx, func() { body.Close() })
+		defer stop()
+
+		return readRedirectedStream(body, writer)
+	}
+
+	if daemonErr := service.InvokeStream(
+		ctx,
+		&r,
+		access_type,
+		access_action,
+		s.app.cluster.ID(),
+		s.config.PluginMaxExecutionTimeout,
+		invoke,
+		func(chunk Rsp) error {
+			payload, err := json.Marshal(chunk)
+			if err != nil {
+				return err
+			}
+			return writer(payload)
+		},
+	); daemonErr != nil {
+		return grpcError(daemonErr.ToResponse())
+	}
+
+	return nil
+}
+
+func (s *grpcDispatchServer) InvokeTool(r *pb.InvokeToolRequest, srv pb.PluginDispatch_InvokeToolServer) error {
+	return grpcDispatch(
+		s, srv.Context(), "tool/invoke", r.Context, r.Data,
+		access_types.PLUGIN_ACCESS_TYPE_TOOL,
+		access_types.PLUGIN_ACCESS_ACTION_INVOKE_TOOL,
+		plugin_daemon.InvokeTool,
+		srv.Send,
+	)
+}
+
+func (s *grpcDispatchServer) ValidateToolCredentials(
+	r *pb.ValidateToolCredentialsRequest, srv pb.PluginDispatch_ValidateToolCredentialsServer,
+) error {
+	return grpcDispatch(
+		s, srv.Context(), "tool/validate_credentials", r.Context, r.Data,
+		access_types.PLUGIN_ACCESS_TYPE_TOOL,
+		access_types.PLUGIN_ACCESS_ACTION_VALIDATE_TOOL_CREDENTIALS,
+		plugin_daemon.ValidateToolCredentials,
+		srv.Send,
+	)
+}
+
+func (s *grpcDispatchServer) GetToolRuntimeParameters(
+	r *pb.GetToolRuntimeParametersRequest, srv pb.PluginDispatch_GetToolRuntimeParametersServer,
+) error {
+	return grpcDispatch(
+		s, srv.Context(), "tool/get_runtime_parameters", r.Context, r.Data,
+		access_types.PLUGIN_ACCESS_TYPE_TOOL,
+		access_types.PLUGIN_ACCESS_ACTION_GET_TOOL_RUNTIME_PARAMETERS,
+		plugin_daemon.GetToolRuntimeParameters,
+		srv.Send,
+	)
+}
+
+func (s *grpcDispatchServer) InvokeAgentStrategy(
+	r *pb.InvokeAgentStrategyRequest, srv pb.PluginDispatch_InvokeAgentStrategyServer,
+) error {
+	return grpcDispatch(
+		s, srv.Context(), "agent_strategy/invoke", r.Context, r.Data,
+		access_types.PLUGIN_ACCESS_TYPE_AGENT_STRATEGY,
+		access_types.PLUGIN_ACCESS_ACTION_INVOKE_AGENT_STRATEGY,
+		plugin_daemon.InvokeAgentStrategy,
+		srv.Send,
+	)
+}
+
+func (s *grpcDispatchServer) InvokeLLM(r *pb.InvokeLLMRequest, srv pb.PluginDispatch_InvokeLLMServer) error {
+	return grpcDispatch(
+		s, srv.Context(), "llm/invoke", r.Context, r.Data,
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_INVOKE_LLM,
+		plugin_daemon.InvokeLLM,
+		srv.Send,
+	)
+}
+
+func (s *grpcDispatchServer) GetLLMNumTokens(r *pb.GetLLMNumTokensRequest, srv pb.PluginDispatch_GetLLMNumTokensServer) error {
+	return grpcDispatch(
+		s, srv.Context(), "llm/num_tokens", r.Context, r.Data,
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_GET_LLM_NUM_TOKENS,
+		plugin_daemon.GetLLMNumTokens,
+		srv.Send,
+	)
+}
+
+func (s *grpcDispatchServer) InvokeTextEmbedding(
+	r *pb.InvokeTextEmbeddingRequest, srv pb.PluginDispatch_InvokeTextEmbeddingServer,
+) error {
+	return grpcDispatch(
+		s, srv.Context(), "text_embedding/invoke", r.Context, r.Data,
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_INVOKE_TEXT_EMBEDDING,
+		plugin_daemon.InvokeTextEmbedding,
+		srv.Send,
+	)
+}
+
+func (s *grpcDispatchServer) GetTextEmbeddingNumTokens(
+	r *pb.GetTextEmbeddingNumTokensRequest, srv pb.PluginDispatch_GetTextEmbeddingNumTokensServer,
+) error {
+	return grpcDispatch(
+		s, srv.Context(), "text_embedding/num_tokens", r.Context, r.Data,
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_GET_TEXT_EMBEDDING_NUM_TOKENS,
+		plugin_daemon.GetTextEmbeddingNumTokens,
+		srv.Send,
+	)
+}
+
+func (s *grpcDispatchServer) InvokeRerank(r *pb.InvokeRerankRequest, srv pb.PluginDispatch_InvokeRerankServer) error {
+	return grpcDispatch(
+		s, srv.Context(), "rerank/invoke", r.Context, r.Data,
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_INVOKE_RERANK,
+		plugin_daemon.InvokeRerank,
+		srv.Send,
+	)
+}
+
+func (s *grpcDispatchServer) InvokeTTS(r *pb.InvokeTTSRequest, srv pb.PluginDispatch_InvokeTTSServer) error {
+	return grpcDispatch(
+		s, srv.Context(), "tts/invoke", r.Context, r.Data,
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_INVOKE_TTS,
+		plugin_daemon.InvokeTTS,
+		srv.Send,
+	)
+}
+
+func (s *grpcDispatchServer) GetTTSModelVoices(
+	r *pb.GetTTSModelVoicesRequest, srv pb.PluginDispatch_GetTTSModelVoicesServer,
+) error {
+	return grpcDispatch(
+		s, srv.Context(), "tts/model/voices", r.Context, r.Data,
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_GET_TTS_MODEL_VOICES,
+		plugin_daemon.GetTTSModelVoices,
+		srv.Send,
+	)
+}
+
+func (s *grpcDispatchServer) InvokeSpeech2Text(
+	r *pb.InvokeSpeech2TextRequest, srv pb.PluginDispatch_InvokeSpeech2TextServer,
+) error {
+	return grpcDispatch(
+		s, srv.Context(), "speech2text/invoke", r.Context, r.Data,
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_INVOKE_SPEECH2TEXT,
+		plugin_daemon.InvokeSpeech2Text,
+		srv.Send,
+	)
+}
+
+func (s *grpcDispatchServer) InvokeModeration(
+	r *pb.InvokeModerationRequest, srv pb.PluginDispatch_InvokeModerationServer,
+) error {
+	return grpcDispatch(
+		s, srv.Context(), "moderation/invoke", r.Context, r.Data,
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_INVOKE_MODERATION,
+		plugin_daemon.InvokeModeration,
+		srv.Send,
+	)
+}
+
+func (s *grpcDispatchServer) ValidateProviderCredentials(
+	r *pb.ValidateProviderCredentialsRequest, srv pb.PluginDispatch_ValidateProviderCredentialsServer,
+) error {
+	return grpcDispatch(
+		s, srv.Context(), "model/validate_provider_credentials", r.Context, r.Data,
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_VALIDATE_PROVIDER_CREDENTIALS,
+		plugin_daemon.ValidateProviderCredentials,
+		srv.Send,
+	)
+}
+
+func (s *grpcDispatchServer) ValidateModelCredentials(
+	r *pb.ValidateModelCredentialsRequest, srv pb.PluginDispatch_ValidateModelCredentialsServer,
+) error {
+	return grpcDispatch(
+		s, srv.Context(), "model/validate_model_credentials", r.Context, r.Data,
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_VALIDATE_MODEL_CREDENTIALS,
+		plugin_daemon.ValidateModelCredentials,
+		srv.Send,
+	)
+}
+
+func (s *grpcDispatchServer) GetAIModelSchema(r *pb.GetAIModelSchemaRequest, srv pb.PluginDispatch_GetAIModelSchemaServer) error {
+	return grpcDispatch(
+		s, srv.Context(), "model/schema", r.Context, r.Data,
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_GET_AI_MODEL_SCHEMAS,
+		plugin_daemon.GetAIModelSchema,
+		srv.Send,
+	)
+}
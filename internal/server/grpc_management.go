@@ -0,0 +1,564 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/log_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/server/constants"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/service"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/app"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/exception"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/pb"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/validators"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// grpcManagementServer serves the management apis over grpc, the same as pluginManagementGroup does,
+// the data of a response is the data of the json response of the http api, decoded into a typed message
+// for the list and fetch rpcs
+type grpcManagementServer struct {
+	pb.UnimplementedPluginManagementServer
+
+	app    *App
+	config *app.Config
+}
+
+// managementResponse converts a response of the service layer, an error response becomes a grpc status
+func managementResponse(response *entities.Response) (*pb.ManagementResponse, error) {
+	if response.Code != 0 {
+		return nil, grpcError(response)
+	}
+
+	payload, err := json.Marshal(response.Data)
+	if err != nil {
+		return nil, grpcError(exception.InternalServerError(err).ToResponse())
+	}
+
+	data := &structpb.Value{}
+	if err := protojson.Unmarshal(payload, data); err != nil {
+		return nil, grpcError(exception.InternalServerError(err).ToResponse())
+	}
+
+	return &pb.ManagementResponse{Data: data}, nil
+}
+
+// typedManagementResponse converts a response of the service layer to a typed message, the data of the
+// response is decoded into the field of the message by its json name, fields unknown to the message are dropped
+func typedManagementResponse[M proto.Message](response *entities.Response, field string, message M) (M, error) {
+	var empty M
+	if response.Code != 0 {
+		return empty, grpcError(response)
+	}
+
+	payload, err := json.Marshal(map[string]any{field: response.Data})
+	if err != nil {
+		return empty, grpcError(exception.InternalServerError(err).ToResponse())
+	}
+
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(payload, message); err != nil {
+		return empty, grpcError(exception.InternalServerError(err).ToResponse())
+	}
+
+	return message, nil
+}
+
+// grpcBindTyped is grpcBind for the rpcs which return a typed message, see typedManagementResponse
+func grpcBindTyped[T any, M proto.Message](
+	request T, field string, message M, success func(T) *entities.Response,
+) (M, error) {
+	if err := validators.GlobalEntitiesValidator.Struct(request); err != nil {
+		var empty M
+		return empty, grpcError(exception.BadRequestError(err).ToResponse())
+	}
+
+	return typedManagementResponse(success(request), field, message)
+}
+
+// grpcBind validates the request like BindRequest does and converts the response of the service layer
+func grpcBind[T any](request T, success func(T) *entities.Response) (*pb.ManagementResponse, error) {
+	if err := validators.GlobalEntitiesValidator.Struct(request); err != nil {
+		return nil, grpcError(exception.BadRequestError(err).ToResponse())
+	}
+
+	return managementResponse(success(request))
+}
+
+func pluginUniqueIdentifiers(identifiers []string) []plugin_entities.PluginUniqueIdentifier {
+	result := make([]plugin_entities.PluginUniqueIdentifier, len(identifiers))
+	for i, identifier := range identifiers {
+		result[i] = plugin_entities.PluginUniqueIdentifier(identifier)
+	}
+	return result
+}
+
+func (s *grpcManagementServer) UploadPlugin(ctx context.Context, r *pb.UploadPluginRequest) (*pb.ManagementResponse, error) {
+	if r.TenantId == "" {
+		return nil, grpcError(exception.BadRequestError(errors.New("Tenant ID is required")).ToResponse())
+	}
+
+	if int64(len(r.MlchainPkg)) > s.config.MaxPluginPackageSize {
+		return nil, grpcError(exception.BadRequestError(errors.New("File size exceeds the maximum limit")).ToResponse())
+	}
+
+	return managementResponse(service.UploadPluginPkg(
+		s.config, r.TenantId, bytes.NewReader(r.MlchainPkg), r.VerifySignature,
+	))
+}
+
+func (s *grpcManagementServer) UploadBundle(ctx context.Context, r *pb.UploadBundleRequest) (*pb.ManagementResponse, error) {
+	if r.TenantId == "" {
+		return nil, grpcError(exception.BadRequestError(errors.New("Tenant ID is required")).ToResponse())
+	}
+
+	if int64(len(r.MlchainBundle)) > s.config.MaxBundlePackageSize {
+		return nil, grpcError(exception.BadRequestError(errors.New("File size exceeds the maximum limit")).ToResponse())
+	}
+
+	return managementResponse(service.UploadPluginBundle(
+		s.config, r.TenantId, bytes.NewReader(r.MlchainBundle), r.VerifySignature,
+	))
+}
+
+func (s *grpcManagementServer) InstallPluginFromIdentifiers(
+	ctx context.Context, r *pb.InstallPluginFromIdentifiersRequest,
+) (*pb.ManagementResponse, error) {
+	type request struct {
+		TenantID                string                                   `validate:"required"`
+		PluginUniqueIdentifiers []plugin_entities.PluginUniqueIdentifier `validate:"required,max=64,dive,plugin_unique_identifier"`
+		Source                  string                                   `validate:"required"`
+		Meta                    map[string]any                           `validate:"omitempty"`
+		WaitForReady            bool
+	}
+
+	return grpcBind(request{
+		TenantID:                r.TenantId,
+		PluginUniqueIdentifiers: pluginUniqueIdentifiers(r.PluginUniqueIdentifiers),
+		Source:                  r.Source,
+		Meta:                    r.Meta.AsMap(),
+		WaitForReady:            r.WaitForReady,
+	}, func(request request) *entities.Response {
+		return service.InstallPluginFromIdentifiers(
+			s.config, request.TenantID, request.PluginUniqueIdentifiers, request.Source, request.Meta, request.WaitForReady,
+		)
+	})
+}
+
+func (s *grpcManagementServer) UpgradePlugin(ctx context.Context, r *pb.UpgradePluginRequest) (*pb.ManagementResponse, error) {
+	type request struct {
+		TenantID                       string                                 `validate:"required"`
+		OriginalPluginUniqueIdentifier plugin_entities.PluginUniqueIdentifier `validate:"required,plugin_unique_identifier"`
+		NewPluginUniqueIdentifier      plugin_entities.PluginUniqueIdentifier `validate:"required,plugin_unique_identifier"`
+		Source                         string                                 `validate:"required"`
+		Meta                           map[string]any                         `validate:"omitempty"`
+		WaitForReady                   bool
+	}
+
+	return grpcBind(request{
+		TenantID:                       r.TenantId,
+		OriginalPluginUniqueIdentifier: plugin_entities.PluginUniqueIdentifier(r.OriginalPluginUniqueIdentifier),
+		NewPluginUniqueIdentifier:      plugin_entities.PluginUniqueIdentifier(r.NewPluginUniqueIdentifier),
+		Source:                         r.Source,
+		Meta:                           r.Meta.AsMap(),
+		WaitForReady:                   r.WaitForReady,
+	}, func(request request) *entities.Response {
+		return service.UpgradePlugin(
+			s.config,
+			request.TenantID,
+			request.Source,
+			request.Meta,
+			request.OriginalPluginUniqueIdentifier,
+			request.NewPluginUniqueIdentifier,
+			request.WaitForReady,
+		)
+	})
+}
+
+func (s *grpcManagementServer) FetchPluginInstallationTask(
+	ctx context.Context, r *pb.PluginInstallationTaskRequest,
+) (*pb.FetchPluginInstallationTaskResponse, error) {
+	type request struct {
+		TenantID string `validate:"required"`
+		TaskID   string `validate:"required"`
+	}
+
+	return grpcBindTyped(request{
+		r.TenantId, r.TaskId,
+	}, "task", &pb.FetchPluginInstallationTaskResponse{}, func(request request) *entities.Response {
+		return service.FetchPluginInstallationTask(request.TenantID, request.TaskID)
+	})
+}
+
+func (s *grpcManagementServer) DeleteAllPluginInstallationTasks(
+	ctx context.Context, r *pb.TenantRequest,
+) (*pb.ManagementResponse, error) {
+	type request struct {
+		TenantID string `validate:"required"`
+	}
+
+	return grpcBind(request{r.TenantId}, func(request request) *entities.Response {
+		return service.DeleteAllPluginInstallationTasks(request.TenantID)
+	})
+}
+
+func (s *grpcManagementServer) DeletePluginInstallationTask(
+	ctx context.Context, r *pb.PluginInstallationTaskRequest,
+) (*pb.ManagementResponse, error) {
+	type request struct {
+		TenantID string `validate:"required"`
+		TaskID   string `validate:"required"`
+	}
+
+	return grpcBind(request{r.TenantId, r.TaskId}, func(request request) *entities.Response {
+		return service.DeletePluginInstallationTask(request.TenantID, request.TaskID)
+	})
+}
+
+func (s *grpcManagementServer) DeletePluginInstallationItemFromTask(
+	ctx context.Context, r *pb.DeletePluginInstallationItemFromTaskRequest,
+) (*pb.ManagementResponse, error) {
+	type request struct {
+		TenantID   string                                 `validate:"required"`
+		TaskID     string                                 `validate:"required"`
+		Identifier plugin_entities.PluginUniqueIdentifier `validate:"required,plugin_unique_identifier"`
+	}
+
+	return grpcBind(request{
+		r.TenantId, r.TaskId, plugin_entities.PluginUniqueIdentifier(strings.TrimLeft(r.Identifier, "/")),
+	}, func(request request) *entities.Response {
+		return service.DeletePluginInstallationItemFromTask(request.TenantID, request.TaskID, request.Identifier)
+	})
+}
+
+func (s *grpcManagementServer) FetchPluginInstallationTasks(
+	ctx context.Context, r *pb.ListRequest,
+) (*pb.FetchPluginInstallationTasksResponse, error) {
+	type request struct {
+		TenantID string `validate:"required"`
+		Page     int    `validate:"required,min=1"`
+		PageSize int    `validate:"required,min=1,max=256"`
+	}
+
+	return grpcBindTyped(request{
+		r.TenantId, int(r.Page), int(r.PageSize),
+	}, "tasks", &pb.FetchPluginInstallationTasksResponse{}, func(request request) *entities.Response {
+		return service.FetchPluginInstallationTasks(request.TenantID, request.Page, request.PageSize)
+	})
+}
+
+func (s *grpcManagementServer) FetchPluginManifest(
+	ctx context.Context, r *pb.PluginRequest,
+) (*pb.FetchPluginManifestResponse, error) {
+	type request struct {
+		TenantID               string                                 `validate:"required"`
+		PluginUniqueIdentifier plugin_entities.PluginUniqueIdentifier `validate:"required,plugin_unique_identifier"`
+	}
+
+	return grpcBindTyped(request{
+		r.TenantId, plugin_entities.PluginUniqueIdentifier(r.PluginUniqueIdentifier),
+	}, "declaration", &pb.FetchPluginManifestResponse{}, func(request request) *entities.Response {
+		return service.FetchPluginManifest(request.TenantID, request.PluginUniqueIdentifier)
+	})
+}
+
+func (s *grpcManagementServer) FetchPluginFromIdentifier(
+	ctx context.Context, r *pb.PluginRequest,
+) (*pb.FetchPluginFromIdentifierResponse, error) {
+	type request struct {
+		PluginUniqueIdentifier plugin_entities.PluginUniqueIdentifier `validate:"required,plugin_unique_identifier"`
+	}
+
+	return grpcBindTyped(request{
+		plugin_entities.PluginUniqueIdentifier(r.PluginUniqueIdentifier),
+	}, "exists", &pb.FetchPluginFromIdentifierResponse{}, func(request request) *entities.Response {
+		return service.FetchPluginFromIdentifier(request.PluginUniqueIdentifier)
+	})
+}
+
+func (s *grpcManagementServer) UninstallPlugin(ctx context.Context, r *pb.UninstallPluginRequest) (*pb.ManagementResponse, error) {
+	type request struct {
+		TenantID             string `validate:"required"`
+		PluginInstallationID string `validate:"required"`
+	}
+
+	return grpcBind(request{r.TenantId, r.PluginInstallationId}, func(request request) *entities.Response {
+		return service.UninstallPlugin(request.TenantID, request.PluginInstallationID)
+	})
+}
+
+func (s *grpcManagementServer) ListPluginRuntimes(
+	ctx context.Context, r *pb.TenantRequest,
+) (*pb.ListPluginRuntimesResponse, error) {
+	type request struct {
+		TenantID string `validate:"required"`
+	}
+
+	return grpcBindTyped(request{
+		r.TenantId,
+	}, "runtimes", &pb.ListPluginRuntimesResponse{}, func(request request) *entities.Response {
+		runtimes, err := s.app.cluster.ListPluginRuntimes()
+		if err != nil {
+			return exception.InternalServerError(err).ToResponse()
+		}
+
+		return service.ListPluginRuntimes(request.TenantID, runtimes)
+	})
+}
+
+func (s *grpcManagementServer) ListPluginLogs(ctx context.Context, r *pb.PluginLogsRequest) (*pb.ManagementResponse, error) {
+	return s.queryPluginLogs(ctx, r, false)
+}
+
+func (s *grpcManagementServer) TailPluginLogs(ctx context.Context, r *pb.PluginLogsRequest) (*pb.ManagementResponse, error) {
+	return s.queryPluginLogs(ctx, r, true)
+}
+
+// queryPluginLogs reads logs of the plugin on this node, or on the node it's running on like
+// RedirectPluginManagement does, logs are kept by the node running the plugin
+func (s *grpcManagementServer) queryPluginLogs(
+	ctx context.Context, r *pb.PluginLogsRequest, tail bool,
+) (*pb.ManagementResponse, error) {
+	type request struct {
+		TenantID               string                                 `validate:"required"`
+		PluginUniqueIdentifier plugin_entities.PluginUniqueIdentifier `validate:"required,plugin_unique_identifier"`
+		Level                  string
+		SessionID              string
+		Since                  time.Time
+		Limit                  int `validate:"omitempty,min=1,max=1000"`
+	}
+
+	var since time.Time
+	if r.Since != nil {
+		since = r.Since.AsTime()
+	}
+
+	params := request{
+		TenantID:               r.TenantId,
+		PluginUniqueIdentifier: plugin_entities.PluginUniqueIdentifier(r.PluginUniqueIdentifier),
+		Level:                  r.Level,
+		SessionID:              r.SessionId,
+		Since:                  since,
+		Limit:                  int(r.Limit),
+	}
+	if err := validators.GlobalEntitiesValidator.Struct(params); err != nil {
+		return nil, grpcError(exception.BadRequestError(err).ToResponse())
+	}
+
+	if params.Limit == 0 {
+		params.Limit = 100
+	}
+
+	if ok, originalError := s.app.cluster.IsPluginOnCurrentNode(params.PluginUniqueIdentifier); !ok {
+		return s.redirectPluginLogs(ctx, params.TenantID, params.PluginUniqueIdentifier, originalError, url.Values{
+			"plugin_unique_identifier": {params.PluginUniqueIdentifier.String()},
+			"level":                    {params.Level},
+			"session_id":               {params.SessionID},
+			"since":                    {formatLogsSince(params.Since)},
+			"limit":                    {strconv.Itoa(params.Limit)},
+		}, tail)
+	}
+
+	return managementResponse(service.QueryPluginLogs(
+		params.TenantID,
+		params.PluginUniqueIdentifier,
+		log_manager.LogFilter{
+			Level:     params.Level,
+			SessionID: params.SessionID,
+			Since:     params.Since,
+			Limit:     params.Limit,
+			Tail:      tail,
+		},
+	))
+}
+
+func formatLogsSince(since time.Time) string {
+	if since.IsZero() {
+		return ""
+	}
+	return since.Format(time.RFC3339Nano)
+}
+
+// redirectPluginLogs queries the logs api of the node the plugin is running on
+func (s *grpcManagementServer) redirectPluginLogs(
+	ctx context.Context,
+	tenant_id string,
+	identity plugin_entities.PluginUniqueIdentifier,
+	originalError error,
+	query url.Values,
+	tail bool,
+) (*pb.ManagementResponse, error) {
+	path := "/plugin/" + tenant_id + "/management/runtime/logs"
+	if tail {
+		path += "/tail"
+	}
+
+	header := http.Header{}
+	header.Set(constants.X_API_KEY, s.config.ServerKey)
+
+	body, err := s.app.grpcRedirect(ctx, identity, originalError, http.MethodGet, path+"?"+query.Encode(), header, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	response, err := readRedirectedResponse(body)
+	if err != nil {
+		return nil, grpcError(exception.InternalServerError(err).ToResponse())
+	}
+
+	return managementResponse(response)
+}
+
+func (s *grpcManagementServer) ListPluginCrashes(ctx context.Context, r *pb.PluginCrashesRequest) (*pb.ManagementResponse, error) {
+	type request struct {
+		TenantID               string                                 `validate:"required"`
+		PluginUniqueIdentifier plugin_entities.PluginUniqueIdentifier `validate:"required,plugin_unique_identifier"`
+		Limit                  int                                    `validate:"omitempty,min=1,max=100"`
+	}
+
+	return grpcBind(request{
+		r.TenantId, plugin_entities.PluginUniqueIdentifier(r.PluginUniqueIdentifier), int(r.Limit),
+	}, func(request request) *entities.Response {
+		if request.Limit == 0 {
+			request.Limit = 20
+		}
+
+		return service.ListPluginCrashes(request.TenantID, request.PluginUniqueIdentifier, request.Limit)
+	})
+}
+
+func (s *grpcManagementServer) ListPlugins(ctx context.Context, r *pb.ListRequest) (*pb.ListPluginsResponse, error) {
+	type request struct {
+		TenantID string `validate:"required"`
+		Page     int    `validate:"required,min=1"`
+		PageSize int    `validate:"required,min=1,max=256"`
+	}
+
+	return grpcBindTyped(request{
+		r.TenantId, int(r.Page), int(r.PageSize),
+	}, "plugins", &pb.ListPluginsResponse{}, func(request request) *entities.Response {
+		return service.ListPlugins(request.TenantID, request.Page, request.PageSize)
+	})
+}
+
+func (s *grpcManagementServer) BatchFetchPluginInstallationByIDs(
+	ctx context.Context, r *pb.BatchFetchPluginInstallationByIDsRequest,
+) (*pb.ListPluginsResponse, error) {
+	type request struct {
+		TenantID  string   `validate:"required"`
+		PluginIDs []string `validate:"required,max=256"`
+	}
+
+	return grpcBindTyped(request{
+		r.TenantId, r.PluginIds,
+	}, "plugins", &pb.ListPluginsResponse{}, func(request request) *entities.Response {
+		return service.BatchFetchPluginInstallationByIDs(request.TenantID, request.PluginIDs)
+	})
+}
+
+func (s *grpcManagementServer) FetchMissingPluginInstallations(
+	ctx context.Context, r *pb.PluginsRequest,
+) (*pb.FetchMissingPluginInstallationsResponse, error) {
+	type request struct {
+		TenantID                string                                   `validate:"required"`
+		PluginUniqueIdentifiers []plugin_entities.PluginUniqueIdentifier `validate:"required,max=256,dive,plugin_unique_identifier"`
+	}
+
+	return grpcBindTyped(request{
+		r.TenantId, pluginUniqueIdentifiers(r.PluginUniqueIdentifiers),
+	}, "plugin_unique_identifiers", &pb.FetchMissingPluginInstallationsResponse{}, func(request request) *entities.Response {
+		return service.FetchMissingPluginInstallations(request.TenantID, request.PluginUniqueIdentifiers)
+	})
+}
+
+func (s *grpcManagementServer) ListModels(ctx context.Context, r *pb.ListRequest) (*pb.ManagementResponse, error) {
+	type request struct {
+		TenantID string `validate:"required"`
+		Page     int    `validate:"required,min=1"`
+		PageSize int    `validate:"required,min=1,max=256"`
+	}
+
+	return grpcBind(request{r.TenantId, int(r.Page), int(r.PageSize)}, func(request request) *entities.Response {
+		return service.ListModels(request.TenantID, request.Page, request.PageSize)
+	})
+}
+
+func (s *grpcManagementServer) ListTools(ctx context.Context, r *pb.ListRequest) (*pb.ManagementResponse, error) {
+	type request struct {
+		TenantID string `validate:"required"`
+		Page     int    `validate:"required,min=1"`
+		PageSize int    `validate:"required,min=1,max=256"`
+	}
+
+	return grpcBind(request{r.TenantId, int(r.Page), int(r.PageSize)}, func(request request) *entities.Response {
+		return service.ListTools(request.TenantID, request.Page, request.PageSize)
+	})
+}
+
+func (s *grpcManagementServer) GetTool(ctx context.Context, r *pb.ProviderRequest) (*pb.ManagementResponse, error) {
+	type request struct {
+		TenantID string `validate:"required"`
+		PluginID string `validate:"required"`
+		Provider string `validate:"required"`
+	}
+
+	return grpcBind(request{r.TenantId, r.PluginId, r.Provider}, func(request request) *entities.Response {
+		return service.GetTool(request.TenantID, request.PluginID, request.Provider)
+	})
+}
+
+func (s *grpcManagementServer) CheckToolExistence(
+	ctx context.Context, r *pb.CheckToolExistenceRequest,
+) (*pb.ManagementResponse, error) {
+	type request struct {
+		TenantID    string                              `validate:"required"`
+		ProviderIDS []service.RequestCheckToolExistence `validate:"required,dive"`
+	}
+
+	providerIds := make([]service.RequestCheckToolExistence, len(r.ProviderIds))
+	for i, providerId := range r.ProviderIds {
+		providerIds[i] = service.RequestCheckToolExistence{
+			PluginID:     providerId.GetPluginId(),
+			ProviderName: providerId.GetProviderName(),
+		}
+	}
+
+	return grpcBind(request{r.TenantId, providerIds}, func(request request) *entities.Response {
+		return service.CheckToolExistence(request.TenantID, request.ProviderIDS)
+	})
+}
+
+func (s *grpcManagementServer) ListAgentStrategies(ctx context.Context, r *pb.ListRequest) (*pb.ManagementResponse, error) {
+	type request struct {
+		TenantID string `validate:"required"`
+		Page     int    `validate:"required,min=1"`
+		PageSize int    `validate:"required,min=1,max=256"`
+	}
+
+	return grpcBind(request{r.TenantId, int(r.Page), int(r.PageSize)}, func(request request) *entities.Response {
+		return service.ListAgentStrategies(request.TenantID, request.Page, request.PageSize)
+	})
+}
+
+func (s *grpcManagementServer) GetAgentStrategy(ctx context.Context, r *pb.ProviderRequest) (*pb.ManagementResponse, error) {
+	type request struct {
+		TenantID string `validate:"required"`
+		PluginID string `validate:"required"`
+		Provider string `validate:"required"`
+	}
+
+	return grpcBind(request{r.TenantId, r.PluginId, r.Provider}, func(request request) *entities.Response {
+		return service.GetAgentStrategy(request.TenantID, request.PluginID, request.Provider)
+	})
+}
@@ -0,0 +1,232 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/server/constants"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/app"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/exception"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/pb"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// the largest line of a SSE stream redirected from another node, results like audio chunks are large
+	GRPC_REDIRECT_MAX_LINE_SIZE = 32 * 1024 * 1024
+
+	// room for the fields other than the package of an upload request
+	GRPC_MESSAGE_SIZE_OVERHEAD = 1024 * 1024
+)
+
+// grpcServer starts a grpc server serving the dispatch and management apis and returns a function to stop it,
+// it shares the service layer and the cluster redirection with the http server
+func (app *App) grpcServer(config *app.Config) func() {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", config.GRPCServerPort))
+	if err != nil {
+		log.Panic("grpc listen: %s\n", err)
+	}
+
+	// packages and bundles are uploaded in a single message
+	maxMessageSize := config.MaxBundlePackageSize
+	if config.MaxPluginPackageSize > maxMessageSize {
+		maxMessageSize = config.MaxPluginPackageSize
+	}
+
+	srv := grpc.NewServer(
+		grpc.MaxRecvMsgSize(int(maxMessageSize)+GRPC_MESSAGE_SIZE_OVERHEAD),
+		grpc.UnaryInterceptor(func(
+			ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+		) (any, error) {
+			if err := grpcCheckingKey(ctx, config.ServerKey); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(
+			srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+		) error {
+			if err := grpcCheckingKey(ss.Context(), config.ServerKey); err != nil {
+				return err
+			}
+			return handler(srv, ss)
+		}),
+	)
+
+	pb.RegisterPluginDispatchServer(srv, &grpcDispatchServer{app: app, config: config})
+	pb.RegisterPluginManagementServer(srv, &grpcManagementServer{app: app, config: config})
+
+	go func() {
+		if err := srv.Serve(listener); err != nil && err != grpc.ErrServerStopped {
+			log.Panic("grpc serve: %s\n", err)
+		}
+	}()
+
+	return func() {
+		// no request lasts longer than the max execution timeout, streams left after it are closed
+		stopped := make(chan bool)
+		go func() {
+			srv.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+		case <-time.After(time.Duration(config.PluginMaxExecutionTimeout) * time.Second):
+			srv.Stop()
+		}
+	}
+}
+
+// grpcMetadata returns the value of the metadata, the keys are the headers of the http api in lower case
+func grpcMetadata(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(strings.ToLower(key))
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+func grpcCheckingKey(ctx context.Context, key string) error {
+	if grpcMetadata(ctx, constants.X_API_KEY) != key {
+		return grpcError(exception.UnauthorizedError().ToResponse())
+	}
+	return nil
+}
+
+// grpcError converts an error response of the http api to a grpc status, the message is kept as is
+func grpcError(response *entities.Response) error {
+	code := codes.Internal
+	switch response.Code {
+	case -400:
+		code = codes.InvalidArgument
+	case -401:
+		code = codes.Unauthenticated
+	case -403:
+		code = codes.PermissionDenied
+	case -404:
+		code = codes.NotFound
+	case -504:
+		code = codes.DeadlineExceeded
+	}
+
+	return status.Error(code, response.Message)
+}
+
+// grpcRedirect sends the request of a grpc call to the http api of the node the plugin is running on,
+// the same way RedirectPluginInvoke does, the body is closed by the caller
+func (app *App) grpcRedirect(
+	ctx context.Context,
+	identity plugin_entities.PluginUniqueIdentifier,
+	originalError error,
+	method string,
+	path string,
+	header http.Header,
+	body any,
+) (io.ReadCloser, error) {
+	nodeId, _, nodeErr := app.pluginNode(identity, originalError)
+	if nodeErr != nil {
+		return nil, grpcError(nodeErr.ToResponse())
+	}
+
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, grpcError(exception.InternalServerError(err).ToResponse())
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, path, reader)
+	if err != nil {
+		return nil, grpcError(exception.InternalServerError(err).ToResponse())
+	}
+	request.Header = header
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	statusCode, _, responseBody, err := app.cluster.RedirectRequest(nodeId, request)
+	if err != nil {
+		log.Error("redirect request failed: %s", err.Error())
+		return nil, grpcError(exception.InternalServerError(errors.New("redirect request failed: " + err.Error())).ToResponse())
+	}
+
+	if statusCode != http.StatusOK {
+		defer responseBody.Close()
+		response, err := readRedirectedResponse(responseBody)
+		if err != nil {
+			return nil, grpcError(exception.InternalServerError(
+				fmt.Errorf("redirect request failed with status code %d", statusCode),
+			).ToResponse())
+		}
+		return nil, grpcError(response)
+	}
+
+	return responseBody, nil
+}
+
+// readRedirectedResponse reads the json response of a redirected request
+func readRedirectedResponse(body io.Reader) (*entities.Response, error) {
+	var response entities.Response
+	if err := json.NewDecoder(body).Decode(&response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// readRedirectedStream reads the SSE stream of a redirected dispatch request, data of
+// every successful event is handed to the writer, an error event ends the stream
+func readRedirectedStream(body io.Reader, writer func(data json.RawMessage) error) error {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), GRPC_REDIRECT_MAX_LINE_SIZE)
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if !bytes.HasPrefix(line, []byte("data: ")) {
+			// ids and the blank lines between events
+			continue
+		}
+
+		var response entities.GenericResponse[json.RawMessage]
+		if err := json.Unmarshal(line[6:], &response); err != nil {
+			return grpcError(exception.InternalServerError(err).ToResponse())
+		}
+
+		if response.Code != 0 {
+			return grpcError(&entities.Response{Code: response.Code, Message: response.Message})
+		}
+
+		if err := writer(response.Data); err != nil {
+			return err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return grpcError(exception.InternalServerError(err).ToResponse())
+	}
+
+	return nil
+}
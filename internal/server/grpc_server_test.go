@@ -0,0 +1,162 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/requests"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/exception"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/models"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestGRPCRequestMirrorsHTTPRequest(t *testing.T) {
+	credentials, _ := structpb.NewStruct(map[string]any{"api_key": "key"})
+
+	payload, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(&pb.RequestInvokeLLM{
+		Provider:    "openai",
+		Model:       "gpt-4o",
+		ModelType:   "llm",
+		Credentials: credentials,
+		PromptMessages: []*pb.PromptMessage{
+			{Role: "user", Content: structpb.NewStringValue("hello")},
+		},
+		Stop:   []string{"\n"},
+		Stream: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var request requests.RequestInvokeLLM
+	if err := json.Unmarshal(payload, &request); err != nil {
+		t.Fatal(err)
+	}
+
+	if request.Provider != "openai" || request.Model != "gpt-4o" || request.ModelType != "llm" {
+		t.Errorf("unexpected model: %+v", request.BaseRequestInvokeModel)
+	}
+	if request.Credentials.Credentials["api_key"] != "key" {
+		t.Errorf("unexpected credentials: %v", request.Credentials.Credentials)
+	}
+	if len(request.PromptMessages) != 1 || request.PromptMessages[0].Content != "hello" {
+		t.Errorf("unexpected prompt messages: %v", request.PromptMessages)
+	}
+	if len(request.Stop) != 1 || !request.Stream {
+		t.Errorf("unexpected schema: %+v", request.InvokeLLMSchema)
+	}
+}
+
+func TestReadRedirectedStream(t *testing.T) {
+	body := strings.NewReader(
+		"id: 1\ndata: {\"code\":0,\"message\":\"success\",\"data\":{\"model\":\"gpt-4o\"}}\n\n" +
+			"id: 2\ndata: {\"code\":0,\"message\":\"success\",\"data\":{\"model\":\"gpt-4o-mini\",\"unknown\":1}}\n\n",
+	)
+
+	models := []string{}
+	err := readRedirectedStream(body, func(data json.RawMessage) error {
+		var chunk pb.LLMResultChunk
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, &chunk); err != nil {
+			return err
+		}
+		models = append(models, chunk.Model)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(models) != 2 || models[0] != "gpt-4o" || models[1] != "gpt-4o-mini" {
+		t.Errorf("unexpected chunks: %v", models)
+	}
+}
+
+func TestReadRedirectedStreamError(t *testing.T) {
+	body := strings.NewReader(
+		"data: {\"code\":0,\"message\":\"success\",\"data\":{}}\n\n" +
+			"data: {\"code\":-404,\"message\":\"plugin not found\",\"data\":null}\n\n" +
+			"data: {\"code\":0,\"message\":\"success\",\"data\":{}}\n\n",
+	)
+
+	chunks := 0
+	err := readRedirectedStream(body, func(data json.RawMessage) error {
+		chunks++
+		return nil
+	})
+
+	if chunks != 1 {
+		t.Errorf("expected the stream to end at the error, got %d chunks", chunks)
+	}
+
+	s, ok := status.FromError(err)
+	if !ok || s.Code() != codes.NotFound || s.Message() != "plugin not found" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestTypedManagementResponse(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.FixedZone("", 7*3600))
+	task, err := typedManagementResponse(entities.NewSuccessResponse(models.InstallTask{
+		Model:        models.Model{ID: "task", CreatedAt: createdAt},
+		Status:       models.InstallTaskStatusRunning,
+		TotalPlugins: 1,
+		Plugins: []models.InstallTaskPluginStatus{
+			{PluginID: "langgenius/openai", Labels: plugin_entities.NewI18nObject("OpenAI"), Stage: "installing_dependencies"},
+		},
+	}), "task", &pb.FetchPluginInstallationTaskResponse{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if task.Task.Id != "task" || task.Task.Status != "running" || task.Task.TotalPlugins != 1 {
+		t.Errorf("unexpected task: %v", task.Task)
+	}
+	if !task.Task.CreatedAt.AsTime().Equal(createdAt) {
+		t.Errorf("unexpected created_at: %v", task.Task.CreatedAt.AsTime())
+	}
+	if len(task.Task.Plugins) != 1 || task.Task.Plugins[0].Labels["en_US"] != "OpenAI" ||
+		task.Task.Plugins[0].Stage != "installing_dependencies" {
+		t.Errorf("unexpected plugins: %v", task.Task.Plugins)
+	}
+
+	// the state is flattened into the runtime, fields the message does not know are dropped
+	runtimes, err := typedManagementResponse(entities.NewSuccessResponse([]map[string]any{
+		{"plugin_unique_identifier": "langgenius/openai:0.0.1@abc", "status": "active", "pids": []int{42}, "active_at": nil, "unknown": 1},
+	}), "runtimes", &pb.ListPluginRuntimesResponse{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(runtimes.Runtimes) != 1 || runtimes.Runtimes[0].Status != "active" ||
+		len(runtimes.Runtimes[0].Pids) != 1 || runtimes.Runtimes[0].ActiveAt != nil {
+		t.Errorf("unexpected runtimes: %v", runtimes.Runtimes)
+	}
+
+	exists, err := typedManagementResponse(entities.NewSuccessResponse(true), "exists", &pb.FetchPluginFromIdentifierResponse{})
+	if err != nil || !exists.Exists {
+		t.Errorf("unexpected response: %v, %v", exists, err)
+	}
+
+	_, err = typedManagementResponse(
+		exception.ErrPluginNotFound().ToResponse(), "declaration", &pb.FetchPluginManifestResponse{},
+	)
+	if s, ok := status.FromError(err); !ok || s.Code() != codes.NotFound {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestGRPCErrorOfExceededDeadline(t *testing.T) {
+	err := grpcError(exception.DeadlineExceededError(errors.New("killed by timeout")).ToResponse())
+	if s, ok := status.FromError(err); !ok || s.Code() != codes.DeadlineExceeded {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -26,6 +26,7 @@ func (app *App) server(config *app.Config) func() {
 	awsLambdaTransactionGroup := engine.Group("/backwards-invocation")
 	pluginGroup := engine.Group("/plugin/:tenant_id")
 	pprofGroup := engine.Group("/debug/pprof")
+	adminGroup := engine.Group("/admin")
 
 	if config.SentryEnabled {
 		// setup sentry for all groups
@@ -33,6 +34,7 @@ func (app *App) server(config *app.Config) func() {
 			endpointGroup,
 			awsLambdaTransactionGroup,
 			pluginGroup,
+			adminGroup,
 		}
 		for _, group := range sentryGroup {
 			group.Use(sentrygin.New(sentrygin.Options{
@@ -45,6 +47,7 @@ func (app *App) server(config *app.Config) func() {
 	app.awsLambdaTransactionGroup(awsLambdaTransactionGroup, config)
 	app.pluginGroup(pluginGroup, config)
 	app.pprofGroup(pprofGroup, config)
+	app.adminGroup(adminGroup, config)
 
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%d", config.ServerPort),
@@ -76,6 +79,7 @@ func (app *App) pluginGroup(group *gin.RouterGroup, config *app.Config) {
 
 func (app *App) pluginDispatchGroup(group *gin.RouterGroup, config *app.Config) {
 	group.Use(app.FetchPluginInstallation())
+	group.Use(app.ResumeSSEStream(config))
 	group.Use(app.RedirectPluginInvoke())
 	group.Use(app.InitClusterID())
 
@@ -95,6 +99,7 @@ func (app *App) pluginDispatchGroup(group *gin.RouterGroup, config *app.Config)
 	group.POST("/model/validate_provider_credentials", controllers.ValidateProviderCredentials(config))
 	group.POST("/model/validate_model_credentials", controllers.ValidateModelCredentials(config))
 	group.POST("/model/schema", controllers.GetAIModelSchema(config))
+	group.GET("/ws", controllers.DispatchWebSocket(config))
 }
 
 func (app *App) remoteDebuggingGroup(group *gin.RouterGroup, config *app.Config) {
@@ -105,12 +110,12 @@ func (app *App) remoteDebuggingGroup(group *gin.RouterGroup, config *app.Config)
 
 func (app *App) endpointGroup(group *gin.RouterGroup, config *app.Config) {
 	if config.PluginEndpointEnabled {
-		group.HEAD("/:hook_id/*path", app.Endpoint())
-		group.POST("/:hook_id/*path", app.Endpoint())
-		group.GET("/:hook_id/*path", app.Endpoint())
-		group.PUT("/:hook_id/*path", app.Endpoint())
-		group.DELETE("/:hook_id/*path", app.Endpoint())
-		group.OPTIONS("/:hook_id/*path", app.Endpoint())
+		group.HEAD("/:hook_id/*path", app.Endpoint(config))
+		group.POST("/:hook_id/*path", app.Endpoint(config))
+		group.GET("/:hook_id/*path", app.Endpoint(config))
+		group.PUT("/:hook_id/*path", app.Endpoint(config))
+		group.DELETE("/:hook_id/*path", app.Endpoint(config))
+		group.OPTIONS("/:hook_id/*path", app.Endpoint(config))
 	}
 }
 
@@ -149,6 +154,10 @@ func (app *App) pluginManagementGroup(group *gin.RouterGroup, config *app.Config
 	group.GET("/fetch/manifest", gzip.Gzip(gzip.DefaultCompression), controllers.FetchPluginManifest)
 	group.GET("/fetch/identifier", controllers.FetchPluginFromIdentifier)
 	group.POST("/uninstall", controllers.UninstallPlugin)
+	group.GET("/runtimes", controllers.ListPluginRuntimes(app.cluster))
+	group.GET("/runtime/logs", app.RedirectPluginManagement(), controllers.ListPluginLogs)
+	group.GET("/runtime/logs/tail", app.RedirectPluginManagement(), controllers.TailPluginLogs)
+	group.GET("/runtime/crashes", controllers.ListPluginCrashes)
 	group.GET("/list", gzip.Gzip(gzip.DefaultCompression), controllers.ListPlugins)
 	group.POST("/installation/fetch/batch", controllers.BatchFetchPluginInstallationByIDs)
 	group.POST("/installation/missing", controllers.FetchMissingPluginInstallations)
@@ -164,6 +173,18 @@ func (app *App) pluginAssetGroup(group *gin.RouterGroup) {
 	group.GET("/:id", gzip.Gzip(gzip.DefaultCompression), controllers.GetAsset)
 }
 
+// adminGroup serves cluster-wide apis which are not scoped to a tenant
+func (app *App) adminGroup(group *gin.RouterGroup, config *app.Config) {
+	group.Use(CheckingKey(config.ServerKey))
+
+	group.GET("/runtimes", controllers.ListAllPluginRuntimes(app.cluster))
+	// the runtime of a plugin is shared by every tenant which installed it
+	group.POST("/plugin/crash_loop/reset", controllers.ResetPluginCrashLoop)
+	group.GET("/plugin/environment", controllers.ListPluginEnvironment)
+	group.POST("/plugin/environment/set", controllers.SetPluginEnvironment)
+	group.POST("/plugin/environment/delete", controllers.DeletePluginEnvironment)
+}
+
 func (app *App) pprofGroup(group *gin.RouterGroup, config *app.Config) {
 	if config.PPROFEnabled {
 		group.Use(CheckingKey(config.ServerKey))
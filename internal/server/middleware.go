@@ -3,14 +3,18 @@ package server
 import (
 	"errors"
 	"io"
+	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/db"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/server/constants"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/service"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/app"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/exception"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/models"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+	"golang.org/x/net/websocket"
 )
 
 func CheckingKey(key string) gin.HandlerFunc {
@@ -27,37 +31,14 @@ func CheckingKey(key string) gin.HandlerFunc {
 
 func (app *App) FetchPluginInstallation() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		pluginId := ctx.Request.Header.Get(constants.X_PLUGIN_ID)
-		if pluginId == "" {
-			ctx.AbortWithStatusJSON(400, exception.BadRequestError(errors.New("plugin_id is required")).ToResponse())
-			return
-		}
-
-		tenantId := ctx.Param("tenant_id")
-		if tenantId == "" {
-			ctx.AbortWithStatusJSON(400, exception.BadRequestError(errors.New("tenant_id is required")).ToResponse())
-			return
-		}
-
-		// fetch plugin installation
-		installation, err := db.GetOne[models.PluginInstallation](
-			db.Equal("tenant_id", tenantId),
-			db.Equal("plugin_id", pluginId),
+		installation, identity, err := fetchPluginInstallation(
+			ctx.Param("tenant_id"),
+			ctx.Request.Header.Get(constants.X_PLUGIN_ID),
 		)
-
-		if err == db.ErrDatabaseNotFound {
-			ctx.AbortWithStatusJSON(404, exception.ErrPluginNotFound().ToResponse())
-			return
-		}
-
-		if err != nil {
-			ctx.AbortWithStatusJSON(500, exception.InternalServerError(err).ToResponse())
-			return
-		}
-
-		identity, err := plugin_entities.NewPluginUniqueIdentifier(installation.PluginUniqueIdentifier)
 		if err != nil {
-			ctx.AbortWithStatusJSON(400, exception.PluginUniqueIdentifierError(err).ToResponse())
+			// codes of daemon errors are negative http status codes
+			response := err.ToResponse()
+			ctx.AbortWithStatusJSON(-response.Code, response)
 			return
 		}
 
@@ -67,6 +48,41 @@ func (app *App) FetchPluginInstallation() gin.HandlerFunc {
 	}
 }
 
+// fetchPluginInstallation returns the installation of the plugin to the tenant, shared by the http and grpc servers
+func fetchPluginInstallation(
+	tenantId string,
+	pluginId string,
+) (models.PluginInstallation, plugin_entities.PluginUniqueIdentifier, exception.PluginDaemonError) {
+	if pluginId == "" {
+		return models.PluginInstallation{}, "", exception.BadRequestError(errors.New("plugin_id is required"))
+	}
+
+	if tenantId == "" {
+		return models.PluginInstallation{}, "", exception.BadRequestError(errors.New("tenant_id is required"))
+	}
+
+	// fetch plugin installation
+	installation, err := db.GetOne[models.PluginInstallation](
+		db.Equal("tenant_id", tenantId),
+		db.Equal("plugin_id", pluginId),
+	)
+
+	if err == db.ErrDatabaseNotFound {
+		return installation, "", exception.ErrPluginNotFound()
+	}
+
+	if err != nil {
+		return installation, "", exception.InternalServerError(err)
+	}
+
+	identity, err := plugin_entities.NewPluginUniqueIdentifier(installation.PluginUniqueIdentifier)
+	if err != nil {
+		return installation, "", exception.PluginUniqueIdentifierError(err)
+	}
+
+	return installation, identity, nil
+}
+
 // RedirectPluginInvoke redirects the request to the correct cluster node
 func (app *App) RedirectPluginInvoke() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
@@ -99,33 +115,42 @@ func (app *App) RedirectPluginInvoke() gin.HandlerFunc {
 	}
 }
 
+// RedirectPluginManagement redirects management requests of a plugin to the node it's running on,
+// the plugin is taken from the plugin_unique_identifier query
+func (app *App) RedirectPluginManagement() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		identity, err := plugin_entities.NewPluginUniqueIdentifier(ctx.Query("plugin_unique_identifier"))
+		if err != nil {
+			ctx.AbortWithStatusJSON(400, exception.PluginUniqueIdentifierError(err).ToResponse())
+			return
+		}
+
+		if ok, originalError := app.cluster.IsPluginOnCurrentNode(identity); !ok {
+			app.redirectPluginInvokeByPluginIdentifier(ctx, identity, originalError)
+			ctx.Abort()
+		} else {
+			ctx.Next()
+		}
+	}
+}
+
 func (app *App) redirectPluginInvokeByPluginIdentifier(
 	ctx *gin.Context,
 	plugin_unique_identifier plugin_entities.PluginUniqueIdentifier,
 	originalError error,
 ) {
-	// try find the correct node
-	nodes, err := app.cluster.FetchPluginAvailableNodesById(plugin_unique_identifier.String())
-	if err != nil {
-		ctx.AbortWithStatusJSON(
-			500,
-			exception.InternalServerError(
-				errors.New("failed to fetch plugin available nodes, "+originalError.Error()+", "+err.Error()),
-			).ToResponse(),
-		)
-		return
-	} else if len(nodes) == 0 {
-		ctx.AbortWithStatusJSON(
-			404,
-			exception.InternalServerError(
-				errors.New("no available node, "+originalError.Error()),
-			).ToResponse(),
-		)
+	nodeId, statusCode, nodeErr := app.pluginNode(plugin_unique_identifier, originalError)
+	if nodeErr != nil {
+		ctx.AbortWithStatusJSON(statusCode, nodeErr.ToResponse())
 		return
 	}
 
 	// redirect to the correct node
-	nodeId := nodes[0]
+	if ctx.IsWebsocket() {
+		app.redirectWebSocket(ctx, nodeId)
+		return
+	}
+
 	statusCode, header, body, err := app.cluster.RedirectRequest(nodeId, ctx.Request)
 	if err != nil {
 		log.Error("redirect request failed: %s", err.Error())
@@ -164,6 +189,108 @@ func (app *App) redirectPluginInvokeByPluginIdentifier(
 	}
 }
 
+// pluginNode returns the node the plugin is running on, and the http status of the failure
+func (app *App) pluginNode(
+	plugin_unique_identifier plugin_entities.PluginUniqueIdentifier,
+	originalError error,
+) (string, int, exception.PluginDaemonError) {
+	// try find the correct node
+	nodes, err := app.cluster.FetchPluginAvailableNodesById(plugin_unique_identifier.String())
+	if err != nil {
+		return "", 500, exception.InternalServerError(
+			errors.New("failed to fetch plugin available nodes, " + originalError.Error() + ", " + err.Error()),
+		)
+	} else if len(nodes) == 0 {
+		return "", 404, exception.InternalServerError(
+			errors.New("no available node, " + originalError.Error()),
+		)
+	}
+
+	return nodes[0], 0, nil
+}
+
+// redirectWebSocket relays messages of the websocket connection between the client and the node
+func (app *App) redirectWebSocket(ctx *gin.Context, nodeId string) {
+	upstream, err := app.cluster.RedirectWebSocket(nodeId, ctx.Request)
+	if err != nil {
+		log.Error("redirect websocket failed: %s", err.Error())
+		ctx.AbortWithStatusJSON(
+			500,
+			exception.InternalServerError(errors.New("redirect websocket failed: "+err.Error())).ToResponse(),
+		)
+		return
+	}
+	defer upstream.Close()
+
+	server := websocket.Server{
+		// the client is authorized by the api key rather than the origin
+		Handshake: func(*websocket.Config, *http.Request) error {
+			return nil
+		},
+		Handler: func(conn *websocket.Conn) {
+			done := make(chan bool, 2)
+			relay := func(from *websocket.Conn, to *websocket.Conn) {
+				defer func() { done <- true }()
+				relayWebSocket(from, to)
+			}
+
+			go relay(conn, upstream)
+			go relay(upstream, conn)
+
+			// either side is gone, both connections are closed on return
+			<-done
+		},
+	}
+
+	server.ServeHTTP(ctx.Writer, ctx.Request)
+}
+
+// wsFrame is a websocket frame relayed as it is, text frames stay text and binary frames stay binary
+type wsFrame struct {
+	payloadType byte
+	data        []byte
+}
+
+var wsFrameCodec = websocket.Codec{
+	Marshal: func(v interface{}) ([]byte, byte, error) {
+		frame := v.(*wsFrame)
+		return frame.data, frame.payloadType, nil
+	},
+	Unmarshal: func(data []byte, payloadType byte, v interface{}) error {
+		frame := v.(*wsFrame)
+		frame.data = data
+		frame.payloadType = payloadType
+		return nil
+	},
+}
+
+// relayWebSocket forwards frames from one connection to the other until either of them is gone
+func relayWebSocket(from *websocket.Conn, to *websocket.Conn) {
+	for {
+		var frame wsFrame
+		if err := wsFrameCodec.Receive(from, &frame); err != nil {
+			return
+		}
+		if err := wsFrameCodec.Send(to, &frame); err != nil {
+			return
+		}
+	}
+}
+
+// ResumeSSEStream serves a client reconnecting to a dispatch stream with Last-Event-ID from the replay buffer,
+// the plugin is not invoked again, it works on any node as the buffer is kept in redis
+func (app *App) ResumeSSEStream(config *app.Config) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if ctx.GetHeader(service.LAST_EVENT_ID_HEADER) != "" &&
+			service.ResumeSSEStream(ctx, config.PluginMaxExecutionTimeout) {
+			ctx.Abort()
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
 func (app *App) InitClusterID() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		ctx.Set(constants.CONTEXT_KEY_CLUSTER_ID, app.cluster.ID())
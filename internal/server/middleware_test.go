@@ -0,0 +1,61 @@
+package server
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/websocket"
+)
+
+func TestRelayWebSocketKeepsFrameTypes(t *testing.T) {
+	// the node running the plugin echoes every frame
+	upstream := httptest.NewServer(websocket.Handler(func(conn *websocket.Conn) {
+		relayWebSocket(conn, conn)
+	}))
+	defer upstream.Close()
+	upstreamURL := "ws" + strings.TrimPrefix(upstream.URL, "http")
+
+	relay := httptest.NewServer(websocket.Handler(func(conn *websocket.Conn) {
+		node, err := websocket.Dial(upstreamURL, "", upstream.URL)
+		if err != nil {
+			return
+		}
+		defer node.Close()
+
+		done := make(chan bool, 2)
+		go func() { relayWebSocket(conn, node); done <- true }()
+		go func() { relayWebSocket(node, conn); done <- true }()
+		<-done
+	}))
+	defer relay.Close()
+
+	client, err := websocket.Dial("ws"+strings.TrimPrefix(relay.URL, "http"), "", relay.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	audio := []byte{0x00, 0xff, '\n', 0x80}
+	if err := websocket.Message.Send(client, audio); err != nil {
+		t.Fatal(err)
+	}
+	var frame wsFrame
+	if err := wsFrameCodec.Receive(client, &frame); err != nil {
+		t.Fatal(err)
+	}
+	if frame.payloadType != websocket.BinaryFrame || !bytes.Equal(frame.data, audio) {
+		t.Fatalf("expected the binary frame to be relayed as it is, got %d %v", frame.payloadType, frame.data)
+	}
+
+	if err := websocket.Message.Send(client, `{"type":"cancel","id":"1"}`); err != nil {
+		t.Fatal(err)
+	}
+	if err := wsFrameCodec.Receive(client, &frame); err != nil {
+		t.Fatal(err)
+	}
+	if frame.payloadType != websocket.TextFrame || string(frame.data) != `{"type":"cancel","id":"1"}` {
+		t.Fatalf("expected the text frame to be relayed as it is, got %d %s", frame.payloadType, frame.data)
+	}
+}
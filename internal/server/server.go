@@ -1,14 +1,20 @@
 package server
 
 import (
+	"os"
+	"os/signal"
+	"syscall"
+
 	"github.com/getsentry/sentry-go"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/cluster"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/persistence"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_daemon"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/db"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/oss"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/oss/local"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/oss/s3"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/service"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/app"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/routine"
@@ -57,6 +63,10 @@ func (app *App) Run(config *app.Config) {
 	// init oss
 	oss := initOSS(config)
 
+	// init plugin daemon
+	plugin_daemon.InitDaemon(config)
+	service.InitSSEReplay(config)
+
 	// create manager
 	manager := plugin_manager.InitGlobalManager(oss, config)
 
@@ -76,8 +86,20 @@ func (app *App) Run(config *app.Config) {
 	app.cluster.Launch()
 
 	// start http server
-	app.server(config)
+	stopServer := app.server(config)
+
+	// start grpc server
+	stopGRPCServer := func() {}
+	if config.GRPCServerEnabled {
+		stopGRPCServer = app.grpcServer(config)
+	}
+
+	// block until the daemon is asked to stop, requests in flight are finished first
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	<-signals
 
-	// block
-	select {}
+	log.Info("shutting down, waiting for requests in flight")
+	stopGRPCServer()
+	stopServer()
 }
@@ -2,6 +2,7 @@ package service
 
 import (
 	"errors"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -14,11 +15,13 @@ import (
 )
 
 // baseSSEService is a helper function to handle SSE service
-// it accepts a generator function that returns a stream response to gin context
+// it accepts a generator function that returns a stream response to gin context,
+// events of a request asking for replay carry ids and are kept for it, see ResumeSSEStream,
+// the plugin is stopped once the deadline of the request is exceeded, or once the client disconnects without replay
 func baseSSEService[R any](
 	generator func() (*stream.Stream[R], error),
 	ctx *gin.Context,
-	max_timeout_seconds int,
+	deadline time.Time,
 ) {
 	writer := ctx.Writer
 	writer.WriteHeader(200)
@@ -28,14 +31,35 @@ func baseSSEService[R any](
 	doneClosed := new(int32)
 	closed := new(int32)
 
+	var replay *sseReplay
+	if sseReplayRequested(ctx) {
+		replay = newSSEReplay(ctx.Param("tenant_id"), deadline)
+		replay.touch()
+		// a client reconnecting knows there are no more events
+		defer replay.push(sseFrame{End: true})
+	}
+
+	seq := int64(0)
+	seqLock := sync.Mutex{}
 	writeData := func(data interface{}) {
+		seqLock.Lock()
+		defer seqLock.Unlock()
+
+		payload := parser.MarshalJsonBytes(data)
+		id := ""
+		if replay != nil {
+			seq++
+			id = replay.eventID(seq)
+			replay.push(sseFrame{Data: payload})
+		}
+
 		if atomic.LoadInt32(closed) == 1 {
 			return
 		}
-		writer.Write([]byte("data: "))
-		writer.Write(parser.MarshalJsonBytes(data))
-		writer.Write([]byte("\n\n"))
-		writer.Flush()
+		writeSSEEvent(writer, id, payload)
+		if replay != nil {
+			replay.touch()
+		}
 	}
 
 	pluginDaemonResponse, err := generator()
@@ -64,7 +88,7 @@ func baseSSEService[R any](
 		}
 	})
 
-	timer := time.NewTimer(time.Duration(max_timeout_seconds) * time.Second)
+	timer := time.NewTimer(time.Until(deadline))
 	defer timer.Stop()
 
 	defer func() {
@@ -73,15 +97,42 @@ func baseSSEService[R any](
 
 	select {
 	case <-writer.CloseNotify():
-		pluginDaemonResponse.Close()
-		return
+		atomic.StoreInt32(closed, 1)
+		if replay == nil {
+			// nobody could come back for the rest of the stream
+			pluginDaemonResponse.Close()
+			return
+		}
+		replay.detach()
 	case <-done:
 		return
 	case <-timer.C:
-		writeData(exception.InternalServerError(errors.New("killed by timeout")).ToResponse())
+		writeData(exception.DeadlineExceededError(errors.New("killed by timeout")).ToResponse())
+		pluginDaemonResponse.Close()
 		if atomic.CompareAndSwapInt32(doneClosed, 0, 1) {
 			close(done)
 		}
 		return
 	}
+
+	// the client is gone, the plugin keeps generating into the replay buffer for the client
+	// to come back, it's cancelled once nobody reads the stream for the replay timeout
+	ticker := time.NewTicker(SSE_REPLAY_POLL_INTERVAL * 5)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-timer.C:
+			writeData(exception.DeadlineExceededError(errors.New("killed by timeout")).ToResponse())
+			pluginDaemonResponse.Close()
+			return
+		case <-ticker.C:
+			if !replay.hasReader() {
+				pluginDaemonResponse.Close()
+				return
+			}
+		}
+	}
 }
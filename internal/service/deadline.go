@@ -0,0 +1,91 @@
+package service
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// header sent by a caller which needs the result by a deadline, in milliseconds since the unix epoch,
+	// it could only shorten the execution timeout of the server, nested calls forward it as is,
+	// the clocks of the caller and the daemon are assumed to be in sync, use TIMEOUT_HEADER otherwise
+	DEADLINE_HEADER = "X-Plugin-Deadline"
+
+	// header sent by a caller which needs the result in a while, in milliseconds since the request is
+	// received, it's not affected by clock skew, the earlier one is used if both headers are sent
+	TIMEOUT_HEADER = "X-Plugin-Timeout"
+)
+
+// Deadline returns the deadline of a request, the one requested by the caller is capped by
+// the execution timeout of the server, a zero requested deadline means the caller has none
+func Deadline(requested time.Time, max_timeout_seconds int) time.Time {
+	deadline := time.Now().Add(time.Duration(max_timeout_seconds) * time.Second)
+	if !requested.IsZero() && requested.Before(deadline) {
+		return requested
+	}
+	return deadline
+}
+
+// ParseDeadline parses the value of the deadline header, returns the zero time if it's missing or invalid
+func ParseDeadline(value string) time.Time {
+	milliseconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return unixMilliDeadline(milliseconds)
+}
+
+// ParseTimeout parses the value of the timeout header as a deadline from now,
+// returns the zero time if it's missing or invalid
+func ParseTimeout(value string) time.Time {
+	milliseconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return timeoutDeadline(milliseconds)
+}
+
+// unixMilliDeadline returns the deadline in milliseconds since the unix epoch, zero means none
+func unixMilliDeadline(milliseconds int64) time.Time {
+	if milliseconds <= 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(milliseconds)
+}
+
+// timeoutDeadline returns the deadline in milliseconds from now, zero means none
+func timeoutDeadline(milliseconds int64) time.Time {
+	if milliseconds <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(time.Duration(milliseconds) * time.Millisecond)
+}
+
+// earliestDeadline returns the earlier one of the deadlines, a zero deadline means none
+func earliestDeadline(a time.Time, b time.Time) time.Time {
+	if a.IsZero() || (!b.IsZero() && b.Before(a)) {
+		return b
+	}
+	return a
+}
+
+// FormatDeadline formats the deadline as the value of the deadline header
+func FormatDeadline(deadline time.Time) string {
+	return strconv.FormatInt(deadline.UnixMilli(), 10)
+}
+
+// FormatTimeout formats the time left until the deadline as the value of the timeout header,
+// a deadline which has passed is formatted as the shortest timeout instead of none
+func FormatTimeout(deadline time.Time) string {
+	return strconv.FormatInt(max(time.Until(deadline).Milliseconds(), 1), 10)
+}
+
+// requestDeadline returns the deadline of the http request
+func requestDeadline(ctx *gin.Context, max_timeout_seconds int) time.Time {
+	return Deadline(earliestDeadline(
+		ParseDeadline(ctx.GetHeader(DEADLINE_HEADER)),
+		ParseTimeout(ctx.GetHeader(TIMEOUT_HEADER)),
+	), max_timeout_seconds)
+}
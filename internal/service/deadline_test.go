@@ -0,0 +1,109 @@
+package service
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_daemon/access_types"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/requests"
+)
+
+func TestDeadlineIsCappedByTheExecutionTimeout(t *testing.T) {
+	now := time.Now()
+
+	// no deadline requested, the execution timeout applies
+	deadline := Deadline(time.Time{}, 10)
+	if deadline.Before(now.Add(10*time.Second)) || deadline.After(time.Now().Add(10*time.Second)) {
+		t.Errorf("expected the execution timeout, got %v", deadline.Sub(now))
+	}
+
+	// an earlier deadline shortens it
+	requested := now.Add(time.Second)
+	if deadline := Deadline(requested, 10); !deadline.Equal(requested) {
+		t.Errorf("expected the requested deadline, got %v", deadline.Sub(now))
+	}
+
+	// a later one could not extend it
+	if deadline := Deadline(now.Add(time.Hour), 10); deadline.After(time.Now().Add(10 * time.Second)) {
+		t.Errorf("expected the deadline to be capped, got %v", deadline.Sub(now))
+	}
+}
+
+func TestParseDeadline(t *testing.T) {
+	for _, value := range []string{"", "soon", "0", "-1"} {
+		if deadline := ParseDeadline(value); !deadline.IsZero() {
+			t.Errorf("expected no deadline for %q, got %v", value, deadline)
+		}
+		if deadline := ParseTimeout(value); !deadline.IsZero() {
+			t.Errorf("expected no timeout for %q, got %v", value, deadline)
+		}
+	}
+
+	requested := time.UnixMilli(time.Now().Add(time.Minute).UnixMilli())
+	if deadline := ParseDeadline(FormatDeadline(requested)); !deadline.Equal(requested) {
+		t.Errorf("expected %v, got %v", requested, deadline)
+	}
+
+	before := time.Now()
+	deadline := ParseTimeout("1500")
+	if deadline.Before(before.Add(1500*time.Millisecond)) || deadline.After(time.Now().Add(1500*time.Millisecond)) {
+		t.Errorf("expected the timeout from now, got %v", deadline.Sub(before))
+	}
+
+	// a deadline which has passed stays passed once it's sent as a timeout
+	if timeout := FormatTimeout(time.Now().Add(-time.Second)); timeout != "1" {
+		t.Errorf("expected the shortest timeout, got %s", timeout)
+	}
+}
+
+func TestRequestDeadlineUsesTheEarlierHeader(t *testing.T) {
+	newContext := func(header http.Header) *gin.Context {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/", nil)
+		ctx.Request.Header = header
+		return ctx
+	}
+
+	now := time.Now()
+	deadline := requestDeadline(newContext(http.Header{
+		DEADLINE_HEADER: {FormatDeadline(now.Add(time.Hour))},
+		TIMEOUT_HEADER:  {"2000"},
+	}), 60)
+	if deadline.Before(now.Add(2*time.Second)) || deadline.After(time.Now().Add(2*time.Second)) {
+		t.Errorf("expected the timeout to be used, got %v", deadline.Sub(now))
+	}
+
+	requested := time.UnixMilli(now.Add(time.Second).UnixMilli())
+	deadline = requestDeadline(newContext(http.Header{
+		DEADLINE_HEADER: {FormatDeadline(requested)},
+		TIMEOUT_HEADER:  {strconv.Itoa(30 * 1000)},
+	}), 60)
+	if !deadline.Equal(requested) {
+		t.Errorf("expected the deadline to be used, got %v", deadline.Sub(now))
+	}
+}
+
+func TestCreateSessionRejectsExceededDeadline(t *testing.T) {
+	request := &plugin_entities.InvokePluginRequest[requests.RequestInvokeLLM]{}
+
+	_, err := createSession(
+		request,
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_INVOKE_LLM,
+		"",
+		time.Now().Add(-time.Millisecond),
+	)
+	if err != ErrDeadlineExceeded {
+		t.Fatalf("expected the deadline to be exceeded, got %v", err)
+	}
+
+	status, response := sessionError(err)
+	if status != http.StatusGatewayTimeout || response.ToResponse().Code != -504 {
+		t.Errorf("unexpected response: %d %+v", status, response.ToResponse())
+	}
+}
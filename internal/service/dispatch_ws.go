@@ -0,0 +1,430 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_daemon"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_daemon/access_types"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/session_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/exception"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/validators"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/parser"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/routine"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/stream"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	// sent by the client
+	WS_MESSAGE_TYPE_INVOKE = "invoke"
+	WS_MESSAGE_TYPE_INPUT  = "input"
+	WS_MESSAGE_TYPE_CANCEL = "cancel"
+
+	// sent by the daemon
+	WS_MESSAGE_TYPE_DATA = "data"
+	WS_MESSAGE_TYPE_END  = "end"
+)
+
+// wsMessage is a message of the websocket dispatch protocol, sessions multiplexed
+// over a connection are told apart by the id chosen by the client
+//
+// an `invoke` message carries the path of a dispatch route, like `llm/invoke`, and the body
+// of the http request in `data`, `data` messages of the daemon carry what the route streams
+// over SSE, an `end` message closes the session
+//
+// `deadline` and `timeout` of an `invoke` message work like the deadline and timeout headers of the http api,
+// messages are json in text or binary frames, the daemon sends text frames,
+// `input` is only accepted by sessions of plugins which are not serverless
+type wsMessage struct {
+	Type     string          `json:"type"`
+	ID       string          `json:"id"`
+	Path     string          `json:"path,omitempty"`
+	Deadline int64           `json:"deadline,omitempty"`
+	Timeout  int64           `json:"timeout,omitempty"`
+	Data     json.RawMessage `json:"data,omitempty"`
+}
+
+// wsDispatchRoute starts a session of the message
+type wsDispatchRoute func(conn *wsDispatchConn, message wsMessage) exception.PluginDaemonError
+
+// wsDispatchRoutes are the dispatch apis served over websocket, keyed by their path under `/dispatch`
+var wsDispatchRoutes = map[string]wsDispatchRoute{
+	"tool/invoke": wsRoute(
+		access_types.PLUGIN_ACCESS_TYPE_TOOL,
+		access_types.PLUGIN_ACCESS_ACTION_INVOKE_TOOL,
+		plugin_daemon.InvokeTool,
+	),
+	"tool/validate_credentials": wsRoute(
+		access_types.PLUGIN_ACCESS_TYPE_TOOL,
+		access_types.PLUGIN_ACCESS_ACTION_VALIDATE_TOOL_CREDENTIALS,
+		plugin_daemon.ValidateToolCredentials,
+	),
+	"tool/get_runtime_parameters": wsRoute(
+		access_types.PLUGIN_ACCESS_TYPE_TOOL,
+		access_types.PLUGIN_ACCESS_ACTION_GET_TOOL_RUNTIME_PARAMETERS,
+		plugin_daemon.GetToolRuntimeParameters,
+	),
+	"agent_strategy/invoke": wsRoute(
+		access_types.PLUGIN_ACCESS_TYPE_AGENT_STRATEGY,
+		access_types.PLUGIN_ACCESS_ACTION_INVOKE_AGENT_STRATEGY,
+		plugin_daemon.InvokeAgentStrategy,
+	),
+	"llm/invoke": wsRoute(
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_INVOKE_LLM,
+		plugin_daemon.InvokeLLM,
+	),
+	"llm/num_tokens": wsRoute(
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_GET_LLM_NUM_TOKENS,
+		plugin_daemon.GetLLMNumTokens,
+	),
+	"text_embedding/invoke": wsRoute(
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_INVOKE_TEXT_EMBEDDING,
+		plugin_daemon.InvokeTextEmbedding,
+	),
+	"text_embedding/num_tokens": wsRoute(
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_GET_TEXT_EMBEDDING_NUM_TOKENS,
+		plugin_daemon.GetTextEmbeddingNumTokens,
+	),
+	"rerank/invoke": wsRoute(
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_INVOKE_RERANK,
+		plugin_daemon.InvokeRerank,
+	),
+	"tts/invoke": wsRoute(
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_INVOKE_TTS,
+		plugin_daemon.InvokeTTS,
+	),
+	"tts/model/voices": wsRoute(
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_GET_TTS_MODEL_VOICES,
+		plugin_daemon.GetTTSModelVoices,
+	),
+	"speech2text/invoke": wsRoute(
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_INVOKE_SPEECH2TEXT,
+		plugin_daemon.InvokeSpeech2Text,
+	),
+	"moderation/invoke": wsRoute(
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_INVOKE_MODERATION,
+		plugin_daemon.InvokeModeration,
+	),
+	"model/validate_provider_credentials": wsRoute(
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_VALIDATE_PROVIDER_CREDENTIALS,
+		plugin_daemon.ValidateProviderCredentials,
+	),
+	"model/validate_model_credentials": wsRoute(
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_VALIDATE_MODEL_CREDENTIALS,
+		plugin_daemon.ValidateModelCredentials,
+	),
+	"model/schema": wsRoute(
+		access_types.PLUGIN_ACCESS_TYPE_MODEL,
+		access_types.PLUGIN_ACCESS_ACTION_GET_AI_MODEL_SCHEMAS,
+		plugin_daemon.GetAIModelSchema,
+	),
+}
+
+// wsSession is a session in flight on a connection
+type wsSession struct {
+	session *session_manager.Session
+	// stops the session, the plugin is told to cancel it if it's not finished yet
+	close func()
+}
+
+type wsDispatchConn struct {
+	conn *websocket.Conn
+
+	tenantId            string
+	clusterId           string
+	identity            plugin_entities.PluginUniqueIdentifier
+	max_timeout_seconds int
+
+	writeLock sync.Mutex
+
+	sessions    map[string]*wsSession
+	sessionLock sync.Mutex
+}
+
+// DispatchWebSocket serves dispatch sessions of the plugin over a websocket connection, a client could
+// run several sessions at once, send follow-up input to them and cancel them, see wsMessage
+func DispatchWebSocket(
+	ctx *gin.Context,
+	identity plugin_entities.PluginUniqueIdentifier,
+	max_timeout_seconds int,
+) {
+	server := websocket.Server{
+		// clients are authorized by the api key rather than the origin
+		Handshake: func(*websocket.Config, *http.Request) error {
+			return nil
+		},
+		Handler: func(conn *websocket.Conn) {
+			c := &wsDispatchConn{
+				conn:                conn,
+				tenantId:            ctx.Param("tenant_id"),
+				clusterId:           ctx.GetString("cluster_id"),
+				identity:            identity,
+				max_timeout_seconds: max_timeout_seconds,
+				sessions:            map[string]*wsSession{},
+			}
+			c.serve()
+		},
+	}
+
+	server.ServeHTTP(ctx.Writer, ctx.Request)
+}
+
+func (c *wsDispatchConn) serve() {
+	// sessions are cancelled once the client is gone
+	defer c.closeAll()
+
+	for {
+		var message wsMessage
+		if err := websocket.JSON.Receive(c.conn, &message); err != nil {
+			var syntaxError *json.SyntaxError
+			var typeError *json.UnmarshalTypeError
+			if errors.As(err, &syntaxError) || errors.As(err, &typeError) {
+				c.fail("", exception.BadRequestError(err).ToResponse())
+				continue
+			}
+			return
+		}
+
+		c.handle(message)
+	}
+}
+
+func (c *wsDispatchConn) handle(message wsMessage) {
+	if message.ID == "" {
+		c.fail("", exception.BadRequestError(errors.New("id is required")).ToResponse())
+		return
+	}
+
+	switch message.Type {
+	case WS_MESSAGE_TYPE_INVOKE:
+		route, ok := wsDispatchRoutes[message.Path]
+		if !ok {
+			c.fail(message.ID, exception.NotFoundError(fmt.Errorf("unknown path: %s", message.Path)).ToResponse())
+			return
+		}
+
+		if !c.reserve(message.ID) {
+			c.fail(message.ID, exception.BadRequestError(fmt.Errorf("session %s is in flight", message.ID)).ToResponse())
+			return
+		}
+
+		if err := route(c, message); err != nil {
+			c.forget(message.ID)
+			c.fail(message.ID, err.ToResponse())
+		}
+	case WS_MESSAGE_TYPE_INPUT:
+		session := c.session(message.ID)
+		if session == nil {
+			c.fail(message.ID, exception.NotFoundError(fmt.Errorf("session %s not found", message.ID)).ToResponse())
+			return
+		}
+
+		// every write to a serverless plugin is a new request rather than a message of the session
+		if runtime := session.session.Runtime(); runtime != nil && runtime.Type() == plugin_entities.PLUGIN_RUNTIME_TYPE_AWS {
+			c.send(wsMessage{
+				Type: WS_MESSAGE_TYPE_DATA,
+				ID:   message.ID,
+				Data: parser.MarshalJsonBytes(
+					exception.BadRequestError(errors.New("serverless plugins do not accept input")).ToResponse(),
+				),
+			})
+			return
+		}
+
+		if err := session.session.Write(session_manager.PLUGIN_IN_STREAM_EVENT_INPUT, message.Data); err != nil {
+			c.send(wsMessage{
+				Type: WS_MESSAGE_TYPE_DATA,
+				ID:   message.ID,
+				Data: parser.MarshalJsonBytes(exception.InternalServerError(err).ToResponse()),
+			})
+		}
+	case WS_MESSAGE_TYPE_CANCEL:
+		// the session ends with an `end` message once the plugin is cancelled
+		if session := c.session(message.ID); session != nil {
+			session.close()
+		}
+	default:
+		c.fail(message.ID, exception.BadRequestError(fmt.Errorf("unknown message type: %s", message.Type)).ToResponse())
+	}
+}
+
+// wsRoute binds the request of a dispatch api and streams the response of the plugin back,
+// like the http controllers and baseSSEService do
+func wsRoute[Req any, Rsp any](
+	access_type access_types.PluginAccessType,
+	access_action access_types.PluginAccessAction,
+	invoke func(*session_manager.Session, *Req) (*stream.Stream[Rsp], error),
+) wsDispatchRoute {
+	return func(c *wsDispatchConn, message wsMessage) exception.PluginDaemonError {
+		var r plugin_entities.InvokePluginRequest[Req]
+		if err := json.Unmarshal(message.Data, &r); err != nil {
+			return exception.BadRequestError(err)
+		}
+
+		r.TenantId = c.tenantId
+		r.UniqueIdentifier = c.identity
+		if err := validators.GlobalEntitiesValidator.Struct(r); err != nil {
+			return exception.BadRequestError(err)
+		}
+
+		deadline := Deadline(earliestDeadline(
+			unixMilliDeadline(message.Deadline), timeoutDeadline(message.Timeout),
+		), c.max_timeout_seconds)
+
+		session, err := createSession(&r, access_type, access_action, c.clusterId, deadline)
+		if err != nil {
+			_, err := sessionError(err)
+			return err
+		}
+
+		response, err := invoke(session, &r.Data)
+		if err != nil {
+			session.Close(session_manager.CloseSessionPayload{
+				IgnoreCache: false,
+			})
+			return exception.InternalServerError(err)
+		}
+
+		c.attach(message.ID, &wsSession{
+			session: session,
+			close:   response.Close,
+		})
+
+		routine.Submit(map[string]string{
+			"module":     "service",
+			"function":   "wsRoute",
+			"session_id": session.ID,
+		}, func() {
+			defer session.Close(session_manager.CloseSessionPayload{
+				IgnoreCache: false,
+			})
+			defer c.forget(message.ID)
+
+			timeout := new(atomic.Bool)
+			timer := time.AfterFunc(time.Until(deadline), func() {
+				timeout.Store(true)
+				response.Close()
+			})
+			defer timer.Stop()
+
+			for response.Next() {
+				chunk, err := response.Read()
+				if err != nil {
+					c.send(wsMessage{
+						Type: WS_MESSAGE_TYPE_DATA,
+						ID:   message.ID,
+						Data: parser.MarshalJsonBytes(exception.InvokePluginError(err).ToResponse()),
+					})
+					break
+				}
+
+				c.send(wsMessage{
+					Type: WS_MESSAGE_TYPE_DATA,
+					ID:   message.ID,
+					Data: parser.MarshalJsonBytes(entities.NewSuccessResponse(chunk)),
+				})
+			}
+
+			if timeout.Load() {
+				c.send(wsMessage{
+					Type: WS_MESSAGE_TYPE_DATA,
+					ID:   message.ID,
+					Data: parser.MarshalJsonBytes(exception.DeadlineExceededError(errors.New("killed by timeout")).ToResponse()),
+				})
+			}
+
+			c.send(wsMessage{Type: WS_MESSAGE_TYPE_END, ID: message.ID})
+		})
+
+		return nil
+	}
+}
+
+// send writes a message to the client, messages of sessions are interleaved
+func (c *wsDispatchConn) send(message wsMessage) {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	if err := websocket.JSON.Send(c.conn, message); err != nil {
+		// the client is gone, sessions are cancelled by serve
+		log.Debug("failed to send websocket message of session %s: %s", message.ID, err.Error())
+	}
+}
+
+// fail ends a session which could not be started
+func (c *wsDispatchConn) fail(id string, response *entities.Response) {
+	c.send(wsMessage{
+		Type: WS_MESSAGE_TYPE_DATA,
+		ID:   id,
+		Data: parser.MarshalJsonBytes(response),
+	})
+	c.send(wsMessage{Type: WS_MESSAGE_TYPE_END, ID: id})
+}
+
+// reserve takes the id for a new session, returns false if it's in flight
+func (c *wsDispatchConn) reserve(id string) bool {
+	c.sessionLock.Lock()
+	defer c.sessionLock.Unlock()
+
+	if _, ok := c.sessions[id]; ok {
+		return false
+	}
+
+	c.sessions[id] = nil
+	return true
+}
+
+func (c *wsDispatchConn) attach(id string, session *wsSession) {
+	c.sessionLock.Lock()
+	defer c.sessionLock.Unlock()
+	c.sessions[id] = session
+}
+
+// session returns the session in flight, nil if it's not found or not started yet
+func (c *wsDispatchConn) session(id string) *wsSession {
+	c.sessionLock.Lock()
+	defer c.sessionLock.Unlock()
+	return c.sessions[id]
+}
+
+func (c *wsDispatchConn) forget(id string) {
+	c.sessionLock.Lock()
+	defer c.sessionLock.Unlock()
+	delete(c.sessions, id)
+}
+
+func (c *wsDispatchConn) closeAll() {
+	c.sessionLock.Lock()
+	sessions := make([]*wsSession, 0, len(c.sessions))
+	for _, session := range c.sessions {
+		if session != nil {
+			sessions = append(sessions, session)
+		}
+	}
+	c.sessionLock.Unlock()
+
+	for _, session := range sessions {
+		session.close()
+	}
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/session_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"golang.org/x/net/websocket"
+)
+
+// wsTestRuntime records what is written to the plugin
+type wsTestRuntime struct {
+	plugin_entities.PluginLifetime
+	runtimeType plugin_entities.PluginRuntimeType
+	writes      chan []byte
+}
+
+func (r *wsTestRuntime) Type() plugin_entities.PluginRuntimeType {
+	return r.runtimeType
+}
+
+func (r *wsTestRuntime) Write(session_id string, data []byte) {
+	r.writes <- data
+}
+
+func newWSTestSession(t *testing.T, runtimeType plugin_entities.PluginRuntimeType) (*wsSession, *wsTestRuntime, chan bool) {
+	runtime := &wsTestRuntime{runtimeType: runtimeType, writes: make(chan []byte, 8)}
+	session := session_manager.NewSession(session_manager.NewSessionPayload{IgnoreCache: true})
+	session.BindRuntime(runtime)
+	t.Cleanup(func() {
+		session.Close(session_manager.CloseSessionPayload{IgnoreCache: true})
+	})
+
+	closed := make(chan bool, 1)
+	return &wsSession{session: session, close: func() { closed <- true }}, runtime, closed
+}
+
+func dialWSTestConn(t *testing.T, sessions map[string]*wsSession) *websocket.Conn {
+	server := httptest.NewServer(websocket.Handler(func(conn *websocket.Conn) {
+		c := &wsDispatchConn{conn: conn, max_timeout_seconds: 60, sessions: map[string]*wsSession{}}
+		for id, session := range sessions {
+			c.attach(id, session)
+		}
+		c.serve()
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := websocket.Dial("ws"+strings.TrimPrefix(server.URL, "http"), "", server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func receiveWSTestMessage(t *testing.T, client *websocket.Conn) wsMessage {
+	client.SetReadDeadline(time.Now().Add(5 * time.Second))
+	var message wsMessage
+	if err := websocket.JSON.Receive(client, &message); err != nil {
+		t.Fatal(err)
+	}
+	return message
+}
+
+func TestDispatchWebSocketRejectsInvalidMessages(t *testing.T) {
+	client := dialWSTestConn(t, nil)
+
+	for _, request := range []wsMessage{
+		{Type: WS_MESSAGE_TYPE_INPUT},
+		{Type: WS_MESSAGE_TYPE_INVOKE, ID: "1", Path: "unknown/invoke"},
+		{Type: WS_MESSAGE_TYPE_INPUT, ID: "2", Data: []byte(`{}`)},
+		{Type: "unknown", ID: "3"},
+	} {
+		if err := websocket.JSON.Send(client, request); err != nil {
+			t.Fatal(err)
+		}
+
+		failure := receiveWSTestMessage(t, client)
+		if failure.Type != WS_MESSAGE_TYPE_DATA || failure.ID != request.ID || !strings.Contains(string(failure.Data), "error") {
+			t.Fatalf("expected an error of %+v, got %+v", request, failure)
+		}
+		if end := receiveWSTestMessage(t, client); end.Type != WS_MESSAGE_TYPE_END || end.ID != request.ID {
+			t.Fatalf("expected the session of %+v to end, got %+v", request, end)
+		}
+	}
+}
+
+func TestDispatchWebSocketInput(t *testing.T) {
+	local, localRuntime, localClosed := newWSTestSession(t, plugin_entities.PLUGIN_RUNTIME_TYPE_LOCAL)
+	serverless, serverlessRuntime, _ := newWSTestSession(t, plugin_entities.PLUGIN_RUNTIME_TYPE_AWS)
+	client := dialWSTestConn(t, map[string]*wsSession{"local": local, "serverless": serverless})
+
+	// input of a session in flight is handed to the plugin
+	if err := websocket.JSON.Send(client, wsMessage{Type: WS_MESSAGE_TYPE_INPUT, ID: "local", Data: []byte(`{"chunk":1}`)}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case data := <-localRuntime.writes:
+		if !strings.Contains(string(data), `"event":"input"`) || !strings.Contains(string(data), `{"chunk":1}`) {
+			t.Fatalf("expected an input event, got %s", data)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the input to be written to the plugin")
+	}
+
+	// a write to a serverless plugin would start a new request
+	if err := websocket.JSON.Send(client, wsMessage{Type: WS_MESSAGE_TYPE_INPUT, ID: "serverless", Data: []byte(`{}`)}); err != nil {
+		t.Fatal(err)
+	}
+	rejected := receiveWSTestMessage(t, client)
+	if rejected.ID != "serverless" || !strings.Contains(string(rejected.Data), "serverless plugins do not accept input") {
+		t.Fatalf("expected the input to be rejected, got %+v", rejected)
+	}
+	select {
+	case data := <-serverlessRuntime.writes:
+		t.Fatalf("expected nothing to be written to a serverless plugin, got %s", data)
+	default:
+	}
+
+	// binary frames carrying json are accepted as well
+	if err := websocket.Message.Send(client, []byte(`{"type":"cancel","id":"local"}`)); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-localClosed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the session to be cancelled")
+	}
+}
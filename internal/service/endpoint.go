@@ -15,6 +15,7 @@ import (
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_daemon"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_daemon/access_types"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/plugin_errors"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/session_manager"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/db"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/service/install_service"
@@ -32,12 +33,15 @@ func Endpoint(
 	endpoint *models.Endpoint,
 	pluginInstallation *models.PluginInstallation,
 	path string,
+	max_timeout_seconds int,
 ) {
 	if !endpoint.Enabled {
 		ctx.JSON(404, exception.NotFoundError(errors.New("endpoint not found")).ToResponse())
 		return
 	}
 
+	deadline := requestDeadline(ctx, max_timeout_seconds)
+
 	req := ctx.Request.Clone(context.Background())
 	req.URL.Path = path
 
@@ -80,6 +84,10 @@ func Endpoint(
 	// fetch plugin
 	manager := plugin_manager.Manager()
 	runtime, err := manager.Get(identifier)
+	if err == plugin_errors.ErrPluginCrashLoop || err == plugin_errors.ErrPluginDraining {
+		ctx.JSON(503, exception.InternalServerError(err).ToResponse())
+		return
+	}
 	if err != nil {
 		ctx.JSON(404, exception.ErrPluginNotFound().ToResponse())
 		return
@@ -113,6 +121,17 @@ func Endpoint(
 		return
 	}
 
+	if !time.Now().Before(deadline) {
+		ctx.JSON(504, exception.DeadlineExceededError(ErrDeadlineExceeded).ToResponse())
+		return
+	}
+
+	environment, err := manager.TenantEnvironment(runtime, endpoint.TenantID)
+	if err != nil {
+		ctx.JSON(500, exception.InternalServerError(err).ToResponse())
+		return
+	}
+
 	session := session_manager.NewSession(
 		session_manager.NewSessionPayload{
 			TenantID:               endpoint.TenantID,
@@ -125,6 +144,8 @@ func Endpoint(
 			BackwardsInvocation:    manager.BackwardsInvocation(),
 			IgnoreCache:            false,
 			EndpointID:             &endpoint.ID,
+			Deadline:               deadline,
+			Environment:            environment,
 		},
 	)
 	defer session.Close(session_manager.CloseSessionPayload{
@@ -182,8 +203,8 @@ func Endpoint(
 	select {
 	case <-ctx.Writer.CloseNotify():
 	case <-done:
-	case <-time.After(240 * time.Second):
-		ctx.JSON(500, exception.InternalServerError(errors.New("killed by timeout")).ToResponse())
+	case <-time.After(time.Until(deadline)):
+		ctx.JSON(504, exception.DeadlineExceededError(errors.New("killed by timeout")).ToResponse())
 	}
 }
 
@@ -37,6 +37,7 @@ func InstallPluginRuntimeToTenant(
 	plugin_unique_identifiers []plugin_entities.PluginUniqueIdentifier,
 	source string,
 	meta map[string]any,
+	wait_for_ready bool, // local plugins are installed once they are ready rather than launched
 	onDone InstallPluginOnDoneHandler, // since installing plugin is a async task, we need to call it asynchronously
 ) (*InstallPluginResponse, error) {
 	response := &InstallPluginResponse{}
@@ -215,7 +216,7 @@ func InstallPluginRuntimeToTenant(
 				}
 				stream, err = manager.InstallToAWSFromPkg(zipDecoder, source, meta)
 			} else if config.Platform == app.PLATFORM_LOCAL {
-				stream, err = manager.InstallToLocal(pluginUniqueIdentifier, source, meta)
+				stream, err = manager.InstallToLocal(pluginUniqueIdentifier, source, meta, wait_for_ready)
 			} else {
 				updateTaskStatus(func(task *models.InstallTask, plugin *models.InstallTaskPluginStatus) {
 					task.Status = models.InstallTaskStatusFailed
@@ -234,6 +235,7 @@ func InstallPluginRuntimeToTenant(
 				return
 			}
 
+			installed := false
 			for stream.Next() {
 				message, err := stream.Read()
 				if err != nil {
@@ -245,6 +247,13 @@ func InstallPluginRuntimeToTenant(
 					return
 				}
 
+				if message.Event == plugin_manager.PluginInstallEventStage {
+					updateTaskStatus(func(task *models.InstallTask, plugin *models.InstallTaskPluginStatus) {
+						plugin.Stage = message.Data
+					})
+					continue
+				}
+
 				if message.Event == plugin_manager.PluginInstallEventError {
 					updateTaskStatus(func(task *models.InstallTask, plugin *models.InstallTaskPluginStatus) {
 						task.Status = models.InstallTaskStatusFailed
@@ -263,9 +272,19 @@ func InstallPluginRuntimeToTenant(
 						})
 						return
 					}
+					installed = true
 				}
 			}
 
+			if !installed {
+				updateTaskStatus(func(task *models.InstallTask, plugin *models.InstallTaskPluginStatus) {
+					task.Status = models.InstallTaskStatusFailed
+					plugin.Status = models.InstallTaskStatusFailed
+					plugin.Message = "Installation ended before the plugin was installed"
+				})
+				return
+			}
+
 			updateTaskStatus(func(task *models.InstallTask, plugin *models.InstallTaskPluginStatus) {
 				plugin.Status = models.InstallTaskStatusSuccess
 				plugin.Message = "Installed"
@@ -291,6 +310,7 @@ func InstallPluginFromIdentifiers(
 	plugin_unique_identifiers []plugin_entities.PluginUniqueIdentifier,
 	source string,
 	meta map[string]any,
+	wait_for_ready bool,
 ) *entities.Response {
 	response, err := InstallPluginRuntimeToTenant(
 		config,
@@ -298,6 +318,7 @@ func InstallPluginFromIdentifiers(
 		plugin_unique_identifiers,
 		source,
 		meta,
+		wait_for_ready,
 		func(
 			pluginUniqueIdentifier plugin_entities.PluginUniqueIdentifier,
 			declaration *plugin_entities.PluginDeclaration,
@@ -341,6 +362,7 @@ func UpgradePlugin(
 	meta map[string]any,
 	original_plugin_unique_identifier plugin_entities.PluginUniqueIdentifier,
 	new_plugin_unique_identifier plugin_entities.PluginUniqueIdentifier,
+	wait_for_ready bool,
 ) *entities.Response {
 	if original_plugin_unique_identifier == new_plugin_unique_identifier {
 		return exception.BadRequestError(errors.New("original and new plugin unique identifier are the same")).ToResponse()
@@ -372,6 +394,7 @@ func UpgradePlugin(
 		[]plugin_entities.PluginUniqueIdentifier{new_plugin_unique_identifier},
 		source,
 		meta,
+		wait_for_ready,
 		func(
 			pluginUniqueIdentifier plugin_entities.PluginUniqueIdentifier,
 			declaration *plugin_entities.PluginDeclaration,
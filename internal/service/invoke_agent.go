@@ -8,7 +8,6 @@ import (
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/agent_entities"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/requests"
-	"github.com/mlchain/mlchain-plugin-daemon/internal/types/exception"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/stream"
 )
 
@@ -17,15 +16,19 @@ func InvokeAgentStrategy(
 	ctx *gin.Context,
 	max_timeout_seconds int,
 ) {
+	deadline := requestDeadline(ctx, max_timeout_seconds)
+
 	// create session
 	session, err := createSession(
 		r,
 		access_types.PLUGIN_ACCESS_TYPE_AGENT_STRATEGY,
 		access_types.PLUGIN_ACCESS_ACTION_INVOKE_AGENT_STRATEGY,
 		ctx.GetString("cluster_id"),
+		deadline,
 	)
 	if err != nil {
-		ctx.JSON(500, exception.InternalServerError(err).ToResponse())
+		status, err := sessionError(err)
+		ctx.JSON(status, err.ToResponse())
 		return
 	}
 	defer session.Close(session_manager.CloseSessionPayload{
@@ -37,6 +40,6 @@ func InvokeAgentStrategy(
 			return plugin_daemon.InvokeAgentStrategy(session, &r.Data)
 		},
 		ctx,
-		max_timeout_seconds,
+		deadline,
 	)
 }
@@ -8,7 +8,6 @@ import (
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/model_entities"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/requests"
-	"github.com/mlchain/mlchain-plugin-daemon/internal/types/exception"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/stream"
 )
 
@@ -17,15 +16,19 @@ func InvokeLLM(
 	ctx *gin.Context,
 	max_timeout_seconds int,
 ) {
+	deadline := requestDeadline(ctx, max_timeout_seconds)
+
 	// create session
 	session, err := createSession(
 		r,
 		access_types.PLUGIN_ACCESS_TYPE_MODEL,
 		access_types.PLUGIN_ACCESS_ACTION_INVOKE_LLM,
 		ctx.GetString("cluster_id"),
+		deadline,
 	)
 	if err != nil {
-		ctx.JSON(500, exception.InternalServerError(err).ToResponse())
+		status, err := sessionError(err)
+		ctx.JSON(status, err.ToResponse())
 		return
 	}
 	defer session.Close(session_manager.CloseSessionPayload{
@@ -37,7 +40,7 @@ func InvokeLLM(
 			return plugin_daemon.InvokeLLM(session, &r.Data)
 		},
 		ctx,
-		max_timeout_seconds,
+		deadline,
 	)
 }
 
@@ -46,14 +49,19 @@ func InvokeTextEmbedding(
 	ctx *gin.Context,
 	max_timeout_seconds int,
 ) {
+	deadline := requestDeadline(ctx, max_timeout_seconds)
+
 	// create session
 	session, err := createSession(
 		r,
 		access_types.PLUGIN_ACCESS_TYPE_MODEL,
 		access_types.PLUGIN_ACCESS_ACTION_INVOKE_TEXT_EMBEDDING,
-		ctx.GetString("cluster_id"))
+		ctx.GetString("cluster_id"),
+		deadline,
+	)
 	if err != nil {
-		ctx.JSON(500, exception.InternalServerError(err).ToResponse())
+		status, err := sessionError(err)
+		ctx.JSON(status, err.ToResponse())
 		return
 	}
 	defer session.Close(session_manager.CloseSessionPayload{
@@ -65,7 +73,7 @@ func InvokeTextEmbedding(
 			return plugin_daemon.InvokeTextEmbedding(session, &r.Data)
 		},
 		ctx,
-		max_timeout_seconds,
+		deadline,
 	)
 }
 
@@ -74,15 +82,19 @@ func InvokeRerank(
 	ctx *gin.Context,
 	max_timeout_seconds int,
 ) {
+	deadline := requestDeadline(ctx, max_timeout_seconds)
+
 	// create session
 	session, err := createSession(
 		r,
 		access_types.PLUGIN_ACCESS_TYPE_MODEL,
 		access_types.PLUGIN_ACCESS_ACTION_INVOKE_RERANK,
 		ctx.GetString("cluster_id"),
+		deadline,
 	)
 	if err != nil {
-		ctx.JSON(500, exception.InternalServerError(err).ToResponse())
+		status, err := sessionError(err)
+		ctx.JSON(status, err.ToResponse())
 		return
 	}
 	defer session.Close(session_manager.CloseSessionPayload{
@@ -94,7 +106,7 @@ func InvokeRerank(
 			return plugin_daemon.InvokeRerank(session, &r.Data)
 		},
 		ctx,
-		max_timeout_seconds,
+		deadline,
 	)
 }
 
@@ -103,15 +115,19 @@ func InvokeTTS(
 	ctx *gin.Context,
 	max_timeout_seconds int,
 ) {
+	deadline := requestDeadline(ctx, max_timeout_seconds)
+
 	// create session
 	session, err := createSession(
 		r,
 		access_types.PLUGIN_ACCESS_TYPE_MODEL,
 		access_types.PLUGIN_ACCESS_ACTION_INVOKE_TTS,
 		ctx.GetString("cluster_id"),
+		deadline,
 	)
 	if err != nil {
-		ctx.JSON(500, exception.InternalServerError(err).ToResponse())
+		status, err := sessionError(err)
+		ctx.JSON(status, err.ToResponse())
 		return
 	}
 	defer session.Close(session_manager.CloseSessionPayload{
@@ -123,7 +139,7 @@ func InvokeTTS(
 			return plugin_daemon.InvokeTTS(session, &r.Data)
 		},
 		ctx,
-		max_timeout_seconds,
+		deadline,
 	)
 }
 
@@ -132,15 +148,19 @@ func InvokeSpeech2Text(
 	ctx *gin.Context,
 	max_timeout_seconds int,
 ) {
+	deadline := requestDeadline(ctx, max_timeout_seconds)
+
 	// create session
 	session, err := createSession(
 		r,
 		access_types.PLUGIN_ACCESS_TYPE_MODEL,
 		access_types.PLUGIN_ACCESS_ACTION_INVOKE_SPEECH2TEXT,
 		ctx.GetString("cluster_id"),
+		deadline,
 	)
 	if err != nil {
-		ctx.JSON(500, exception.InternalServerError(err).ToResponse())
+		status, err := sessionError(err)
+		ctx.JSON(status, err.ToResponse())
 		return
 	}
 	defer session.Close(session_manager.CloseSessionPayload{
@@ -152,7 +172,7 @@ func InvokeSpeech2Text(
 			return plugin_daemon.InvokeSpeech2Text(session, &r.Data)
 		},
 		ctx,
-		max_timeout_seconds,
+		deadline,
 	)
 }
 
@@ -161,15 +181,19 @@ func InvokeModeration(
 	ctx *gin.Context,
 	max_timeout_seconds int,
 ) {
+	deadline := requestDeadline(ctx, max_timeout_seconds)
+
 	// create session
 	session, err := createSession(
 		r,
 		access_types.PLUGIN_ACCESS_TYPE_MODEL,
 		access_types.PLUGIN_ACCESS_ACTION_INVOKE_MODERATION,
 		ctx.GetString("cluster_id"),
+		deadline,
 	)
 	if err != nil {
-		ctx.JSON(500, exception.InternalServerError(err).ToResponse())
+		status, err := sessionError(err)
+		ctx.JSON(status, err.ToResponse())
 		return
 	}
 	defer session.Close(session_manager.CloseSessionPayload{
@@ -181,7 +205,7 @@ func InvokeModeration(
 			return plugin_daemon.InvokeModeration(session, &r.Data)
 		},
 		ctx,
-		max_timeout_seconds,
+		deadline,
 	)
 }
 
@@ -190,15 +214,19 @@ func ValidateProviderCredentials(
 	ctx *gin.Context,
 	max_timeout_seconds int,
 ) {
+	deadline := requestDeadline(ctx, max_timeout_seconds)
+
 	// create session
 	session, err := createSession(
 		r,
 		access_types.PLUGIN_ACCESS_TYPE_MODEL,
 		access_types.PLUGIN_ACCESS_ACTION_VALIDATE_PROVIDER_CREDENTIALS,
 		ctx.GetString("cluster_id"),
+		deadline,
 	)
 	if err != nil {
-		ctx.JSON(500, exception.InternalServerError(err).ToResponse())
+		status, err := sessionError(err)
+		ctx.JSON(status, err.ToResponse())
 		return
 	}
 	defer session.Close(session_manager.CloseSessionPayload{
@@ -210,7 +238,7 @@ func ValidateProviderCredentials(
 			return plugin_daemon.ValidateProviderCredentials(session, &r.Data)
 		},
 		ctx,
-		max_timeout_seconds,
+		deadline,
 	)
 }
 
@@ -219,15 +247,19 @@ func ValidateModelCredentials(
 	ctx *gin.Context,
 	max_timeout_seconds int,
 ) {
+	deadline := requestDeadline(ctx, max_timeout_seconds)
+
 	// create session
 	session, err := createSession(
 		r,
 		access_types.PLUGIN_ACCESS_TYPE_MODEL,
 		access_types.PLUGIN_ACCESS_ACTION_VALIDATE_MODEL_CREDENTIALS,
 		ctx.GetString("cluster_id"),
+		deadline,
 	)
 	if err != nil {
-		ctx.JSON(500, exception.InternalServerError(err).ToResponse())
+		status, err := sessionError(err)
+		ctx.JSON(status, err.ToResponse())
 		return
 	}
 	defer session.Close(session_manager.CloseSessionPayload{
@@ -239,7 +271,7 @@ func ValidateModelCredentials(
 			return plugin_daemon.ValidateModelCredentials(session, &r.Data)
 		},
 		ctx,
-		max_timeout_seconds,
+		deadline,
 	)
 }
 
@@ -248,14 +280,18 @@ func GetTTSModelVoices(
 	ctx *gin.Context,
 	max_timeout_seconds int,
 ) {
+	deadline := requestDeadline(ctx, max_timeout_seconds)
+
 	session, err := createSession(
 		r,
 		access_types.PLUGIN_ACCESS_TYPE_MODEL,
 		access_types.PLUGIN_ACCESS_ACTION_GET_TTS_MODEL_VOICES,
 		ctx.GetString("cluster_id"),
+		deadline,
 	)
 	if err != nil {
-		ctx.JSON(500, exception.InternalServerError(err).ToResponse())
+		status, err := sessionError(err)
+		ctx.JSON(status, err.ToResponse())
 		return
 	}
 	defer session.Close(session_manager.CloseSessionPayload{
@@ -267,7 +303,7 @@ func GetTTSModelVoices(
 			return plugin_daemon.GetTTSModelVoices(session, &r.Data)
 		},
 		ctx,
-		max_timeout_seconds,
+		deadline,
 	)
 }
 
@@ -276,14 +312,18 @@ func GetTextEmbeddingNumTokens(
 	ctx *gin.Context,
 	max_timeout_seconds int,
 ) {
+	deadline := requestDeadline(ctx, max_timeout_seconds)
+
 	session, err := createSession(
 		r,
 		access_types.PLUGIN_ACCESS_TYPE_MODEL,
 		access_types.PLUGIN_ACCESS_ACTION_GET_TEXT_EMBEDDING_NUM_TOKENS,
 		ctx.GetString("cluster_id"),
+		deadline,
 	)
 	if err != nil {
-		ctx.JSON(500, exception.InternalServerError(err).ToResponse())
+		status, err := sessionError(err)
+		ctx.JSON(status, err.ToResponse())
 		return
 	}
 	defer session.Close(session_manager.CloseSessionPayload{
@@ -295,7 +335,7 @@ func GetTextEmbeddingNumTokens(
 			return plugin_daemon.GetTextEmbeddingNumTokens(session, &r.Data)
 		},
 		ctx,
-		max_timeout_seconds,
+		deadline,
 	)
 }
 
@@ -304,14 +344,18 @@ func GetAIModelSchema(
 	ctx *gin.Context,
 	max_timeout_seconds int,
 ) {
+	deadline := requestDeadline(ctx, max_timeout_seconds)
+
 	session, err := createSession(
 		r,
 		access_types.PLUGIN_ACCESS_TYPE_MODEL,
 		access_types.PLUGIN_ACCESS_ACTION_GET_AI_MODEL_SCHEMAS,
 		ctx.GetString("cluster_id"),
+		deadline,
 	)
 	if err != nil {
-		ctx.JSON(500, exception.InternalServerError(err).ToResponse())
+		status, err := sessionError(err)
+		ctx.JSON(status, err.ToResponse())
 		return
 	}
 	defer session.Close(session_manager.CloseSessionPayload{
@@ -323,7 +367,7 @@ func GetAIModelSchema(
 			return plugin_daemon.GetAIModelSchema(session, &r.Data)
 		},
 		ctx,
-		max_timeout_seconds,
+		deadline,
 	)
 }
 
@@ -332,14 +376,18 @@ func GetLLMNumTokens(
 	ctx *gin.Context,
 	max_timeout_seconds int,
 ) {
+	deadline := requestDeadline(ctx, max_timeout_seconds)
+
 	session, err := createSession(
 		r,
 		access_types.PLUGIN_ACCESS_TYPE_MODEL,
 		access_types.PLUGIN_ACCESS_ACTION_GET_LLM_NUM_TOKENS,
 		ctx.GetString("cluster_id"),
+		deadline,
 	)
 	if err != nil {
-		ctx.JSON(500, exception.InternalServerError(err).ToResponse())
+		status, err := sessionError(err)
+		ctx.JSON(status, err.ToResponse())
 		return
 	}
 	defer session.Close(session_manager.CloseSessionPayload{
@@ -351,6 +399,6 @@ func GetLLMNumTokens(
 			return plugin_daemon.GetLLMNumTokens(session, &r.Data)
 		},
 		ctx,
-		max_timeout_seconds,
+		deadline,
 	)
 }
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_daemon/access_types"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/session_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/exception"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/stream"
+)
+
+// InvokeStream runs a dispatch session like the SSE services do, but hands the responses of the plugin
+// to the writer, it's used by transports other than http, the session is cancelled once the context is
+// done or the writer fails, the deadline of the context is the deadline of the session,
+// returns the error which ended the session if any
+func InvokeStream[Req any, Rsp any](
+	ctx context.Context,
+	r *plugin_entities.InvokePluginRequest[Req],
+	access_type access_types.PluginAccessType,
+	access_action access_types.PluginAccessAction,
+	cluster_id string,
+	max_timeout_seconds int,
+	invoke func(*session_manager.Session, *Req) (*stream.Stream[Rsp], error),
+	writer func(Rsp) error,
+) exception.PluginDaemonError {
+	requested, _ := ctx.Deadline()
+	deadline := Deadline(requested, max_timeout_seconds)
+
+	session, err := createSession(r, access_type, access_action, cluster_id, deadline)
+	if err != nil {
+		_, err := sessionError(err)
+		return err
+	}
+	defer session.Close(session_manager.CloseSessionPayload{
+		IgnoreCache: false,
+	})
+
+	response, err := invoke(session, &r.Data)
+	if err != nil {
+		return exception.InternalServerError(err)
+	}
+	defer response.Close()
+
+	timeout := new(atomic.Bool)
+	timer := time.AfterFunc(time.Until(deadline), func() {
+		timeout.Store(true)
+		response.Close()
+	})
+	defer timer.Stop()
+
+	// the caller is gone
+	stop := context.AfterFunc(ctx, response.Close)
+	defer stop()
+
+	for response.Next() {
+		chunk, err := response.Read()
+		if err != nil {
+			return exception.InvokePluginError(err)
+		}
+
+		if err := writer(chunk); err != nil {
+			return exception.InternalServerError(err)
+		}
+	}
+
+	if timeout.Load() {
+		return exception.DeadlineExceededError(errors.New("killed by timeout"))
+	}
+
+	if ctx.Err() != nil {
+		return exception.InternalServerError(ctx.Err())
+	}
+
+	return nil
+}
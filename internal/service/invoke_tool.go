@@ -8,7 +8,6 @@ import (
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/requests"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/tool_entities"
-	"github.com/mlchain/mlchain-plugin-daemon/internal/types/exception"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/stream"
 )
 
@@ -17,15 +16,19 @@ func InvokeTool(
 	ctx *gin.Context,
 	max_timeout_seconds int,
 ) {
+	deadline := requestDeadline(ctx, max_timeout_seconds)
+
 	// create session
 	session, err := createSession(
 		r,
 		access_types.PLUGIN_ACCESS_TYPE_TOOL,
 		access_types.PLUGIN_ACCESS_ACTION_INVOKE_TOOL,
 		ctx.GetString("cluster_id"),
+		deadline,
 	)
 	if err != nil {
-		ctx.JSON(500, exception.InternalServerError(err).ToResponse())
+		status, err := sessionError(err)
+		ctx.JSON(status, err.ToResponse())
 		return
 	}
 	defer session.Close(session_manager.CloseSessionPayload{
@@ -37,7 +40,7 @@ func InvokeTool(
 			return plugin_daemon.InvokeTool(session, &r.Data)
 		},
 		ctx,
-		max_timeout_seconds,
+		deadline,
 	)
 }
 
@@ -46,15 +49,19 @@ func ValidateToolCredentials(
 	ctx *gin.Context,
 	max_timeout_seconds int,
 ) {
+	deadline := requestDeadline(ctx, max_timeout_seconds)
+
 	// create session
 	session, err := createSession(
 		r,
 		access_types.PLUGIN_ACCESS_TYPE_TOOL,
 		access_types.PLUGIN_ACCESS_ACTION_VALIDATE_TOOL_CREDENTIALS,
 		ctx.GetString("cluster_id"),
+		deadline,
 	)
 	if err != nil {
-		ctx.JSON(500, exception.InternalServerError(err).ToResponse())
+		status, err := sessionError(err)
+		ctx.JSON(status, err.ToResponse())
 		return
 	}
 	defer session.Close(session_manager.CloseSessionPayload{
@@ -66,7 +73,7 @@ func ValidateToolCredentials(
 			return plugin_daemon.ValidateToolCredentials(session, &r.Data)
 		},
 		ctx,
-		max_timeout_seconds,
+		deadline,
 	)
 }
 
@@ -75,15 +82,19 @@ func GetToolRuntimeParameters(
 	ctx *gin.Context,
 	max_timeout_seconds int,
 ) {
+	deadline := requestDeadline(ctx, max_timeout_seconds)
+
 	// create session
 	session, err := createSession(
 		r,
 		access_types.PLUGIN_ACCESS_TYPE_TOOL,
 		access_types.PLUGIN_ACCESS_ACTION_GET_TOOL_RUNTIME_PARAMETERS,
 		ctx.GetString("cluster_id"),
+		deadline,
 	)
 	if err != nil {
-		ctx.JSON(500, exception.InternalServerError(err).ToResponse())
+		status, err := sessionError(err)
+		ctx.JSON(status, err.ToResponse())
 		return
 	}
 	defer session.Close(session_manager.CloseSessionPayload{
@@ -95,6 +106,6 @@ func GetToolRuntimeParameters(
 			return plugin_daemon.GetToolRuntimeParameters(session, &r.Data)
 		},
 		ctx,
-		max_timeout_seconds,
+		deadline,
 	)
 }
@@ -3,9 +3,7 @@ package service
 import (
 	"errors"
 	"io"
-	"mime/multipart"
 
-	"github.com/gin-gonic/gin"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/bundle_packager"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_packager/decoder"
@@ -19,9 +17,8 @@ import (
 
 func UploadPluginPkg(
 	config *app.Config,
-	c *gin.Context,
 	tenant_id string,
-	mlchain_pkg_file multipart.File,
+	mlchain_pkg_file io.Reader,
 	verify_signature bool,
 ) *entities.Response {
 	pluginFile, err := io.ReadAll(mlchain_pkg_file)
@@ -66,9 +63,8 @@ func UploadPluginPkg(
 
 func UploadPluginBundle(
 	config *app.Config,
-	c *gin.Context,
 	tenant_id string,
-	mlchain_bundle_file multipart.File,
+	mlchain_bundle_file io.Reader,
 	verify_signature bool,
 ) *entities.Response {
 	bundleFile, err := io.ReadAll(mlchain_bundle_file)
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/local_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/sandbox"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/exception"
+)
+
+var environmentKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// variables set by the daemon itself could not be configured
+var reservedEnvironmentKeys = map[string]bool{
+	"INSTALL_METHOD":                     true,
+	"PATH":                               true,
+	local_manager.STDIO_PROTOCOLS_ENV:    true,
+	local_manager.HEARTBEAT_INTERVAL_ENV: true,
+	sandbox.POLICY_ENV:                   true,
+}
+
+// the sandbox routes the egress of plugins through its own proxy with these variables
+var sandboxEnvironmentKeys = map[string]bool{
+	"HTTP_PROXY":  true,
+	"HTTPS_PROXY": true,
+	"NO_PROXY":    true,
+	"http_proxy":  true,
+	"https_proxy": true,
+	"no_proxy":    true,
+}
+
+// ListPluginEnvironment returns environment variables of the plugin with their values masked
+func ListPluginEnvironment(plugin_id string) *entities.Response {
+	manager := plugin_manager.Manager()
+	if manager == nil {
+		return exception.InternalServerError(fmt.Errorf("failed to get plugin manager")).ToResponse()
+	}
+
+	variables, err := manager.ListEnvironment(plugin_id)
+	if err != nil {
+		return exception.InternalServerError(fmt.Errorf("failed to list environment: %s", err.Error())).ToResponse()
+	}
+
+	return entities.NewSuccessResponse(variables)
+}
+
+// SetPluginEnvironment sets an environment variable of the plugin, the plugin is restarted to pick up
+// a plugin-wide one, a tenant-scoped one is sent along the next request of the tenant
+func SetPluginEnvironment(plugin_id string, tenant_id string, key string, value string) *entities.Response {
+	if !environmentKeyPattern.MatchString(key) {
+		return exception.BadRequestError(fmt.Errorf("invalid environment variable name: %s", key)).ToResponse()
+	}
+	if reservedEnvironmentKeys[key] {
+		return exception.BadRequestError(fmt.Errorf("environment variable %s is reserved", key)).ToResponse()
+	}
+
+	manager := plugin_manager.Manager()
+	if manager == nil {
+		return exception.InternalServerError(fmt.Errorf("failed to get plugin manager")).ToResponse()
+	}
+
+	if sandboxEnvironmentKeys[key] && manager.SandboxEnabled() {
+		return exception.BadRequestError(
+			fmt.Errorf("environment variable %s is reserved by the sandbox", key),
+		).ToResponse()
+	}
+
+	if err := manager.SetEnvironment(plugin_id, tenant_id, key, value); err != nil {
+		return exception.InternalServerError(fmt.Errorf("failed to set environment: %s", err.Error())).ToResponse()
+	}
+
+	return entities.NewSuccessResponse(true)
+}
+
+// DeletePluginEnvironment removes an environment variable of the plugin, the plugin is restarted
+// without a plugin-wide one, a tenant-scoped one is no longer sent along requests of the tenant
+func DeletePluginEnvironment(plugin_id string, tenant_id string, key string) *entities.Response {
+	manager := plugin_manager.Manager()
+	if manager == nil {
+		return exception.InternalServerError(fmt.Errorf("failed to get plugin manager")).ToResponse()
+	}
+
+	if err := manager.DeleteEnvironment(plugin_id, tenant_id, key); err != nil {
+		if err == plugin_manager.ErrEnvironmentNotFound {
+			return exception.NotFoundError(err).ToResponse()
+		}
+		return exception.InternalServerError(fmt.Errorf("failed to delete environment: %s", err.Error())).ToResponse()
+	}
+
+	return entities.NewSuccessResponse(true)
+}
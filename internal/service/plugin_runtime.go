@@ -0,0 +1,158 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/cluster"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/log_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/db"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/exception"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/models"
+)
+
+// ResetPluginCrashLoop restarts a plugin which has been put in crash loop, the runtime is shared
+// by every tenant which installed the plugin, so it's not scoped to a tenant
+func ResetPluginCrashLoop(plugin_unique_identifier plugin_entities.PluginUniqueIdentifier) *entities.Response {
+	_, err := db.GetOne[models.Plugin](
+		db.Equal("plugin_unique_identifier", plugin_unique_identifier.String()),
+	)
+	if err == db.ErrDatabaseNotFound {
+		return exception.ErrPluginNotFound().ToResponse()
+	}
+	if err != nil {
+		return exception.InternalServerError(err).ToResponse()
+	}
+
+	manager := plugin_manager.Manager()
+	if manager == nil {
+		return exception.InternalServerError(fmt.Errorf("failed to get plugin manager")).ToResponse()
+	}
+
+	if err := manager.ResetCrashLoop(plugin_unique_identifier); err != nil {
+		return exception.InternalServerError(fmt.Errorf("failed to reset crash loop: %s", err.Error())).ToResponse()
+	}
+
+	return entities.NewSuccessResponse(true)
+}
+
+// QueryPluginLogs returns logs of the sessions of the tenant and lifecycle events of a plugin kept on the current node
+func QueryPluginLogs(
+	tenant_id string,
+	plugin_unique_identifier plugin_entities.PluginUniqueIdentifier,
+	filter log_manager.LogFilter,
+) *entities.Response {
+	if response := checkPluginInstallation(tenant_id, plugin_unique_identifier); response != nil {
+		return response
+	}
+
+	manager := plugin_manager.Manager()
+	if manager == nil {
+		return exception.InternalServerError(fmt.Errorf("failed to get plugin manager")).ToResponse()
+	}
+
+	// the plugin is shared by every tenant which installed it, only logs of the tenant are returned
+	filter.TenantID = tenant_id
+	logs, err := manager.QueryLogs(plugin_unique_identifier, filter)
+	if err != nil {
+		return exception.InternalServerError(fmt.Errorf("failed to query logs: %s", err.Error())).ToResponse()
+	}
+
+	return entities.NewSuccessResponse(logs)
+}
+
+// ListPluginCrashes returns the latest crashes of a plugin across the cluster as the tenant could see them
+func ListPluginCrashes(
+	tenant_id string,
+	plugin_unique_identifier plugin_entities.PluginUniqueIdentifier,
+	limit int,
+) *entities.Response {
+	if response := checkPluginInstallation(tenant_id, plugin_unique_identifier); response != nil {
+		return response
+	}
+
+	manager := plugin_manager.Manager()
+	if manager == nil {
+		return exception.InternalServerError(fmt.Errorf("failed to get plugin manager")).ToResponse()
+	}
+
+	crashes, err := manager.ListCrashes(plugin_unique_identifier, limit)
+	if err != nil {
+		return exception.InternalServerError(fmt.Errorf("failed to list crashes: %s", err.Error())).ToResponse()
+	}
+
+	for i := range crashes {
+		crashes[i] = crashOfTenant(crashes[i], tenant_id)
+	}
+
+	return entities.NewSuccessResponse(crashes)
+}
+
+// crashOfTenant returns the crash as the tenant could see it, the process is shared by every tenant
+// which installed the plugin, stderr could carry data of any of them and sessions of others are hidden
+func crashOfTenant(crash models.PluginCrash, tenant_id string) models.PluginCrash {
+	sessions := []string{}
+	for _, session := range crash.Sessions {
+		if crash.SessionTenants[session] == tenant_id {
+			sessions = append(sessions, session)
+		}
+	}
+
+	crash.Sessions = sessions
+	crash.Stderr = []string{}
+	crash.SessionTenants = nil
+	return crash
+}
+
+// checkPluginInstallation returns an error response if the tenant has not installed the plugin
+func checkPluginInstallation(
+	tenant_id string,
+	plugin_unique_identifier plugin_entities.PluginUniqueIdentifier,
+) *entities.Response {
+	_, err := db.GetOne[models.PluginInstallation](
+		db.Equal("tenant_id", tenant_id),
+		db.Equal("plugin_unique_identifier", plugin_unique_identifier.String()),
+	)
+	if err == db.ErrDatabaseNotFound {
+		return exception.ErrPluginNotFound().ToResponse()
+	}
+	if err != nil {
+		return exception.InternalServerError(err).ToResponse()
+	}
+
+	return nil
+}
+
+// ListPluginRuntimes returns runtimes of plugins installed by the tenant across the cluster
+func ListPluginRuntimes(tenant_id string, runtimes []cluster.PluginRuntime) *entities.Response {
+	installations, err := db.GetAll[models.PluginInstallation](
+		db.Equal("tenant_id", tenant_id),
+	)
+	if err != nil {
+		return exception.InternalServerError(err).ToResponse()
+	}
+
+	return entities.NewSuccessResponse(installedPluginRuntimes(runtimes, installations))
+}
+
+// installedPluginRuntimes returns the runtimes of the installed plugins
+func installedPluginRuntimes(
+	runtimes []cluster.PluginRuntime,
+	installations []models.PluginInstallation,
+) []cluster.PluginRuntime {
+	installed := map[string]bool{}
+	for _, installation := range installations {
+		installed[installation.PluginUniqueIdentifier] = true
+	}
+
+	result := []cluster.PluginRuntime{}
+	for _, runtime := range runtimes {
+		if installed[runtime.PluginUniqueIdentifier] {
+			result = append(result, runtime)
+		}
+	}
+
+	return result
+}
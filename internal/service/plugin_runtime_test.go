@@ -0,0 +1,49 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/mlchain/mlchain-plugin-daemon/internal/cluster"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/models"
+)
+
+func TestCrashOfTenant(t *testing.T) {
+	crash := crashOfTenant(models.PluginCrash{
+		Stderr:   []string{"Traceback (most recent call last):"},
+		Sessions: []string{"session-1", "session-2", "session-3"},
+		SessionTenants: map[string]string{
+			"session-1": "tenant-1",
+			"session-2": "tenant-2",
+			"session-3": "tenant-1",
+		},
+	}, "tenant-1")
+
+	if len(crash.Stderr) != 0 {
+		t.Fatalf("expected stderr to be hidden from tenants, got %v", crash.Stderr)
+	}
+	if len(crash.Sessions) != 2 || crash.Sessions[0] != "session-1" || crash.Sessions[1] != "session-3" {
+		t.Fatalf("expected only sessions of the tenant, got %v", crash.Sessions)
+	}
+}
+
+func TestInstalledPluginRuntimes(t *testing.T) {
+	runtimes := []cluster.PluginRuntime{
+		{PluginUniqueIdentifier: "langgenius/installed:0.0.1@a", NodeID: "node-a"},
+		{PluginUniqueIdentifier: "langgenius/installed:0.0.1@a", NodeID: "node-b"},
+		{PluginUniqueIdentifier: "langgenius/other:0.0.1@b", NodeID: "node-a"},
+	}
+
+	result := installedPluginRuntimes(runtimes, []models.PluginInstallation{
+		{PluginUniqueIdentifier: "langgenius/installed:0.0.1@a"},
+		// installed but not running anywhere
+		{PluginUniqueIdentifier: "langgenius/stopped:0.0.1@c"},
+	})
+	if len(result) != 2 || result[0].NodeID != "node-a" || result[1].NodeID != "node-b" {
+		t.Fatalf("expected runtimes of the installed plugin on every node, got %+v", result)
+	}
+	for _, runtime := range result {
+		if runtime.PluginUniqueIdentifier != "langgenius/installed:0.0.1@a" {
+			t.Fatalf("expected runtimes of plugins the tenant has not installed to be hidden, got %+v", runtime)
+		}
+	}
+}
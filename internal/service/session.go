@@ -2,19 +2,40 @@ package service
 
 import (
 	"errors"
+	"net/http"
+	"time"
 
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_daemon/access_types"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/core/plugin_manager/plugin_errors"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/core/session_manager"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/exception"
 )
 
+// ErrDeadlineExceeded is returned by createSession if the deadline of the request has passed already
+var ErrDeadlineExceeded = errors.New("deadline exceeded")
+
+// sessionError converts an error of createSession to the status and the error of the response
+func sessionError(err error) (int, exception.PluginDaemonError) {
+	if err == ErrDeadlineExceeded {
+		return http.StatusGatewayTimeout, exception.DeadlineExceededError(err)
+	}
+	return http.StatusInternalServerError, exception.InternalServerError(err)
+}
+
 func createSession[T any](
 	r *plugin_entities.InvokePluginRequest[T],
 	access_type access_types.PluginAccessType,
 	access_action access_types.PluginAccessAction,
 	cluster_id string,
+	deadline time.Time,
 ) (*session_manager.Session, error) {
+	// the caller has given up already, do not bother the plugin
+	if !time.Now().Before(deadline) {
+		return nil, ErrDeadlineExceeded
+	}
+
 	manager := plugin_manager.Manager()
 	if manager == nil {
 		return nil, errors.New("failed to get plugin manager")
@@ -23,10 +44,18 @@ func createSession[T any](
 	// try fetch plugin identifier from plugin id
 
 	runtime, err := manager.Get(r.UniqueIdentifier)
+	if err == plugin_errors.ErrPluginCrashLoop || err == plugin_errors.ErrPluginDraining {
+		return nil, err
+	}
 	if err != nil {
 		return nil, errors.New("failed to get plugin runtime")
 	}
 
+	environment, err := manager.TenantEnvironment(runtime, r.TenantId)
+	if err != nil {
+		return nil, errors.New("failed to load environment of plugin: " + err.Error())
+	}
+
 	session := session_manager.NewSession(
 		session_manager.NewSessionPayload{
 			TenantID:               r.TenantId,
@@ -42,6 +71,8 @@ func createSession[T any](
 			MessageID:              r.MessageID,
 			AppID:                  r.AppID,
 			EndpointID:             r.EndpointID,
+			Deadline:               deadline,
+			Environment:            environment,
 		},
 	)
 
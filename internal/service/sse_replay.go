@@ -0,0 +1,296 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/app"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/types/exception"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/cache"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/parser"
+	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/routine"
+)
+
+const (
+	// header sent by a client reconnecting to a stream, it's the id of the last event it received
+	LAST_EVENT_ID_HEADER = "Last-Event-ID"
+	// header of a request asking for its stream to be kept for replay, `true` or `1`
+	SSE_REPLAY_HEADER = "X-Plugin-SSE-Replay"
+
+	// how often a resumed stream looks for new events
+	SSE_REPLAY_POLL_INTERVAL = 200 * time.Millisecond
+)
+
+var (
+	// how long events of a stream are kept for a client to come back, a stream nobody reads
+	// for that long is cancelled
+	sseReplayTimeout = 60 * time.Second
+	// every stream is kept for replay, not only the ones which ask for it
+	sseReplayEnabled = false
+)
+
+func InitSSEReplay(config *app.Config) {
+	if config.PluginSSEReplayTimeout > 0 {
+		sseReplayTimeout = time.Duration(config.PluginSSEReplayTimeout) * time.Second
+	}
+	sseReplayEnabled = config.PluginSSEReplayEnabled
+}
+
+// sseReplayRequested returns true if events of the stream of the request are kept for replay
+func sseReplayRequested(ctx *gin.Context) bool {
+	if sseReplayEnabled {
+		return true
+	}
+
+	requested, err := strconv.ParseBool(ctx.GetHeader(SSE_REPLAY_HEADER))
+	return err == nil && requested
+}
+
+// sseFrame is an event of a stream kept in the replay buffer, the id of an event is its position
+type sseFrame struct {
+	Data json.RawMessage `json:"data,omitempty"`
+	// the stream is finished, no more events
+	End bool `json:"end,omitempty"`
+}
+
+// sseReplay keeps events of a stream in redis, so that a client reconnecting with
+// `Last-Event-ID` resumes from the next event on any node of the cluster,
+// events are written in batches by a single writer, so the stream never waits for redis
+type sseReplay struct {
+	tenantId string
+	streamId string
+	// the deadline of the request which started the stream, a resumed stream ends by then as well
+	deadline time.Time
+
+	// the last time a reader of the stream was reported
+	touchedAt time.Time
+	touchLock sync.Mutex
+
+	// events not written to redis yet, in order
+	pending     []sseFrame
+	pendingLock sync.Mutex
+	wakeup      chan bool
+}
+
+func newSSEReplay(tenantId string, deadline time.Time) *sseReplay {
+	r := &sseReplay{
+		tenantId: tenantId,
+		streamId: uuid.New().String(),
+		deadline: deadline,
+		wakeup:   make(chan bool, 1),
+	}
+
+	routine.Submit(map[string]string{
+		"module":   "service",
+		"function": "sseReplay",
+	}, func() {
+		// written before any event, so a stream which could be resumed always has its deadline
+		if err := cache.Store(
+			r.deadlineKey(), r.deadline.UnixMilli(), time.Until(r.deadline)+sseReplayTimeout,
+		); err != nil {
+			log.Error("failed to keep deadline of stream %s for replay: %s", r.streamId, err.Error())
+		}
+		r.flush()
+	})
+
+	return r
+}
+
+func (r *sseReplay) key() string {
+	return fmt.Sprintf("sse_replay:%s:%s", r.tenantId, r.streamId)
+}
+
+func (r *sseReplay) readerKey() string {
+	return fmt.Sprintf("sse_replay_reader:%s:%s", r.tenantId, r.streamId)
+}
+
+func (r *sseReplay) deadlineKey() string {
+	return fmt.Sprintf("sse_replay_deadline:%s:%s", r.tenantId, r.streamId)
+}
+
+// resumeDeadline returns the deadline of the request which started the stream, the execution timeout
+// of the server is used if it's lost, the request resuming the stream could not extend it
+func (r *sseReplay) resumeDeadline(max_timeout_seconds int) time.Time {
+	milliseconds, err := cache.Get[int64](r.deadlineKey())
+	if err != nil {
+		return Deadline(time.Time{}, max_timeout_seconds)
+	}
+	return Deadline(unixMilliDeadline(*milliseconds), max_timeout_seconds)
+}
+
+// eventID formats the id of the event at the position, ids start from 1
+func (r *sseReplay) eventID(seq int64) string {
+	return fmt.Sprintf("%s:%d", r.streamId, seq)
+}
+
+// parseSSEEventID returns the stream and the position of an event id
+func parseSSEEventID(id string) (string, int64, bool) {
+	index := strings.LastIndex(id, ":")
+	if index <= 0 {
+		return "", 0, false
+	}
+
+	seq, err := strconv.ParseInt(id[index+1:], 10, 64)
+	if err != nil || seq < 0 {
+		return "", 0, false
+	}
+
+	return id[:index], seq, true
+}
+
+// push queues the event to be written to redis, it never waits for redis
+func (r *sseReplay) push(frame sseFrame) {
+	r.pendingLock.Lock()
+	r.pending = append(r.pending, frame)
+	r.pendingLock.Unlock()
+
+	select {
+	case r.wakeup <- true:
+	default:
+	}
+}
+
+// flush writes queued events to redis until the end of the stream is written,
+// events queued in the meantime are written in a single round trip
+func (r *sseReplay) flush() {
+	for range r.wakeup {
+		r.pendingLock.Lock()
+		frames := r.pending
+		r.pending = nil
+		r.pendingLock.Unlock()
+
+		if err := cache.PushList(r.key(), frames, sseReplayTimeout); err != nil {
+			log.Error("failed to keep events of stream %s for replay: %s", r.streamId, err.Error())
+		}
+
+		if len(frames) > 0 && frames[len(frames)-1].End {
+			return
+		}
+	}
+}
+
+// touch reports that a client is reading the stream, it's throttled to spare redis
+func (r *sseReplay) touch() {
+	r.touchLock.Lock()
+	defer r.touchLock.Unlock()
+
+	if time.Since(r.touchedAt) < sseReplayTimeout/4 {
+		return
+	}
+	r.touchedAt = time.Now()
+
+	if err := cache.Store(r.readerKey(), "1", sseReplayTimeout); err != nil {
+		log.Error("failed to report reader of stream %s: %s", r.streamId, err.Error())
+	}
+}
+
+// detach gives the client which just disconnected the replay timeout to come back
+func (r *sseReplay) detach() {
+	r.touchLock.Lock()
+	r.touchedAt = time.Time{}
+	r.touchLock.Unlock()
+	r.touch()
+}
+
+// hasReader returns false once no client has read the stream for the replay timeout
+func (r *sseReplay) hasReader() bool {
+	exists, err := cache.Exist(r.readerKey())
+	if err != nil {
+		// do not cancel the plugin because of redis
+		return true
+	}
+	return exists > 0
+}
+
+// writeSSEEvent writes an event, events of streams which are not kept for replay have no id
+func writeSSEEvent(writer gin.ResponseWriter, id string, data []byte) {
+	if id != "" {
+		writer.Write([]byte("id: " + id + "\n"))
+	}
+	writer.Write([]byte("data: "))
+	writer.Write(data)
+	writer.Write([]byte("\n\n"))
+	writer.Flush()
+}
+
+// ResumeSSEStream replays events of the stream after the one in `Last-Event-ID` and follows the stream
+// until it ends, the plugin keeps generating while the client is away, so nothing is invoked again,
+// returns false if there is nothing to resume, the request should be handled as a new one then
+func ResumeSSEStream(ctx *gin.Context, max_timeout_seconds int) bool {
+	streamId, seq, ok := parseSSEEventID(ctx.GetHeader(LAST_EVENT_ID_HEADER))
+	if !ok {
+		return false
+	}
+
+	replay := &sseReplay{
+		tenantId: ctx.Param("tenant_id"),
+		streamId: streamId,
+	}
+
+	exists, err := cache.Exist(replay.key())
+	if err != nil || exists == 0 {
+		return false
+	}
+
+	writer := ctx.Writer
+	writer.WriteHeader(200)
+	writer.Header().Set("Content-Type", "text/event-stream")
+
+	timer := time.NewTimer(time.Until(replay.resumeDeadline(max_timeout_seconds)))
+	defer timer.Stop()
+
+	ticker := time.NewTicker(SSE_REPLAY_POLL_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		replay.touch()
+
+		frames, err := cache.GetListRange[sseFrame](replay.key(), seq, -1)
+		if err != nil {
+			log.Error("failed to replay stream %s: %s", streamId, err.Error())
+			return true
+		}
+
+		for _, frame := range frames {
+			if frame.End {
+				return true
+			}
+
+			seq++
+			writeSSEEvent(writer, replay.eventID(seq), frame.Data)
+		}
+
+		if len(frames) == 0 {
+			if exists, err := cache.Exist(replay.key()); err == nil && exists == 0 {
+				writeSSEEvent(
+					writer,
+					replay.eventID(seq+1),
+					parser.MarshalJsonBytes(exception.InternalServerError(fmt.Errorf("stream %s expired", streamId)).ToResponse()),
+				)
+				return true
+			}
+		}
+
+		select {
+		case <-writer.CloseNotify():
+			return true
+		case <-timer.C:
+			// the same as the stream ends on the node running the plugin once the deadline is reached
+			writeSSEEvent(
+				writer,
+				replay.eventID(seq+1),
+				parser.MarshalJsonBytes(exception.DeadlineExceededError(errors.New("killed by timeout")).ToResponse()),
+			)
+			return true
+		case <-ticker.C:
+		}
+	}
+}
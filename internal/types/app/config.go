@@ -2,6 +2,7 @@ package app
 
 import (
 	"fmt"
+	"runtime"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -11,6 +12,10 @@ type Config struct {
 	ServerPort uint16 `envconfig:"SERVER_PORT" validate:"required"`
 	ServerKey  string `envconfig:"SERVER_KEY" validate:"required"`
 
+	// grpc server for dispatch and management, served alongside the http server
+	GRPCServerEnabled bool   `envconfig:"GRPC_SERVER_ENABLED"`
+	GRPCServerPort    uint16 `envconfig:"GRPC_SERVER_PORT"`
+
 	// mlchain inner api
 	MlchainInnerApiURL string `envconfig:"MLCHAIN_INNER_API_URL" validate:"required"`
 	MlchainInnerApiKey string `envconfig:"MLCHAIN_INNER_API_KEY" validate:"required"`
@@ -41,6 +46,67 @@ type Config struct {
 
 	PluginMaxExecutionTimeout int `envconfig:"PLUGIN_MAX_EXECUTION_TIMEOUT" validate:"required"`
 
+	// how responses of plugins are buffered once the caller reads slower than the plugin writes,
+	// `block` holds the plugin back, `spill` writes the overflow to temporary files in the spill path
+	PluginResponseOverflowMode string `envconfig:"PLUGIN_RESPONSE_OVERFLOW_MODE" validate:"required,oneof=block spill"`
+	PluginResponseSpillPath    string `envconfig:"PLUGIN_RESPONSE_SPILL_PATH"` // system temp dir if empty
+
+	// events of dispatch streams are kept this long for clients reconnecting with Last-Event-ID,
+	// a stream nobody reads for that long is cancelled, streams are only kept if their request asks
+	// for it with X-Plugin-SSE-Replay, or for every request once it's enabled
+	PluginSSEReplayEnabled bool `envconfig:"PLUGIN_SSE_REPLAY_ENABLED"`
+	PluginSSEReplayTimeout int  `envconfig:"PLUGIN_SSE_REPLAY_TIMEOUT"` // seconds
+
+	// process pool of local plugins
+	PluginLocalMinInstances         int `envconfig:"PLUGIN_LOCAL_MIN_INSTANCES"`
+	PluginLocalMaxInstances         int `envconfig:"PLUGIN_LOCAL_MAX_INSTANCES"`
+	PluginLocalScaleUpThreshold     int `envconfig:"PLUGIN_LOCAL_SCALE_UP_THRESHOLD"`      // in-flight sessions per instance
+	PluginLocalScaleDownIdleTimeout int `envconfig:"PLUGIN_LOCAL_SCALE_DOWN_IDLE_TIMEOUT"` // seconds
+	PluginLocalIdleTimeout          int `envconfig:"PLUGIN_LOCAL_IDLE_TIMEOUT"`            // seconds, 0 never parks
+
+	// restart policy of plugins which have exited, exceeding max restarts within the window puts a plugin in crash loop
+	PluginRestartInitialBackoff int  `envconfig:"PLUGIN_RESTART_INITIAL_BACKOFF"` // seconds
+	PluginRestartMaxBackoff     int  `envconfig:"PLUGIN_RESTART_MAX_BACKOFF"`     // seconds
+	PluginRestartMaxRestarts    *int `envconfig:"PLUGIN_RESTART_MAX_RESTARTS"`    // 0 restarts forever
+	PluginRestartWindow         int  `envconfig:"PLUGIN_RESTART_WINDOW"`          // seconds
+
+	// bounds of the heartbeat and readiness checks local plugins declare in their manifest
+	PluginHeartbeatIntervalMin int `envconfig:"PLUGIN_HEARTBEAT_INTERVAL_MIN"` // seconds
+	PluginHeartbeatIntervalMax int `envconfig:"PLUGIN_HEARTBEAT_INTERVAL_MAX"` // seconds
+	PluginHeartbeatTimeoutMin  int `envconfig:"PLUGIN_HEARTBEAT_TIMEOUT_MIN"`  // seconds
+	PluginHeartbeatTimeoutMax  int `envconfig:"PLUGIN_HEARTBEAT_TIMEOUT_MAX"`  // seconds
+	PluginReadinessTimeoutMax  int `envconfig:"PLUGIN_READINESS_TIMEOUT_MAX"`  // seconds
+
+	// a json event a local plugin sends in a frame is read as a whole and could not be larger than this,
+	// raw bytes sent in blob frames are streamed to the session and not bounded
+	PluginStdioMaxEventSize int `envconfig:"PLUGIN_STDIO_MAX_EVENT_SIZE"` // bytes
+
+	// in-flight sessions of a plugin being uninstalled or upgraded get this long to finish
+	PluginDrainGracePeriod int `envconfig:"PLUGIN_DRAIN_GRACE_PERIOD"` // seconds
+
+	// logs and lifecycle events of every plugin are kept on disk, the oldest are dropped beyond the size or retention
+	PluginLogPath      string `envconfig:"PLUGIN_LOG_PATH"`
+	PluginLogMaxSize   int64  `envconfig:"PLUGIN_LOG_MAX_SIZE"`  // bytes per plugin
+	PluginLogRetention int    `envconfig:"PLUGIN_LOG_RETENTION"` // seconds
+
+	// development mode, plugins in these source directories run as they are for the tenant
+	// and are restarted once their source changes
+	PluginDevPaths        []string `envconfig:"PLUGIN_DEV_PATHS"`
+	PluginDevTenantID     string   `envconfig:"PLUGIN_DEV_TENANT_ID"`
+	PluginDevPollInterval int      `envconfig:"PLUGIN_DEV_POLL_INTERVAL"` // milliseconds
+
+	// environment variables of plugins are encrypted with it, SERVER_KEY is used if it's empty
+	PluginEnvironmentSecret string `envconfig:"PLUGIN_ENVIRONMENT_SECRET"`
+
+	// resource limits of local plugins, memory limit is taken from the manifest
+	PluginCgroupEnabled  bool    `envconfig:"PLUGIN_CGROUP_ENABLED"`
+	PluginCgroupRoot     string  `envconfig:"PLUGIN_CGROUP_ROOT"`
+	PluginCgroupCPUQuota float64 `envconfig:"PLUGIN_CGROUP_CPU_QUOTA"` // cpus per process, 0 means unlimited
+
+	// run local plugins in isolated namespaces with a seccomp profile, linux only
+	PluginSandboxEnabled       bool     `envconfig:"PLUGIN_SANDBOX_ENABLED"`
+	PluginSandboxReadOnlyPaths []string `envconfig:"PLUGIN_SANDBOX_READONLY_PATHS"` // extra host paths visible to plugins
+
 	// platform like local or aws lambda
 	Platform PlatformType `envconfig:"PLATFORM" validate:"required"`
 
@@ -83,6 +149,23 @@ type Config struct {
 
 	PythonInterpreterPath string `envconfig:"PYTHON_INTERPRETER_PATH"`
 
+	// downloaded packages and virtual environments shared by python plugins with identical dependencies,
+	// every plugin gets its own virtual environment if empty
+	PythonDependencyCachePath string `envconfig:"PYTHON_DEPENDENCY_CACHE_PATH"`
+
+	// directory of wheels python plugins are installed from, the package index is never used if set
+	PythonLocalIndexPath string `envconfig:"PYTHON_LOCAL_INDEX_PATH"`
+
+	// install python plugins locked by uv or poetry from requirements.txt if the tool is not installed,
+	// the versions installed could drift from the lockfile, installations fail without the tool if false
+	PythonDependencyPipFallback bool `envconfig:"PYTHON_DEPENDENCY_PIP_FALLBACK"`
+
+	// go toolchain used to build go plugins which ship without a prebuilt binary
+	GoCompilerPath string `envconfig:"GO_COMPILER_PATH"`
+
+	// node executable to launch nodejs plugins, npm or pnpm is taken from PATH to install dependencies
+	NodeExecutablePath string `envconfig:"NODE_EXECUTABLE_PATH"`
+
 	DisplayClusterLog bool `envconfig:"DISPLAY_CLUSTER_LOG"`
 
 	PPROFEnabled bool `envconfig:"PPROF_ENABLED"`
@@ -133,10 +216,34 @@ func (c *Config) Validate() error {
 		if c.PluginWorkingPath == "" {
 			return fmt.Errorf("plugin working path is empty")
 		}
+
+		if c.PluginLocalMaxInstances < c.PluginLocalMinInstances {
+			return fmt.Errorf("plugin local max instances should not be less than min instances")
+		}
+
+		if c.PluginHeartbeatTimeoutMax > 0 && c.PluginHeartbeatTimeoutMax < 2*c.PluginHeartbeatIntervalMin {
+			return fmt.Errorf("plugin heartbeat timeout max should be at least twice the heartbeat interval min")
+		}
+
+		if c.PluginCgroupCPUQuota < 0 {
+			return fmt.Errorf("plugin cgroup cpu quota should not be negative")
+		}
+
+		if len(c.PluginDevPaths) > 0 && c.PluginDevTenantID == "" {
+			return fmt.Errorf("plugin dev tenant id is empty")
+		}
+
+		if c.PluginSandboxEnabled && runtime.GOOS != "linux" {
+			return fmt.Errorf("plugin sandbox is only supported on linux")
+		}
 	} else {
 		return fmt.Errorf("invalid platform")
 	}
 
+	if c.PluginRestartMaxBackoff < c.PluginRestartInitialBackoff {
+		return fmt.Errorf("plugin restart max backoff should not be less than initial backoff")
+	}
+
 	if c.PluginPackageCachePath == "" {
 		return fmt.Errorf("plugin package cache path is empty")
 	}
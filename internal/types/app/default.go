@@ -1,9 +1,14 @@
 package app
 
-import "golang.org/x/exp/constraints"
+import (
+	"path"
+
+	"golang.org/x/exp/constraints"
+)
 
 func (config *Config) SetDefault() {
 	setDefaultInt(&config.ServerPort, 5002)
+	setDefaultInt(&config.GRPCServerPort, 5004)
 	setDefaultInt(&config.RoutinePoolSize, 1000)
 	setDefaultInt(&config.LifetimeCollectionGCInterval, 60)
 	setDefaultInt(&config.LifetimeCollectionHeartbeatInterval, 5)
@@ -15,7 +20,28 @@ func (config *Config) SetDefault() {
 	setDefaultInt(&config.MaxBundlePackageSize, 52428800*12)
 	setDefaultInt(&config.MaxAWSLambdaTransactionTimeout, 150)
 	setDefaultInt(&config.PluginMaxExecutionTimeout, 240)
+	setDefaultInt(&config.PluginSSEReplayTimeout, 60)
+	setDefaultInt(&config.PluginLocalMinInstances, 1)
+	setDefaultInt(&config.PluginLocalMaxInstances, config.PluginLocalMinInstances)
+	setDefaultInt(&config.PluginLocalScaleUpThreshold, 8)
+	setDefaultInt(&config.PluginLocalScaleDownIdleTimeout, 300)
+	setDefaultInt(&config.PluginRestartInitialBackoff, 5)
+	setDefaultInt(&config.PluginRestartMaxBackoff, 300)
+	setDefaultIntIfUnset(&config.PluginRestartMaxRestarts, 5)
+	setDefaultInt(&config.PluginRestartWindow, 600)
+	setDefaultInt(&config.PluginHeartbeatIntervalMin, 1)
+	setDefaultInt(&config.PluginHeartbeatIntervalMax, 60)
+	setDefaultInt(&config.PluginHeartbeatTimeoutMin, 10)
+	setDefaultInt(&config.PluginHeartbeatTimeoutMax, 600)
+	setDefaultInt(&config.PluginReadinessTimeoutMax, 600)
+	setDefaultInt(&config.PluginStdioMaxEventSize, 64*1024*1024)
+	setDefaultInt(&config.PluginDrainGracePeriod, 60)
+	setDefaultInt(&config.PluginDevPollInterval, 1000)
+	setDefaultInt(&config.PluginLogMaxSize, 16*1024*1024)
+	setDefaultInt(&config.PluginLogRetention, 7*24*60*60)
+	setDefaultString(&config.PluginCgroupRoot, "/sys/fs/cgroup/mlchain-plugin")
 	setDefaultString(&config.PluginStorageType, "local")
+	setDefaultString(&config.PluginResponseOverflowMode, "spill")
 	setDefaultInt(&config.PluginMediaCacheSize, 1024)
 	setDefaultInt(&config.PluginRemoteInstallingMaxSingleTenantConn, 5)
 	setDefaultBool(&config.PluginRemoteInstallingEnabled, true)
@@ -28,6 +54,10 @@ func (config *Config) SetDefault() {
 	setDefaultInt(&config.PersistenceStorageMaxSize, 100*1024*1024)
 	setDefaultString(&config.PluginPackageCachePath, "plugin_packages")
 	setDefaultString(&config.PythonInterpreterPath, "/usr/bin/python3")
+	setDefaultString(&config.PluginLogPath, path.Join(config.PluginWorkingPath, ".logs"))
+	setDefaultString(&config.PluginEnvironmentSecret, config.ServerKey)
+	setDefaultString(&config.GoCompilerPath, "go")
+	setDefaultString(&config.NodeExecutablePath, "node")
 }
 
 func setDefaultInt[T constraints.Integer](value *T, defaultValue T) {
@@ -36,6 +66,13 @@ func setDefaultInt[T constraints.Integer](value *T, defaultValue T) {
 	}
 }
 
+// setDefaultIntIfUnset sets the default of a value for which zero is meaningful, only if it's not set at all
+func setDefaultIntIfUnset[T constraints.Integer](value **T, defaultValue T) {
+	if *value == nil {
+		*value = &defaultValue
+	}
+}
+
 func setDefaultString(value *string, defaultValue string) {
 	if *value == "" {
 		*value = defaultValue
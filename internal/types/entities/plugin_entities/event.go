@@ -1,7 +1,11 @@
 package plugin_entities
 
 import (
+	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/log"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/utils/parser"
@@ -20,8 +24,9 @@ func ParsePluginUniversalEvent(
 	data []byte,
 	session_handler func(sessionId string, data []byte),
 	heartbeat_handler func(),
+	ready_handler func(),
 	error_handler func(err string),
-	info_handler func(message string),
+	log_handler func(session_id string, event PluginLogEvent),
 ) {
 	// handle event
 	event, err := parser.UnmarshalJsonBytes[PluginUniversalEvent](data)
@@ -43,7 +48,7 @@ func ParsePluginUniversalEvent(
 				return
 			}
 
-			info_handler(logEvent.Message)
+			log_handler(sessionId, logEvent)
 		}
 	case PLUGIN_EVENT_SESSION:
 		session_handler(sessionId, event.Data)
@@ -51,6 +56,8 @@ func ParsePluginUniversalEvent(
 		error_handler(string(event.Data))
 	case PLUGIN_EVENT_HEARTBEAT:
 		heartbeat_handler()
+	case PLUGIN_EVENT_READY:
+		ready_handler()
 	}
 }
 
@@ -61,6 +68,10 @@ const (
 	PLUGIN_EVENT_SESSION   PluginEventType = "session"
 	PLUGIN_EVENT_ERROR     PluginEventType = "error"
 	PLUGIN_EVENT_HEARTBEAT PluginEventType = "heartbeat"
+	// sent once by local plugins to switch the stdio protocol
+	PLUGIN_EVENT_PROTOCOL PluginEventType = "protocol"
+	// sent once by local plugins which declare readiness in the manifest, when they could serve sessions
+	PLUGIN_EVENT_READY PluginEventType = "ready"
 )
 
 type PluginLogEvent struct {
@@ -72,6 +83,71 @@ type PluginLogEvent struct {
 type SessionMessage struct {
 	Type SESSION_MESSAGE_TYPE `json:"type" validate:"required"`
 	Data json.RawMessage      `json:"data" validate:"required"`
+	// raw bytes sent along the message by the framed stdio protocol of local plugins
+	Blob *SessionBlob `json:"-"`
+}
+
+// SessionBlob is raw bytes which belong at Path of the data of a session message,
+// they are kept out of the json so that they are never encoded as base64 on the way
+type SessionBlob struct {
+	Path []string
+	Data []byte
+
+	// a large blob is handed over in pieces of the same session message, every piece has
+	// the ID of the blob, Data is the piece at Offset of the Total bytes, Sequence counts from 0
+	ID       string
+	Sequence int
+	Offset   uint64
+	Total    uint64
+}
+
+// Whole returns whether Data is the blob as a whole rather than a piece of it
+func (b *SessionBlob) Whole() bool {
+	return b.Offset == 0 && uint64(len(b.Data)) >= b.Total
+}
+
+// Last returns whether Data is the last piece of the blob
+func (b *SessionBlob) Last() bool {
+	return b.Offset+uint64(len(b.Data)) >= b.Total
+}
+
+// BlobReceiver is implemented by responses which take the raw bytes of a session message as they are,
+// responses which are not get every piece of a large blob as a response of its own
+type BlobReceiver interface {
+	SetBlob(blob *SessionBlob) error
+}
+
+// EncodeInto puts the bytes into the data as a base64 string, for responses which could only
+// be built from json, `[]byte` fields of them decode it back
+func (b *SessionBlob) EncodeInto(data json.RawMessage) (json.RawMessage, error) {
+	value, err := parser.UnmarshalJsonBytes2Map(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := SetBlobValue(value, b.Path, base64.StdEncoding.EncodeToString(b.Data)); err != nil {
+		return nil, err
+	}
+
+	return parser.MarshalJsonBytes(value), nil
+}
+
+// SetBlobValue sets the value at the path of nested objects
+func SetBlobValue(node map[string]any, path []string, value any) error {
+	if len(path) == 0 {
+		return errors.New("blob path is empty")
+	}
+
+	for _, key := range path[:len(path)-1] {
+		child, ok := node[key].(map[string]any)
+		if !ok {
+			return fmt.Errorf("blob path %s is not an object", strings.Join(path, "."))
+		}
+		node = child
+	}
+	node[path[len(path)-1]] = value
+
+	return nil
 }
 
 type SESSION_MESSAGE_TYPE string
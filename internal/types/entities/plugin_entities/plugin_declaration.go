@@ -29,6 +29,7 @@ type PluginPermissionRequirement struct {
 	Endpoint *PluginPermissionEndpointRequirement `json:"endpoint,omitempty" yaml:"endpoint,omitempty" validate:"omitempty"`
 	App      *PluginPermissionAppRequirement      `json:"app,omitempty" yaml:"app,omitempty" validate:"omitempty"`
 	Storage  *PluginPermissionStorageRequirement  `json:"storage,omitempty" yaml:"storage,omitempty" validate:"omitempty"`
+	Network  *PluginPermissionNetworkRequirement  `json:"network,omitempty" yaml:"network,omitempty" validate:"omitempty"`
 }
 
 func (p *PluginPermissionRequirement) AllowInvokeTool() bool {
@@ -79,6 +80,10 @@ func (p *PluginPermissionRequirement) AllowInvokeStorage() bool {
 	return p != nil && p.Storage != nil && p.Storage.Enabled
 }
 
+func (p *PluginPermissionRequirement) AllowNetwork() bool {
+	return p != nil && p.Network != nil && p.Network.Enabled
+}
+
 type PluginPermissionToolRequirement struct {
 	Enabled bool `json:"enabled" yaml:"enabled"`
 }
@@ -110,6 +115,12 @@ type PluginPermissionStorageRequirement struct {
 	Size    uint64 `json:"size" yaml:"size" validate:"min=1024,max=1073741824"` // min 1024 bytes, max 1G
 }
 
+type PluginPermissionNetworkRequirement struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// hosts the plugin could reach when sandboxed, `*.example.com` matches all subdomains
+	Egress []string `json:"egress" yaml:"egress" validate:"omitempty,max=64,dive,max=256"`
+}
+
 type PluginResourceRequirement struct {
 	// Memory in bytes
 	Memory int64 `json:"memory" yaml:"memory" validate:"required"`
@@ -125,10 +136,38 @@ type PluginRunner struct {
 	Entrypoint string             `json:"entrypoint" yaml:"entrypoint" validate:"required,max=256"`
 }
 
+// GoPrebuiltBinary returns where the prebuilt binary of a go plugin for `arch` is placed in the package,
+// the entrypoint of a go plugin is the path of its main package, like `.` or `cmd/plugin`
+func GoPrebuiltBinary(arch constants.Arch) string {
+	return fmt.Sprintf("bin/plugin-linux-%s", arch)
+}
+
+// PluginHealth declares how the daemon checks a local process of the plugin, durations are in seconds,
+// they are clamped to the bounds set by the admin and defaults are used for the ones not set
+type PluginHealth struct {
+	HeartbeatInterval int `json:"heartbeat_interval,omitempty" yaml:"heartbeat_interval,omitempty" validate:"omitempty,min=1"`
+	HeartbeatTimeout  int `json:"heartbeat_timeout,omitempty" yaml:"heartbeat_timeout,omitempty" validate:"omitempty,min=1"`
+	// the process receives no session until it sends a ready event
+	Readiness        bool `json:"readiness,omitempty" yaml:"readiness,omitempty"`
+	ReadinessTimeout int  `json:"readiness_timeout,omitempty" yaml:"readiness_timeout,omitempty" validate:"omitempty,min=1"`
+}
+
+// PluginRestartPolicy declares how the daemon restarts the plugin once it exits, durations are in seconds,
+// the policy set by the admin is used for the ones not set
+type PluginRestartPolicy struct {
+	InitialBackoff int `json:"initial_backoff,omitempty" yaml:"initial_backoff,omitempty" validate:"omitempty,min=1"`
+	MaxBackoff     int `json:"max_backoff,omitempty" yaml:"max_backoff,omitempty" validate:"omitempty,min=1"`
+	// restarts within the window before the plugin is put in crash loop
+	MaxRestarts int `json:"max_restarts,omitempty" yaml:"max_restarts,omitempty" validate:"omitempty,min=1"`
+	Window      int `json:"window,omitempty" yaml:"window,omitempty" validate:"omitempty,min=1"`
+}
+
 type PluginMeta struct {
-	Version string           `json:"version" yaml:"version" validate:"required,version"`
-	Arch    []constants.Arch `json:"arch" yaml:"arch" validate:"required,dive,is_available_arch"`
-	Runner  PluginRunner     `json:"runner" yaml:"runner" validate:"required"`
+	Version string               `json:"version" yaml:"version" validate:"required,version"`
+	Arch    []constants.Arch     `json:"arch" yaml:"arch" validate:"required,dive,is_available_arch"`
+	Runner  PluginRunner         `json:"runner" yaml:"runner" validate:"required"`
+	Health  *PluginHealth        `json:"health,omitempty" yaml:"health,omitempty" validate:"omitempty"`
+	Restart *PluginRestartPolicy `json:"restart,omitempty" yaml:"restart,omitempty" validate:"omitempty"`
 }
 
 type PluginExtensions struct {
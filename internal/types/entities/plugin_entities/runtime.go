@@ -14,9 +14,10 @@ import (
 
 type (
 	PluginRuntime struct {
-		State     PluginRuntimeState `json:"state"`
-		Config    PluginDeclaration  `json:"config"`
-		onStopped []func()           `json:"-"`
+		State      PluginRuntimeState `json:"state"`
+		Config     PluginDeclaration  `json:"config"`
+		onStopped  []func()           `json:"-"`
+		onDraining []func()           `json:"-"`
 	}
 
 	PluginLifetime interface {
@@ -44,6 +45,8 @@ type (
 		SetRestarting()
 		// set the plugin to pending
 		SetPending()
+		// set the plugin to crash loop, it will not be restarted until reset
+		SetCrashLoop()
 		// set the active time of the plugin
 		SetActiveAt(t time.Time)
 		// set the scheduled time of the plugin
@@ -80,6 +83,13 @@ type (
 		Error(string)
 	}
 
+	// PluginCancelAware is implemented by runtimes which know whether the plugin handles cancel events,
+	// plugins of other runtimes are never sent one
+	PluginCancelAware interface {
+		// returns true if the plugin running the session handles cancel events
+		AcceptsCancel(session_id string) bool
+	}
+
 	PluginClusterLifetime interface {
 		// stop the plugin
 		Stop()
@@ -87,6 +97,8 @@ type (
 		OnStop(func())
 		// trigger the stop event
 		TriggerStop()
+		// add a function to be called when the plugin starts draining
+		OnDraining(func())
 		// returns true if the plugin is stopped
 		Stopped() bool
 		// returns the runtime state of the plugin
@@ -167,6 +179,23 @@ func (r *PluginRuntime) SetPending() {
 	r.State.Status = PLUGIN_RUNTIME_STATUS_PENDING
 }
 
+func (r *PluginRuntime) SetCrashLoop() {
+	r.State.Status = PLUGIN_RUNTIME_STATUS_CRASH_LOOP
+}
+
+func (r *PluginRuntime) SetParked() {
+	r.State.Status = PLUGIN_RUNTIME_STATUS_PARKED
+}
+
+// SetDraining marks the plugin as accepting no new session, it's kept apart from the status
+// so that parking or restarting the plugin while it drains does not make it available again
+func (r *PluginRuntime) SetDraining() {
+	r.State.Draining = true
+	for _, f := range r.onDraining {
+		f()
+	}
+}
+
 func (r *PluginRuntime) SetActiveAt(t time.Time) {
 	r.State.ActiveAt = &t
 }
@@ -179,10 +208,22 @@ func (r *PluginRuntime) AddRestarts() {
 	r.State.Restarts++
 }
 
+func (r *PluginRuntime) AddOOMKills(n int64) {
+	r.State.OOMKills += n
+}
+
+func (r *PluginRuntime) AddCPUThrottled(usec int64) {
+	r.State.CPUThrottledUsec += usec
+}
+
 func (r *PluginRuntime) OnStop(f func()) {
 	r.onStopped = append(r.onStopped, f)
 }
 
+func (r *PluginRuntime) OnDraining(f func()) {
+	r.onDraining = append(r.onDraining, f)
+}
+
 func (r *PluginRuntime) TriggerStop() {
 	for _, f := range r.onStopped {
 		f()
@@ -218,6 +259,17 @@ type PluginRuntimeState struct {
 	Verified    bool       `json:"verified"`
 	ScheduledAt *time.Time `json:"scheduled_at"`
 	Logs        []string   `json:"logs"`
+
+	// resource events reported by cgroup, only available for local plugins
+	OOMKills         int64 `json:"oom_kills"`
+	CPUThrottledUsec int64 `json:"cpu_throttled_usec"`
+
+	// processes and in-flight sessions of the runtime, only available for local plugins
+	Pids     []int `json:"pids"`
+	Sessions int   `json:"sessions"`
+
+	// a draining plugin finishes in-flight sessions and accepts no new one
+	Draining bool `json:"draining"`
 }
 
 func (s *PluginRuntimeState) Hash() (uint64, error) {
@@ -242,4 +294,6 @@ const (
 	PLUGIN_RUNTIME_STATUS_STOPPED    = "stopped"
 	PLUGIN_RUNTIME_STATUS_RESTARTING = "restarting"
 	PLUGIN_RUNTIME_STATUS_PENDING    = "pending"
+	PLUGIN_RUNTIME_STATUS_CRASH_LOOP = "crash_loop"
+	PLUGIN_RUNTIME_STATUS_PARKED     = "parked"
 )
@@ -1,6 +1,9 @@
 package tool_entities
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/go-playground/validator/v10"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/entities/plugin_entities"
 	"github.com/mlchain/mlchain-plugin-daemon/internal/types/validators"
@@ -53,6 +56,39 @@ type ToolResponseChunk struct {
 	Meta    map[string]any        `json:"meta"`
 }
 
+// SetBlob puts raw bytes sent by the plugin into the message, like the blob of a blob chunk,
+// a blob message handed over in pieces turns into blob chunks which are merged into the blob again
+func (t *ToolResponseChunk) SetBlob(blob *plugin_entities.SessionBlob) error {
+	path := blob.Path
+	if len(path) < 2 || path[0] != "message" {
+		return fmt.Errorf("blob path %s is not in the message", strings.Join(path, "."))
+	}
+
+	if t.Type == ToolResponseChunkTypeBlob && len(path) == 2 && path[1] == "blob" && !blob.Whole() {
+		t.Type = ToolResponseChunkTypeBlobChunk
+		t.Message = map[string]any{
+			"id":       blob.ID,
+			"sequence": float64(blob.Sequence),
+			// numbers are float64 like the ones of chunks decoded from json
+			"total_length": float64(blob.Total),
+			"blob":         blob.Data,
+			"end":          blob.Last(),
+		}
+		return nil
+	}
+
+	if t.Message == nil {
+		t.Message = map[string]any{}
+	}
+
+	// a blob chunk handed over in pieces ends with its last piece
+	if t.Type == ToolResponseChunkTypeBlobChunk && !blob.Last() {
+		t.Message["end"] = false
+	}
+
+	return plugin_entities.SetBlobValue(t.Message, path[1:], blob.Data)
+}
+
 type GetToolRuntimeParametersResponse struct {
 	Parameters []plugin_entities.ToolParameter `json:"parameters"`
 }
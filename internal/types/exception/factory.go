@@ -30,6 +30,11 @@ func PermissionDeniedError(msg string) PluginDaemonError {
 	return ErrorWithTypeAndCode(msg, "PluginPermissionDeniedError", -403)
 }
 
+// the deadline of the request has passed before the plugin could respond
+func DeadlineExceededError(err error) PluginDaemonError {
+	return ErrorWithTypeAndCode(err.Error(), "PluginDaemonDeadlineExceededError", -504)
+}
+
 func InvokePluginError(err error) PluginDaemonError {
 	return ErrorWithTypeAndCode(err.Error(), "PluginInvokeError", -500)
 }
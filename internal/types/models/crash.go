@@ -0,0 +1,23 @@
+package models
+
+// PluginCrash is an unexpected exit of a process of a local plugin, it's created when the process crashed
+type PluginCrash struct {
+	Model
+	PluginUniqueIdentifier string `json:"plugin_unique_identifier" gorm:"index;size:255"`
+	PluginID               string `json:"plugin_id" gorm:"index;size:255"`
+	InstanceID             string `json:"instance_id" gorm:"size:36"`
+	Pid                    int    `json:"pid"`
+	// -1 if the process was killed by a signal
+	ExitCode int    `json:"exit_code"`
+	Signal   string `json:"signal" gorm:"size:64"`
+	// why the daemon killed the process if it did
+	Reason string `json:"reason" gorm:"size:255"`
+	// the last lines of stderr
+	Stderr []string `json:"stderr" gorm:"serializer:json;type:text"`
+	// seconds the process had been running
+	Uptime   float64  `json:"uptime"`
+	Restarts int      `json:"restarts"`
+	Sessions []string `json:"sessions" gorm:"serializer:json;type:text"`
+	// tenants which own the sessions by their ids, a tenant is shown its own sessions only
+	SessionTenants map[string]string `json:"-" gorm:"serializer:json;type:text"`
+}
@@ -0,0 +1,12 @@
+package models
+
+// PluginEnvironment is an environment variable injected into local processes of a plugin,
+// an empty tenant id applies to every tenant
+type PluginEnvironment struct {
+	Model
+	PluginID string `json:"plugin_id" gorm:"index;size:255"`
+	TenantID string `json:"tenant_id" gorm:"index;size:36"`
+	Key      string `json:"key" gorm:"size:255"`
+	// encrypted and base64 encoded
+	Value string `json:"value" gorm:"type:text"`
+}
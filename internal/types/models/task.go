@@ -18,6 +18,8 @@ type InstallTaskPluginStatus struct {
 	PluginID               string                                 `json:"plugin_id"`
 	Status                 InstallTaskStatus                      `json:"status"`
 	Message                string                                 `json:"message"`
+	// stage of launching a local plugin, e.g. installing_dependencies
+	Stage string `json:"stage"`
 }
 
 type InstallTask struct {
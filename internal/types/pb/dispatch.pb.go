@@ -0,0 +1,5149 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: plugin_daemon/v1/dispatch.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// InvokeContext identifies the caller, it mirrors the fields of
+// `plugin_entities.InvokePluginRequest` other than `data`
+type InvokeContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId       string  `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	UserId         string  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ConversationId *string `protobuf:"bytes,3,opt,name=conversation_id,json=conversationId,proto3,oneof" json:"conversation_id,omitempty"`
+	MessageId      *string `protobuf:"bytes,4,opt,name=message_id,json=messageId,proto3,oneof" json:"message_id,omitempty"`
+	AppId          *string `protobuf:"bytes,5,opt,name=app_id,json=appId,proto3,oneof" json:"app_id,omitempty"`
+	EndpointId     *string `protobuf:"bytes,6,opt,name=endpoint_id,json=endpointId,proto3,oneof" json:"endpoint_id,omitempty"`
+}
+
+func (x *InvokeContext) Reset() {
+	*x = InvokeContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvokeContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeContext) ProtoMessage() {}
+
+func (x *InvokeContext) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeContext.ProtoReflect.Descriptor instead.
+func (*InvokeContext) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InvokeContext) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *InvokeContext) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InvokeContext) GetConversationId() string {
+	if x != nil && x.ConversationId != nil {
+		return *x.ConversationId
+	}
+	return ""
+}
+
+func (x *InvokeContext) GetMessageId() string {
+	if x != nil && x.MessageId != nil {
+		return *x.MessageId
+	}
+	return ""
+}
+
+func (x *InvokeContext) GetAppId() string {
+	if x != nil && x.AppId != nil {
+		return *x.AppId
+	}
+	return ""
+}
+
+func (x *InvokeContext) GetEndpointId() string {
+	if x != nil && x.EndpointId != nil {
+		return *x.EndpointId
+	}
+	return ""
+}
+
+type PromptMessageToolCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string                          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type     string                          `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Function *PromptMessageToolCall_Function `protobuf:"bytes,3,opt,name=function,proto3" json:"function,omitempty"`
+}
+
+func (x *PromptMessageToolCall) Reset() {
+	*x = PromptMessageToolCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromptMessageToolCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptMessageToolCall) ProtoMessage() {}
+
+func (x *PromptMessageToolCall) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptMessageToolCall.ProtoReflect.Descriptor instead.
+func (*PromptMessageToolCall) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PromptMessageToolCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PromptMessageToolCall) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PromptMessageToolCall) GetFunction() *PromptMessageToolCall_Function {
+	if x != nil {
+		return x.Function
+	}
+	return nil
+}
+
+type PromptMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// a string or a list of contents like `{"type": "image", "data": "..."}`
+	Content    *structpb.Value          `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Name       string                   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ToolCalls  []*PromptMessageToolCall `protobuf:"bytes,4,rep,name=tool_calls,json=toolCalls,proto3" json:"tool_calls,omitempty"`
+	ToolCallId string                   `protobuf:"bytes,5,opt,name=tool_call_id,json=toolCallId,proto3" json:"tool_call_id,omitempty"`
+}
+
+func (x *PromptMessage) Reset() {
+	*x = PromptMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromptMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptMessage) ProtoMessage() {}
+
+func (x *PromptMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptMessage.ProtoReflect.Descriptor instead.
+func (*PromptMessage) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PromptMessage) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *PromptMessage) GetContent() *structpb.Value {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *PromptMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PromptMessage) GetToolCalls() []*PromptMessageToolCall {
+	if x != nil {
+		return x.ToolCalls
+	}
+	return nil
+}
+
+func (x *PromptMessage) GetToolCallId() string {
+	if x != nil {
+		return x.ToolCallId
+	}
+	return ""
+}
+
+type PromptMessageTool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string           `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Parameters  *structpb.Struct `protobuf:"bytes,3,opt,name=parameters,proto3" json:"parameters,omitempty"`
+}
+
+func (x *PromptMessageTool) Reset() {
+	*x = PromptMessageTool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromptMessageTool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptMessageTool) ProtoMessage() {}
+
+func (x *PromptMessageTool) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptMessageTool.ProtoReflect.Descriptor instead.
+func (*PromptMessageTool) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PromptMessageTool) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PromptMessageTool) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PromptMessageTool) GetParameters() *structpb.Struct {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type RequestInvokeTool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider       string           `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Tool           string           `protobuf:"bytes,2,opt,name=tool,proto3" json:"tool,omitempty"`
+	ToolParameters *structpb.Struct `protobuf:"bytes,3,opt,name=tool_parameters,json=toolParameters,proto3" json:"tool_parameters,omitempty"`
+	Credentials    *structpb.Struct `protobuf:"bytes,4,opt,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (x *RequestInvokeTool) Reset() {
+	*x = RequestInvokeTool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestInvokeTool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestInvokeTool) ProtoMessage() {}
+
+func (x *RequestInvokeTool) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestInvokeTool.ProtoReflect.Descriptor instead.
+func (*RequestInvokeTool) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RequestInvokeTool) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RequestInvokeTool) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *RequestInvokeTool) GetToolParameters() *structpb.Struct {
+	if x != nil {
+		return x.ToolParameters
+	}
+	return nil
+}
+
+func (x *RequestInvokeTool) GetCredentials() *structpb.Struct {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+type RequestValidateToolCredentials struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string           `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Credentials *structpb.Struct `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (x *RequestValidateToolCredentials) Reset() {
+	*x = RequestValidateToolCredentials{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestValidateToolCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestValidateToolCredentials) ProtoMessage() {}
+
+func (x *RequestValidateToolCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestValidateToolCredentials.ProtoReflect.Descriptor instead.
+func (*RequestValidateToolCredentials) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RequestValidateToolCredentials) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RequestValidateToolCredentials) GetCredentials() *structpb.Struct {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+type RequestGetToolRuntimeParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string           `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Tool        string           `protobuf:"bytes,2,opt,name=tool,proto3" json:"tool,omitempty"`
+	Credentials *structpb.Struct `protobuf:"bytes,3,opt,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (x *RequestGetToolRuntimeParameters) Reset() {
+	*x = RequestGetToolRuntimeParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestGetToolRuntimeParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestGetToolRuntimeParameters) ProtoMessage() {}
+
+func (x *RequestGetToolRuntimeParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestGetToolRuntimeParameters.ProtoReflect.Descriptor instead.
+func (*RequestGetToolRuntimeParameters) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RequestGetToolRuntimeParameters) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RequestGetToolRuntimeParameters) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *RequestGetToolRuntimeParameters) GetCredentials() *structpb.Struct {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+type RequestInvokeAgentStrategy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AgentStrategyProvider string           `protobuf:"bytes,1,opt,name=agent_strategy_provider,json=agentStrategyProvider,proto3" json:"agent_strategy_provider,omitempty"`
+	AgentStrategy         string           `protobuf:"bytes,2,opt,name=agent_strategy,json=agentStrategy,proto3" json:"agent_strategy,omitempty"`
+	AgentStrategyParams   *structpb.Struct `protobuf:"bytes,3,opt,name=agent_strategy_params,json=agentStrategyParams,proto3" json:"agent_strategy_params,omitempty"`
+}
+
+func (x *RequestInvokeAgentStrategy) Reset() {
+	*x = RequestInvokeAgentStrategy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestInvokeAgentStrategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestInvokeAgentStrategy) ProtoMessage() {}
+
+func (x *RequestInvokeAgentStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestInvokeAgentStrategy.ProtoReflect.Descriptor instead.
+func (*RequestInvokeAgentStrategy) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RequestInvokeAgentStrategy) GetAgentStrategyProvider() string {
+	if x != nil {
+		return x.AgentStrategyProvider
+	}
+	return ""
+}
+
+func (x *RequestInvokeAgentStrategy) GetAgentStrategy() string {
+	if x != nil {
+		return x.AgentStrategy
+	}
+	return ""
+}
+
+func (x *RequestInvokeAgentStrategy) GetAgentStrategyParams() *structpb.Struct {
+	if x != nil {
+		return x.AgentStrategyParams
+	}
+	return nil
+}
+
+type RequestInvokeLLM struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider        string               `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Model           string               `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	ModelType       string               `protobuf:"bytes,3,opt,name=model_type,json=modelType,proto3" json:"model_type,omitempty"`
+	Credentials     *structpb.Struct     `protobuf:"bytes,4,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	ModelParameters *structpb.Struct     `protobuf:"bytes,5,opt,name=model_parameters,json=modelParameters,proto3" json:"model_parameters,omitempty"`
+	PromptMessages  []*PromptMessage     `protobuf:"bytes,6,rep,name=prompt_messages,json=promptMessages,proto3" json:"prompt_messages,omitempty"`
+	Tools           []*PromptMessageTool `protobuf:"bytes,7,rep,name=tools,proto3" json:"tools,omitempty"`
+	Stop            []string             `protobuf:"bytes,8,rep,name=stop,proto3" json:"stop,omitempty"`
+	Stream          bool                 `protobuf:"varint,9,opt,name=stream,proto3" json:"stream,omitempty"`
+}
+
+func (x *RequestInvokeLLM) Reset() {
+	*x = RequestInvokeLLM{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestInvokeLLM) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestInvokeLLM) ProtoMessage() {}
+
+func (x *RequestInvokeLLM) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestInvokeLLM.ProtoReflect.Descriptor instead.
+func (*RequestInvokeLLM) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RequestInvokeLLM) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RequestInvokeLLM) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *RequestInvokeLLM) GetModelType() string {
+	if x != nil {
+		return x.ModelType
+	}
+	return ""
+}
+
+func (x *RequestInvokeLLM) GetCredentials() *structpb.Struct {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *RequestInvokeLLM) GetModelParameters() *structpb.Struct {
+	if x != nil {
+		return x.ModelParameters
+	}
+	return nil
+}
+
+func (x *RequestInvokeLLM) GetPromptMessages() []*PromptMessage {
+	if x != nil {
+		return x.PromptMessages
+	}
+	return nil
+}
+
+func (x *RequestInvokeLLM) GetTools() []*PromptMessageTool {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+func (x *RequestInvokeLLM) GetStop() []string {
+	if x != nil {
+		return x.Stop
+	}
+	return nil
+}
+
+func (x *RequestInvokeLLM) GetStream() bool {
+	if x != nil {
+		return x.Stream
+	}
+	return false
+}
+
+type RequestGetLLMNumTokens struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider       string               `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Model          string               `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	ModelType      string               `protobuf:"bytes,3,opt,name=model_type,json=modelType,proto3" json:"model_type,omitempty"`
+	Credentials    *structpb.Struct     `protobuf:"bytes,4,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	PromptMessages []*PromptMessage     `protobuf:"bytes,5,rep,name=prompt_messages,json=promptMessages,proto3" json:"prompt_messages,omitempty"`
+	Tools          []*PromptMessageTool `protobuf:"bytes,6,rep,name=tools,proto3" json:"tools,omitempty"`
+}
+
+func (x *RequestGetLLMNumTokens) Reset() {
+	*x = RequestGetLLMNumTokens{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestGetLLMNumTokens) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestGetLLMNumTokens) ProtoMessage() {}
+
+func (x *RequestGetLLMNumTokens) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestGetLLMNumTokens.ProtoReflect.Descriptor instead.
+func (*RequestGetLLMNumTokens) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RequestGetLLMNumTokens) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RequestGetLLMNumTokens) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *RequestGetLLMNumTokens) GetModelType() string {
+	if x != nil {
+		return x.ModelType
+	}
+	return ""
+}
+
+func (x *RequestGetLLMNumTokens) GetCredentials() *structpb.Struct {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *RequestGetLLMNumTokens) GetPromptMessages() []*PromptMessage {
+	if x != nil {
+		return x.PromptMessages
+	}
+	return nil
+}
+
+func (x *RequestGetLLMNumTokens) GetTools() []*PromptMessageTool {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+type RequestInvokeTextEmbedding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string           `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Model       string           `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	ModelType   string           `protobuf:"bytes,3,opt,name=model_type,json=modelType,proto3" json:"model_type,omitempty"`
+	Credentials *structpb.Struct `protobuf:"bytes,4,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Texts       []string         `protobuf:"bytes,5,rep,name=texts,proto3" json:"texts,omitempty"`
+	InputType   string           `protobuf:"bytes,6,opt,name=input_type,json=inputType,proto3" json:"input_type,omitempty"`
+}
+
+func (x *RequestInvokeTextEmbedding) Reset() {
+	*x = RequestInvokeTextEmbedding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestInvokeTextEmbedding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestInvokeTextEmbedding) ProtoMessage() {}
+
+func (x *RequestInvokeTextEmbedding) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestInvokeTextEmbedding.ProtoReflect.Descriptor instead.
+func (*RequestInvokeTextEmbedding) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RequestInvokeTextEmbedding) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RequestInvokeTextEmbedding) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *RequestInvokeTextEmbedding) GetModelType() string {
+	if x != nil {
+		return x.ModelType
+	}
+	return ""
+}
+
+func (x *RequestInvokeTextEmbedding) GetCredentials() *structpb.Struct {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *RequestInvokeTextEmbedding) GetTexts() []string {
+	if x != nil {
+		return x.Texts
+	}
+	return nil
+}
+
+func (x *RequestInvokeTextEmbedding) GetInputType() string {
+	if x != nil {
+		return x.InputType
+	}
+	return ""
+}
+
+type RequestGetTextEmbeddingNumTokens struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string           `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Model       string           `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	ModelType   string           `protobuf:"bytes,3,opt,name=model_type,json=modelType,proto3" json:"model_type,omitempty"`
+	Credentials *structpb.Struct `protobuf:"bytes,4,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Texts       []string         `protobuf:"bytes,5,rep,name=texts,proto3" json:"texts,omitempty"`
+}
+
+func (x *RequestGetTextEmbeddingNumTokens) Reset() {
+	*x = RequestGetTextEmbeddingNumTokens{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestGetTextEmbeddingNumTokens) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestGetTextEmbeddingNumTokens) ProtoMessage() {}
+
+func (x *RequestGetTextEmbeddingNumTokens) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestGetTextEmbeddingNumTokens.ProtoReflect.Descriptor instead.
+func (*RequestGetTextEmbeddingNumTokens) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RequestGetTextEmbeddingNumTokens) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RequestGetTextEmbeddingNumTokens) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *RequestGetTextEmbeddingNumTokens) GetModelType() string {
+	if x != nil {
+		return x.ModelType
+	}
+	return ""
+}
+
+func (x *RequestGetTextEmbeddingNumTokens) GetCredentials() *structpb.Struct {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *RequestGetTextEmbeddingNumTokens) GetTexts() []string {
+	if x != nil {
+		return x.Texts
+	}
+	return nil
+}
+
+type RequestInvokeRerank struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider       string           `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Model          string           `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	ModelType      string           `protobuf:"bytes,3,opt,name=model_type,json=modelType,proto3" json:"model_type,omitempty"`
+	Credentials    *structpb.Struct `protobuf:"bytes,4,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Query          string           `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`
+	Docs           []string         `protobuf:"bytes,6,rep,name=docs,proto3" json:"docs,omitempty"`
+	ScoreThreshold float64          `protobuf:"fixed64,7,opt,name=score_threshold,json=scoreThreshold,proto3" json:"score_threshold,omitempty"`
+	TopN           int32            `protobuf:"varint,8,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
+}
+
+func (x *RequestInvokeRerank) Reset() {
+	*x = RequestInvokeRerank{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestInvokeRerank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestInvokeRerank) ProtoMessage() {}
+
+func (x *RequestInvokeRerank) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestInvokeRerank.ProtoReflect.Descriptor instead.
+func (*RequestInvokeRerank) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RequestInvokeRerank) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RequestInvokeRerank) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *RequestInvokeRerank) GetModelType() string {
+	if x != nil {
+		return x.ModelType
+	}
+	return ""
+}
+
+func (x *RequestInvokeRerank) GetCredentials() *structpb.Struct {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *RequestInvokeRerank) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *RequestInvokeRerank) GetDocs() []string {
+	if x != nil {
+		return x.Docs
+	}
+	return nil
+}
+
+func (x *RequestInvokeRerank) GetScoreThreshold() float64 {
+	if x != nil {
+		return x.ScoreThreshold
+	}
+	return 0
+}
+
+func (x *RequestInvokeRerank) GetTopN() int32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+type RequestInvokeTTS struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string           `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Model       string           `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	ModelType   string           `protobuf:"bytes,3,opt,name=model_type,json=modelType,proto3" json:"model_type,omitempty"`
+	Credentials *structpb.Struct `protobuf:"bytes,4,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	ContentText string           `protobuf:"bytes,5,opt,name=content_text,json=contentText,proto3" json:"content_text,omitempty"`
+	Voice       string           `protobuf:"bytes,6,opt,name=voice,proto3" json:"voice,omitempty"`
+	TenantId    string           `protobuf:"bytes,7,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+}
+
+func (x *RequestInvokeTTS) Reset() {
+	*x = RequestInvokeTTS{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestInvokeTTS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestInvokeTTS) ProtoMessage() {}
+
+func (x *RequestInvokeTTS) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestInvokeTTS.ProtoReflect.Descriptor instead.
+func (*RequestInvokeTTS) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RequestInvokeTTS) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RequestInvokeTTS) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *RequestInvokeTTS) GetModelType() string {
+	if x != nil {
+		return x.ModelType
+	}
+	return ""
+}
+
+func (x *RequestInvokeTTS) GetCredentials() *structpb.Struct {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *RequestInvokeTTS) GetContentText() string {
+	if x != nil {
+		return x.ContentText
+	}
+	return ""
+}
+
+func (x *RequestInvokeTTS) GetVoice() string {
+	if x != nil {
+		return x.Voice
+	}
+	return ""
+}
+
+func (x *RequestInvokeTTS) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type RequestGetTTSModelVoices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string           `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Model       string           `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	ModelType   string           `protobuf:"bytes,3,opt,name=model_type,json=modelType,proto3" json:"model_type,omitempty"`
+	Credentials *structpb.Struct `protobuf:"bytes,4,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Language    string           `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+}
+
+func (x *RequestGetTTSModelVoices) Reset() {
+	*x = RequestGetTTSModelVoices{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestGetTTSModelVoices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestGetTTSModelVoices) ProtoMessage() {}
+
+func (x *RequestGetTTSModelVoices) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestGetTTSModelVoices.ProtoReflect.Descriptor instead.
+func (*RequestGetTTSModelVoices) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RequestGetTTSModelVoices) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RequestGetTTSModelVoices) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *RequestGetTTSModelVoices) GetModelType() string {
+	if x != nil {
+		return x.ModelType
+	}
+	return ""
+}
+
+func (x *RequestGetTTSModelVoices) GetCredentials() *structpb.Struct {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *RequestGetTTSModelVoices) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type RequestInvokeSpeech2Text struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string           `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Model       string           `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	ModelType   string           `protobuf:"bytes,3,opt,name=model_type,json=modelType,proto3" json:"model_type,omitempty"`
+	Credentials *structpb.Struct `protobuf:"bytes,4,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	// hex encoded audio file
+	File string `protobuf:"bytes,5,opt,name=file,proto3" json:"file,omitempty"`
+}
+
+func (x *RequestInvokeSpeech2Text) Reset() {
+	*x = RequestInvokeSpeech2Text{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestInvokeSpeech2Text) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestInvokeSpeech2Text) ProtoMessage() {}
+
+func (x *RequestInvokeSpeech2Text) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestInvokeSpeech2Text.ProtoReflect.Descriptor instead.
+func (*RequestInvokeSpeech2Text) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RequestInvokeSpeech2Text) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RequestInvokeSpeech2Text) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *RequestInvokeSpeech2Text) GetModelType() string {
+	if x != nil {
+		return x.ModelType
+	}
+	return ""
+}
+
+func (x *RequestInvokeSpeech2Text) GetCredentials() *structpb.Struct {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *RequestInvokeSpeech2Text) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+type RequestInvokeModeration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string           `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Model       string           `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	ModelType   string           `protobuf:"bytes,3,opt,name=model_type,json=modelType,proto3" json:"model_type,omitempty"`
+	Credentials *structpb.Struct `protobuf:"bytes,4,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Text        string           `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *RequestInvokeModeration) Reset() {
+	*x = RequestInvokeModeration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestInvokeModeration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestInvokeModeration) ProtoMessage() {}
+
+func (x *RequestInvokeModeration) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestInvokeModeration.ProtoReflect.Descriptor instead.
+func (*RequestInvokeModeration) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RequestInvokeModeration) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RequestInvokeModeration) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *RequestInvokeModeration) GetModelType() string {
+	if x != nil {
+		return x.ModelType
+	}
+	return ""
+}
+
+func (x *RequestInvokeModeration) GetCredentials() *structpb.Struct {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *RequestInvokeModeration) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type RequestValidateProviderCredentials struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string           `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Credentials *structpb.Struct `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (x *RequestValidateProviderCredentials) Reset() {
+	*x = RequestValidateProviderCredentials{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestValidateProviderCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestValidateProviderCredentials) ProtoMessage() {}
+
+func (x *RequestValidateProviderCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestValidateProviderCredentials.ProtoReflect.Descriptor instead.
+func (*RequestValidateProviderCredentials) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RequestValidateProviderCredentials) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RequestValidateProviderCredentials) GetCredentials() *structpb.Struct {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+type RequestValidateModelCredentials struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string           `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Model       string           `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	ModelType   string           `protobuf:"bytes,3,opt,name=model_type,json=modelType,proto3" json:"model_type,omitempty"`
+	Credentials *structpb.Struct `protobuf:"bytes,4,opt,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (x *RequestValidateModelCredentials) Reset() {
+	*x = RequestValidateModelCredentials{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestValidateModelCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestValidateModelCredentials) ProtoMessage() {}
+
+func (x *RequestValidateModelCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestValidateModelCredentials.ProtoReflect.Descriptor instead.
+func (*RequestValidateModelCredentials) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RequestValidateModelCredentials) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RequestValidateModelCredentials) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *RequestValidateModelCredentials) GetModelType() string {
+	if x != nil {
+		return x.ModelType
+	}
+	return ""
+}
+
+func (x *RequestValidateModelCredentials) GetCredentials() *structpb.Struct {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+type RequestGetAIModelSchema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string           `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Model       string           `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	ModelType   string           `protobuf:"bytes,3,opt,name=model_type,json=modelType,proto3" json:"model_type,omitempty"`
+	Credentials *structpb.Struct `protobuf:"bytes,4,opt,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (x *RequestGetAIModelSchema) Reset() {
+	*x = RequestGetAIModelSchema{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestGetAIModelSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestGetAIModelSchema) ProtoMessage() {}
+
+func (x *RequestGetAIModelSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestGetAIModelSchema.ProtoReflect.Descriptor instead.
+func (*RequestGetAIModelSchema) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RequestGetAIModelSchema) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RequestGetAIModelSchema) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *RequestGetAIModelSchema) GetModelType() string {
+	if x != nil {
+		return x.ModelType
+	}
+	return ""
+}
+
+func (x *RequestGetAIModelSchema) GetCredentials() *structpb.Struct {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+type InvokeToolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context *InvokeContext     `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Data    *RequestInvokeTool `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *InvokeToolRequest) Reset() {
+	*x = InvokeToolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvokeToolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeToolRequest) ProtoMessage() {}
+
+func (x *InvokeToolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeToolRequest.ProtoReflect.Descriptor instead.
+func (*InvokeToolRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *InvokeToolRequest) GetContext() *InvokeContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *InvokeToolRequest) GetData() *RequestInvokeTool {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ValidateToolCredentialsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context *InvokeContext                  `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Data    *RequestValidateToolCredentials `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ValidateToolCredentialsRequest) Reset() {
+	*x = ValidateToolCredentialsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateToolCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateToolCredentialsRequest) ProtoMessage() {}
+
+func (x *ValidateToolCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateToolCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*ValidateToolCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ValidateToolCredentialsRequest) GetContext() *InvokeContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ValidateToolCredentialsRequest) GetData() *RequestValidateToolCredentials {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetToolRuntimeParametersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context *InvokeContext                   `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Data    *RequestGetToolRuntimeParameters `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetToolRuntimeParametersRequest) Reset() {
+	*x = GetToolRuntimeParametersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetToolRuntimeParametersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetToolRuntimeParametersRequest) ProtoMessage() {}
+
+func (x *GetToolRuntimeParametersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetToolRuntimeParametersRequest.ProtoReflect.Descriptor instead.
+func (*GetToolRuntimeParametersRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetToolRuntimeParametersRequest) GetContext() *InvokeContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetToolRuntimeParametersRequest) GetData() *RequestGetToolRuntimeParameters {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type InvokeAgentStrategyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context *InvokeContext              `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Data    *RequestInvokeAgentStrategy `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *InvokeAgentStrategyRequest) Reset() {
+	*x = InvokeAgentStrategyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvokeAgentStrategyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeAgentStrategyRequest) ProtoMessage() {}
+
+func (x *InvokeAgentStrategyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeAgentStrategyRequest.ProtoReflect.Descriptor instead.
+func (*InvokeAgentStrategyRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *InvokeAgentStrategyRequest) GetContext() *InvokeContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *InvokeAgentStrategyRequest) GetData() *RequestInvokeAgentStrategy {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type InvokeLLMRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context *InvokeContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Data    *RequestInvokeLLM `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *InvokeLLMRequest) Reset() {
+	*x = InvokeLLMRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvokeLLMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeLLMRequest) ProtoMessage() {}
+
+func (x *InvokeLLMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeLLMRequest.ProtoReflect.Descriptor instead.
+func (*InvokeLLMRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *InvokeLLMRequest) GetContext() *InvokeContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *InvokeLLMRequest) GetData() *RequestInvokeLLM {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetLLMNumTokensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context *InvokeContext          `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Data    *RequestGetLLMNumTokens `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetLLMNumTokensRequest) Reset() {
+	*x = GetLLMNumTokensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLLMNumTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLLMNumTokensRequest) ProtoMessage() {}
+
+func (x *GetLLMNumTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLLMNumTokensRequest.ProtoReflect.Descriptor instead.
+func (*GetLLMNumTokensRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetLLMNumTokensRequest) GetContext() *InvokeContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetLLMNumTokensRequest) GetData() *RequestGetLLMNumTokens {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type InvokeTextEmbeddingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context *InvokeContext              `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Data    *RequestInvokeTextEmbedding `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *InvokeTextEmbeddingRequest) Reset() {
+	*x = InvokeTextEmbeddingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvokeTextEmbeddingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeTextEmbeddingRequest) ProtoMessage() {}
+
+func (x *InvokeTextEmbeddingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeTextEmbeddingRequest.ProtoReflect.Descriptor instead.
+func (*InvokeTextEmbeddingRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *InvokeTextEmbeddingRequest) GetContext() *InvokeContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *InvokeTextEmbeddingRequest) GetData() *RequestInvokeTextEmbedding {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetTextEmbeddingNumTokensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context *InvokeContext                    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Data    *RequestGetTextEmbeddingNumTokens `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetTextEmbeddingNumTokensRequest) Reset() {
+	*x = GetTextEmbeddingNumTokensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTextEmbeddingNumTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTextEmbeddingNumTokensRequest) ProtoMessage() {}
+
+func (x *GetTextEmbeddingNumTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTextEmbeddingNumTokensRequest.ProtoReflect.Descriptor instead.
+func (*GetTextEmbeddingNumTokensRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetTextEmbeddingNumTokensRequest) GetContext() *InvokeContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetTextEmbeddingNumTokensRequest) GetData() *RequestGetTextEmbeddingNumTokens {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type InvokeRerankRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context *InvokeContext       `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Data    *RequestInvokeRerank `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *InvokeRerankRequest) Reset() {
+	*x = InvokeRerankRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvokeRerankRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeRerankRequest) ProtoMessage() {}
+
+func (x *InvokeRerankRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeRerankRequest.ProtoReflect.Descriptor instead.
+func (*InvokeRerankRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *InvokeRerankRequest) GetContext() *InvokeContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *InvokeRerankRequest) GetData() *RequestInvokeRerank {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type InvokeTTSRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context *InvokeContext    `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Data    *RequestInvokeTTS `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *InvokeTTSRequest) Reset() {
+	*x = InvokeTTSRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvokeTTSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeTTSRequest) ProtoMessage() {}
+
+func (x *InvokeTTSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeTTSRequest.ProtoReflect.Descriptor instead.
+func (*InvokeTTSRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *InvokeTTSRequest) GetContext() *InvokeContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *InvokeTTSRequest) GetData() *RequestInvokeTTS {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetTTSModelVoicesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context *InvokeContext            `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Data    *RequestGetTTSModelVoices `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetTTSModelVoicesRequest) Reset() {
+	*x = GetTTSModelVoicesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTTSModelVoicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTTSModelVoicesRequest) ProtoMessage() {}
+
+func (x *GetTTSModelVoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTTSModelVoicesRequest.ProtoReflect.Descriptor instead.
+func (*GetTTSModelVoicesRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetTTSModelVoicesRequest) GetContext() *InvokeContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetTTSModelVoicesRequest) GetData() *RequestGetTTSModelVoices {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type InvokeSpeech2TextRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context *InvokeContext            `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Data    *RequestInvokeSpeech2Text `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *InvokeSpeech2TextRequest) Reset() {
+	*x = InvokeSpeech2TextRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvokeSpeech2TextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeSpeech2TextRequest) ProtoMessage() {}
+
+func (x *InvokeSpeech2TextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeSpeech2TextRequest.ProtoReflect.Descriptor instead.
+func (*InvokeSpeech2TextRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *InvokeSpeech2TextRequest) GetContext() *InvokeContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *InvokeSpeech2TextRequest) GetData() *RequestInvokeSpeech2Text {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type InvokeModerationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context *InvokeContext           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Data    *RequestInvokeModeration `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *InvokeModerationRequest) Reset() {
+	*x = InvokeModerationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvokeModerationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeModerationRequest) ProtoMessage() {}
+
+func (x *InvokeModerationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeModerationRequest.ProtoReflect.Descriptor instead.
+func (*InvokeModerationRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *InvokeModerationRequest) GetContext() *InvokeContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *InvokeModerationRequest) GetData() *RequestInvokeModeration {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ValidateProviderCredentialsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context *InvokeContext                      `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Data    *RequestValidateProviderCredentials `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ValidateProviderCredentialsRequest) Reset() {
+	*x = ValidateProviderCredentialsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateProviderCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateProviderCredentialsRequest) ProtoMessage() {}
+
+func (x *ValidateProviderCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateProviderCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*ValidateProviderCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ValidateProviderCredentialsRequest) GetContext() *InvokeContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ValidateProviderCredentialsRequest) GetData() *RequestValidateProviderCredentials {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ValidateModelCredentialsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context *InvokeContext                   `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Data    *RequestValidateModelCredentials `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ValidateModelCredentialsRequest) Reset() {
+	*x = ValidateModelCredentialsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateModelCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateModelCredentialsRequest) ProtoMessage() {}
+
+func (x *ValidateModelCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateModelCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*ValidateModelCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ValidateModelCredentialsRequest) GetContext() *InvokeContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ValidateModelCredentialsRequest) GetData() *RequestValidateModelCredentials {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetAIModelSchemaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context *InvokeContext           `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Data    *RequestGetAIModelSchema `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetAIModelSchemaRequest) Reset() {
+	*x = GetAIModelSchemaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAIModelSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAIModelSchemaRequest) ProtoMessage() {}
+
+func (x *GetAIModelSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAIModelSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetAIModelSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetAIModelSchemaRequest) GetContext() *InvokeContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetAIModelSchemaRequest) GetData() *RequestGetAIModelSchema {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ToolResponseChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    string           `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Message *structpb.Struct `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Meta    *structpb.Struct `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (x *ToolResponseChunk) Reset() {
+	*x = ToolResponseChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToolResponseChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolResponseChunk) ProtoMessage() {}
+
+func (x *ToolResponseChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolResponseChunk.ProtoReflect.Descriptor instead.
+func (*ToolResponseChunk) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ToolResponseChunk) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ToolResponseChunk) GetMessage() *structpb.Struct {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *ToolResponseChunk) GetMeta() *structpb.Struct {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type ValidateCredentialsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ValidateCredentialsResult) Reset() {
+	*x = ValidateCredentialsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateCredentialsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateCredentialsResult) ProtoMessage() {}
+
+func (x *ValidateCredentialsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateCredentialsResult.ProtoReflect.Descriptor instead.
+func (*ValidateCredentialsResult) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ValidateCredentialsResult) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+type GetToolRuntimeParametersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Parameters []*structpb.Struct `protobuf:"bytes,1,rep,name=parameters,proto3" json:"parameters,omitempty"`
+}
+
+func (x *GetToolRuntimeParametersResponse) Reset() {
+	*x = GetToolRuntimeParametersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetToolRuntimeParametersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetToolRuntimeParametersResponse) ProtoMessage() {}
+
+func (x *GetToolRuntimeParametersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetToolRuntimeParametersResponse.ProtoReflect.Descriptor instead.
+func (*GetToolRuntimeParametersResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetToolRuntimeParametersResponse) GetParameters() []*structpb.Struct {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+type LLMUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PromptTokens        int64   `protobuf:"varint,1,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	PromptUnitPrice     string  `protobuf:"bytes,2,opt,name=prompt_unit_price,json=promptUnitPrice,proto3" json:"prompt_unit_price,omitempty"`
+	PromptPriceUnit     string  `protobuf:"bytes,3,opt,name=prompt_price_unit,json=promptPriceUnit,proto3" json:"prompt_price_unit,omitempty"`
+	PromptPrice         string  `protobuf:"bytes,4,opt,name=prompt_price,json=promptPrice,proto3" json:"prompt_price,omitempty"`
+	CompletionTokens    int64   `protobuf:"varint,5,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	CompletionUnitPrice string  `protobuf:"bytes,6,opt,name=completion_unit_price,json=completionUnitPrice,proto3" json:"completion_unit_price,omitempty"`
+	CompletionPriceUnit string  `protobuf:"bytes,7,opt,name=completion_price_unit,json=completionPriceUnit,proto3" json:"completion_price_unit,omitempty"`
+	CompletionPrice     string  `protobuf:"bytes,8,opt,name=completion_price,json=completionPrice,proto3" json:"completion_price,omitempty"`
+	TotalTokens         int64   `protobuf:"varint,9,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`
+	TotalPrice          string  `protobuf:"bytes,10,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	Currency            string  `protobuf:"bytes,11,opt,name=currency,proto3" json:"currency,omitempty"`
+	Latency             float64 `protobuf:"fixed64,12,opt,name=latency,proto3" json:"latency,omitempty"`
+}
+
+func (x *LLMUsage) Reset() {
+	*x = LLMUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LLMUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LLMUsage) ProtoMessage() {}
+
+func (x *LLMUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LLMUsage.ProtoReflect.Descriptor instead.
+func (*LLMUsage) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *LLMUsage) GetPromptTokens() int64 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *LLMUsage) GetPromptUnitPrice() string {
+	if x != nil {
+		return x.PromptUnitPrice
+	}
+	return ""
+}
+
+func (x *LLMUsage) GetPromptPriceUnit() string {
+	if x != nil {
+		return x.PromptPriceUnit
+	}
+	return ""
+}
+
+func (x *LLMUsage) GetPromptPrice() string {
+	if x != nil {
+		return x.PromptPrice
+	}
+	return ""
+}
+
+func (x *LLMUsage) GetCompletionTokens() int64 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *LLMUsage) GetCompletionUnitPrice() string {
+	if x != nil {
+		return x.CompletionUnitPrice
+	}
+	return ""
+}
+
+func (x *LLMUsage) GetCompletionPriceUnit() string {
+	if x != nil {
+		return x.CompletionPriceUnit
+	}
+	return ""
+}
+
+func (x *LLMUsage) GetCompletionPrice() string {
+	if x != nil {
+		return x.CompletionPrice
+	}
+	return ""
+}
+
+func (x *LLMUsage) GetTotalTokens() int64 {
+	if x != nil {
+		return x.TotalTokens
+	}
+	return 0
+}
+
+func (x *LLMUsage) GetTotalPrice() string {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return ""
+}
+
+func (x *LLMUsage) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *LLMUsage) GetLatency() float64 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+type LLMResultChunkDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index        int64          `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Message      *PromptMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Usage        *LLMUsage      `protobuf:"bytes,3,opt,name=usage,proto3" json:"usage,omitempty"`
+	FinishReason *string        `protobuf:"bytes,4,opt,name=finish_reason,json=finishReason,proto3,oneof" json:"finish_reason,omitempty"`
+}
+
+func (x *LLMResultChunkDelta) Reset() {
+	*x = LLMResultChunkDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LLMResultChunkDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LLMResultChunkDelta) ProtoMessage() {}
+
+func (x *LLMResultChunkDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LLMResultChunkDelta.ProtoReflect.Descriptor instead.
+func (*LLMResultChunkDelta) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LLMResultChunkDelta) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *LLMResultChunkDelta) GetMessage() *PromptMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *LLMResultChunkDelta) GetUsage() *LLMUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *LLMResultChunkDelta) GetFinishReason() string {
+	if x != nil && x.FinishReason != nil {
+		return *x.FinishReason
+	}
+	return ""
+}
+
+type LLMResultChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Model             string               `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	PromptMessages    []*PromptMessage     `protobuf:"bytes,2,rep,name=prompt_messages,json=promptMessages,proto3" json:"prompt_messages,omitempty"`
+	SystemFingerprint string               `protobuf:"bytes,3,opt,name=system_fingerprint,json=systemFingerprint,proto3" json:"system_fingerprint,omitempty"`
+	Delta             *LLMResultChunkDelta `protobuf:"bytes,4,opt,name=delta,proto3" json:"delta,omitempty"`
+}
+
+func (x *LLMResultChunk) Reset() {
+	*x = LLMResultChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LLMResultChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LLMResultChunk) ProtoMessage() {}
+
+func (x *LLMResultChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LLMResultChunk.ProtoReflect.Descriptor instead.
+func (*LLMResultChunk) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LLMResultChunk) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *LLMResultChunk) GetPromptMessages() []*PromptMessage {
+	if x != nil {
+		return x.PromptMessages
+	}
+	return nil
+}
+
+func (x *LLMResultChunk) GetSystemFingerprint() string {
+	if x != nil {
+		return x.SystemFingerprint
+	}
+	return ""
+}
+
+func (x *LLMResultChunk) GetDelta() *LLMResultChunkDelta {
+	if x != nil {
+		return x.Delta
+	}
+	return nil
+}
+
+type LLMGetNumTokensResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumTokens int64 `protobuf:"varint,1,opt,name=num_tokens,json=numTokens,proto3" json:"num_tokens,omitempty"`
+}
+
+func (x *LLMGetNumTokensResponse) Reset() {
+	*x = LLMGetNumTokensResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LLMGetNumTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LLMGetNumTokensResponse) ProtoMessage() {}
+
+func (x *LLMGetNumTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LLMGetNumTokensResponse.ProtoReflect.Descriptor instead.
+func (*LLMGetNumTokensResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *LLMGetNumTokensResponse) GetNumTokens() int64 {
+	if x != nil {
+		return x.NumTokens
+	}
+	return 0
+}
+
+type EmbeddingUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tokens      int64   `protobuf:"varint,1,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	TotalTokens int64   `protobuf:"varint,2,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`
+	UnitPrice   string  `protobuf:"bytes,3,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	PriceUnit   string  `protobuf:"bytes,4,opt,name=price_unit,json=priceUnit,proto3" json:"price_unit,omitempty"`
+	TotalPrice  string  `protobuf:"bytes,5,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	Currency    string  `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	Latency     float64 `protobuf:"fixed64,7,opt,name=latency,proto3" json:"latency,omitempty"`
+}
+
+func (x *EmbeddingUsage) Reset() {
+	*x = EmbeddingUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmbeddingUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmbeddingUsage) ProtoMessage() {}
+
+func (x *EmbeddingUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmbeddingUsage.ProtoReflect.Descriptor instead.
+func (*EmbeddingUsage) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *EmbeddingUsage) GetTokens() int64 {
+	if x != nil {
+		return x.Tokens
+	}
+	return 0
+}
+
+func (x *EmbeddingUsage) GetTotalTokens() int64 {
+	if x != nil {
+		return x.TotalTokens
+	}
+	return 0
+}
+
+func (x *EmbeddingUsage) GetUnitPrice() string {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return ""
+}
+
+func (x *EmbeddingUsage) GetPriceUnit() string {
+	if x != nil {
+		return x.PriceUnit
+	}
+	return ""
+}
+
+func (x *EmbeddingUsage) GetTotalPrice() string {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return ""
+}
+
+func (x *EmbeddingUsage) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *EmbeddingUsage) GetLatency() float64 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+type TextEmbeddingResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Model string `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	// a list of numbers per text
+	Embeddings []*structpb.ListValue `protobuf:"bytes,2,rep,name=embeddings,proto3" json:"embeddings,omitempty"`
+	Usage      *EmbeddingUsage       `protobuf:"bytes,3,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (x *TextEmbeddingResult) Reset() {
+	*x = TextEmbeddingResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TextEmbeddingResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextEmbeddingResult) ProtoMessage() {}
+
+func (x *TextEmbeddingResult) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextEmbeddingResult.ProtoReflect.Descriptor instead.
+func (*TextEmbeddingResult) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TextEmbeddingResult) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *TextEmbeddingResult) GetEmbeddings() []*structpb.ListValue {
+	if x != nil {
+		return x.Embeddings
+	}
+	return nil
+}
+
+func (x *TextEmbeddingResult) GetUsage() *EmbeddingUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+type GetTextEmbeddingNumTokensResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumTokens []int64 `protobuf:"varint,1,rep,packed,name=num_tokens,json=numTokens,proto3" json:"num_tokens,omitempty"`
+}
+
+func (x *GetTextEmbeddingNumTokensResponse) Reset() {
+	*x = GetTextEmbeddingNumTokensResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTextEmbeddingNumTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTextEmbeddingNumTokensResponse) ProtoMessage() {}
+
+func (x *GetTextEmbeddingNumTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTextEmbeddingNumTokensResponse.ProtoReflect.Descriptor instead.
+func (*GetTextEmbeddingNumTokensResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetTextEmbeddingNumTokensResponse) GetNumTokens() []int64 {
+	if x != nil {
+		return x.NumTokens
+	}
+	return nil
+}
+
+type RerankDocument struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index int64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Text  string  `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Score float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *RerankDocument) Reset() {
+	*x = RerankDocument{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RerankDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RerankDocument) ProtoMessage() {}
+
+func (x *RerankDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RerankDocument.ProtoReflect.Descriptor instead.
+func (*RerankDocument) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RerankDocument) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *RerankDocument) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *RerankDocument) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type RerankResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Model string            `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	Docs  []*RerankDocument `protobuf:"bytes,2,rep,name=docs,proto3" json:"docs,omitempty"`
+}
+
+func (x *RerankResult) Reset() {
+	*x = RerankResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RerankResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RerankResult) ProtoMessage() {}
+
+func (x *RerankResult) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RerankResult.ProtoReflect.Descriptor instead.
+func (*RerankResult) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RerankResult) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *RerankResult) GetDocs() []*RerankDocument {
+	if x != nil {
+		return x.Docs
+	}
+	return nil
+}
+
+type TTSResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// hex encoded audio chunk
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *TTSResult) Reset() {
+	*x = TTSResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TTSResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TTSResult) ProtoMessage() {}
+
+func (x *TTSResult) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TTSResult.ProtoReflect.Descriptor instead.
+func (*TTSResult) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *TTSResult) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type TTSModelVoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *TTSModelVoice) Reset() {
+	*x = TTSModelVoice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TTSModelVoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TTSModelVoice) ProtoMessage() {}
+
+func (x *TTSModelVoice) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TTSModelVoice.ProtoReflect.Descriptor instead.
+func (*TTSModelVoice) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *TTSModelVoice) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TTSModelVoice) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type GetTTSVoicesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Voices []*TTSModelVoice `protobuf:"bytes,1,rep,name=voices,proto3" json:"voices,omitempty"`
+}
+
+func (x *GetTTSVoicesResponse) Reset() {
+	*x = GetTTSVoicesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTTSVoicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTTSVoicesResponse) ProtoMessage() {}
+
+func (x *GetTTSVoicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTTSVoicesResponse.ProtoReflect.Descriptor instead.
+func (*GetTTSVoicesResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetTTSVoicesResponse) GetVoices() []*TTSModelVoice {
+	if x != nil {
+		return x.Voices
+	}
+	return nil
+}
+
+type Speech2TextResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *Speech2TextResult) Reset() {
+	*x = Speech2TextResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Speech2TextResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Speech2TextResult) ProtoMessage() {}
+
+func (x *Speech2TextResult) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Speech2TextResult.ProtoReflect.Descriptor instead.
+func (*Speech2TextResult) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Speech2TextResult) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type ModerationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ModerationResult) Reset() {
+	*x = ModerationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModerationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationResult) ProtoMessage() {}
+
+func (x *ModerationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationResult.ProtoReflect.Descriptor instead.
+func (*ModerationResult) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ModerationResult) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+type GetModelSchemasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModelSchema *structpb.Struct `protobuf:"bytes,1,opt,name=model_schema,json=modelSchema,proto3" json:"model_schema,omitempty"`
+}
+
+func (x *GetModelSchemasResponse) Reset() {
+	*x = GetModelSchemasResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetModelSchemasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModelSchemasResponse) ProtoMessage() {}
+
+func (x *GetModelSchemasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModelSchemasResponse.ProtoReflect.Descriptor instead.
+func (*GetModelSchemasResponse) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetModelSchemasResponse) GetModelSchema() *structpb.Struct {
+	if x != nil {
+		return x.ModelSchema
+	}
+	return nil
+}
+
+type PromptMessageToolCall_Function struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Arguments string `protobuf:"bytes,2,opt,name=arguments,proto3" json:"arguments,omitempty"`
+}
+
+func (x *PromptMessageToolCall_Function) Reset() {
+	*x = PromptMessageToolCall_Function{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromptMessageToolCall_Function) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptMessageToolCall_Function) ProtoMessage() {}
+
+func (x *PromptMessageToolCall_Function) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_daemon_v1_dispatch_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptMessageToolCall_Function.ProtoReflect.Descriptor instead.
+func (*PromptMessageToolCall_Function) Descriptor() ([]byte, []int) {
+	return file_plugin_daemon_v1_dispatch_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *PromptMessageToolCall_Function) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PromptMessageToolCall_Function) GetArguments() string {
+	if x != nil {
+		return x.Arguments
+	}
+	return ""
+}
+
+var File_plugin_daemon_v1_dispatch_proto protoreflect.FileDescriptor
+
+var file_plugin_daemon_v1_dispatch_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f,
+	0x76, 0x31, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x10, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x97, 0x02, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x0f, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06, 0x61,
+	0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x05, 0x61,
+	0x70, 0x70, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0a,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a,
+	0x10, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0xc7, 0x01, 0x0a, 0x15,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6f,
+	0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x4c, 0x0a, 0x08, 0x66, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6f, 0x6c,
+	0x43, 0x61, 0x6c, 0x6c, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x66,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3c, 0x0a, 0x08, 0x46, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x72, 0x67, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xd3, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x46, 0x0a, 0x0a, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6f, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x09,
+	0x74, 0x6f, 0x6f, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6f, 0x6f,
+	0x6c, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x74, 0x6f, 0x6f, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x11,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6f,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x22, 0xc0, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f,
+	0x6b, 0x65, 0x54, 0x6f, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x12, 0x40, 0x0a, 0x0f, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0e, 0x74, 0x6f, 0x6f, 0x6c, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x22, 0x77, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6f, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52,
+	0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0x8c, 0x01, 0x0a,
+	0x1f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6f, 0x6c, 0x52,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6c,
+	0x12, 0x39, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0b,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x1a,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x4b, 0x0a, 0x15, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x13, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x93, 0x03, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x4c, 0x4d, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0b,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x42, 0x0a, 0x10, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x48, 0x0a, 0x0f, 0x70,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6f, 0x6c, 0x52, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x73, 0x74, 0x6f, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0xa9, 0x02, 0x0a,
+	0x16, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x65, 0x74, 0x4c, 0x4c, 0x4d, 0x4e, 0x75,
+	0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x12, 0x48, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x70,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x39, 0x0a,
+	0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6f,
+	0x6c, 0x52, 0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x65, 0x78, 0x74, 0x45, 0x6d,
+	0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x78, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x65, 0x78, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x20, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x47, 0x65, 0x74, 0x54, 0x65, 0x78, 0x74, 0x45, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39,
+	0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0b, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x78,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x65, 0x78, 0x74, 0x73, 0x22,
+	0x89, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x6b,
+	0x65, 0x52, 0x65, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x63,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x6f, 0x63, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x5f, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x4e, 0x22, 0xf4, 0x01, 0x0a, 0x10,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x54, 0x53,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52,
+	0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0xc2, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x65,
+	0x74, 0x54, 0x54, 0x53, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x39, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0b,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x32,
+	0x54, 0x65, 0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x69, 0x6c, 0x65, 0x22, 0xb9, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52,
+	0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x22, 0x7b, 0x0a, 0x22, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0xad, 0x01,
+	0x0a, 0x1f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0xa5, 0x01,
+	0x0a, 0x17, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x65, 0x74, 0x41, 0x49, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x11, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65,
+	0x54, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x37, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x6f, 0x6f, 0x6c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0xa1, 0x01, 0x0a, 0x1e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6f, 0x6c,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x44, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f,
+	0x6f, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0xa3, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6f, 0x6c, 0x52,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f,
+	0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x45, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x65, 0x74, 0x54, 0x6f,
+	0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x99, 0x01, 0x0a, 0x1a, 0x49, 0x6e,
+	0x76, 0x6f, 0x6b, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76,
+	0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x40, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f,
+	0x6b, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x85, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65,
+	0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e,
+	0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x4c, 0x4d, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x91, 0x01,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x4c, 0x4d, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76,
+	0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x3c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x65, 0x74, 0x4c,
+	0x4c, 0x4d, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x99, 0x01, 0x0a, 0x1a, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x65, 0x78, 0x74,
+	0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x39, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x40, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x65, 0x78, 0x74, 0x45, 0x6d,
+	0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa5, 0x01,
+	0x0a, 0x20, 0x47, 0x65, 0x74, 0x54, 0x65, 0x78, 0x74, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69,
+	0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x46, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x65, 0x74, 0x54, 0x65, 0x78, 0x74, 0x45, 0x6d, 0x62,
+	0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x8b, 0x01, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65,
+	0x52, 0x65, 0x72, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x39, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x72, 0x61, 0x6e, 0x6b, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x85, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x54,
+	0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76,
+	0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f,
+	0x6b, 0x65, 0x54, 0x54, 0x53, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x95, 0x01, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x54, 0x54, 0x53, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x56, 0x6f, 0x69, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76,
+	0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x65, 0x74, 0x54,
+	0x54, 0x53, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x95, 0x01, 0x0a, 0x18, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x70,
+	0x65, 0x65, 0x63, 0x68, 0x32, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x39, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68,
+	0x32, 0x54, 0x65, 0x78, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x93, 0x01, 0x0a, 0x17,
+	0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f,
+	0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x3d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x6b,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0xa9, 0x01, 0x0a, 0x22, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76,
+	0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x48, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x34, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa3, 0x01,
+	0x0a, 0x1f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x45, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x93, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x49, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x39, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x3d, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x47, 0x65, 0x74, 0x41, 0x49, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x87, 0x01, 0x0a, 0x11, 0x54, 0x6f,
+	0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x6d,
+	0x65, 0x74, 0x61, 0x22, 0x33, 0x0a, 0x19, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x5b, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0a,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xe4, 0x03, 0x0a, 0x08, 0x4c, 0x4c, 0x4d, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12,
+	0x32, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x6e,
+	0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x69, 0x74, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xd4, 0x01, 0x0a,
+	0x13, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x44,
+	0x65, 0x6c, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x39, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x0d, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0c, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0xdc, 0x01, 0x0a, 0x0e, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x48, 0x0a, 0x0f,
+	0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x5f, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72,
+	0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x05, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x22, 0x38, 0x0a, 0x17, 0x4c, 0x4c, 0x4d, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0xe0, 0x01, 0x0a,
+	0x0e, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x6e,
+	0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x75, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22,
+	0x9f, 0x01, 0x0a, 0x13, 0x54, 0x65, 0x78, 0x74, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x3a, 0x0a,
+	0x0a, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x65,
+	0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x05, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x42, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x54, 0x65, 0x78, 0x74, 0x45, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x50, 0x0a, 0x0e, 0x52, 0x65, 0x72, 0x61, 0x6e, 0x6b, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x5a, 0x0a, 0x0c, 0x52, 0x65, 0x72, 0x61, 0x6e,
+	0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x34, 0x0a,
+	0x04, 0x64, 0x6f, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x72, 0x61, 0x6e, 0x6b, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x64,
+	0x6f, 0x63, 0x73, 0x22, 0x23, 0x0a, 0x09, 0x54, 0x54, 0x53, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x39, 0x0a, 0x0d, 0x54, 0x54, 0x53, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x4f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x54, 0x53, 0x56, 0x6f, 0x69,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x54, 0x53, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x06, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x11, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x32, 0x54,
+	0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x2a, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x55, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x32, 0xde, 0x0d, 0x0a, 0x0e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x44,
+	0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x12, 0x58, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x6f, 0x6b,
+	0x65, 0x54, 0x6f, 0x6f, 0x6c, 0x12, 0x23, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x54,
+	0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f,
+	0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30,
+	0x01, 0x12, 0x7a, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6f,
+	0x6c, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x30, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6f, 0x6c, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30, 0x01, 0x12, 0x83, 0x01,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x31, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x30, 0x01, 0x12, 0x6a, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x2c, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x76, 0x6f, 0x6b, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6f, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12,
+	0x53, 0x0a, 0x09, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x4c, 0x4d, 0x12, 0x22, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x30, 0x01, 0x12, 0x68, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x4c, 0x4d, 0x4e, 0x75,
+	0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x28, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x4c,
+	0x4d, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x6c,
+	0x0a, 0x13, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x65, 0x78, 0x74, 0x45, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x54,
+	0x65, 0x78, 0x74, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x45, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30, 0x01, 0x12, 0x86, 0x01, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x54, 0x65, 0x78, 0x74, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e,
+	0x67, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x32, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x65, 0x78, 0x74, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x4e, 0x75,
+	0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x78, 0x74, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69,
+	0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x57, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52,
+	0x65, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x25, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52,
+	0x65, 0x72, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x72, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30, 0x01, 0x12, 0x4e,
+	0x0a, 0x09, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x54, 0x53, 0x12, 0x22, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x54, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x54, 0x53, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30, 0x01, 0x12, 0x69,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x54, 0x53, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x56, 0x6f, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x54, 0x53, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x54, 0x53, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x66, 0x0a, 0x11, 0x49, 0x6e, 0x76,
+	0x6f, 0x6b, 0x65, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x32, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2a,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x32, 0x54,
+	0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70,
+	0x65, 0x65, 0x63, 0x68, 0x32, 0x54, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30,
+	0x01, 0x12, 0x63, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x30, 0x01, 0x12, 0x82, 0x01, 0x0a, 0x1b, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x34, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30, 0x01, 0x12, 0x7c, 0x0a, 0x18, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x31, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30, 0x01, 0x12, 0x6a, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x41, 0x49, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x29, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x49, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x6c, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2d, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2d, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_plugin_daemon_v1_dispatch_proto_rawDescOnce sync.Once
+	file_plugin_daemon_v1_dispatch_proto_rawDescData = file_plugin_daemon_v1_dispatch_proto_rawDesc
+)
+
+func file_plugin_daemon_v1_dispatch_proto_rawDescGZIP() []byte {
+	file_plugin_daemon_v1_dispatch_proto_rawDescOnce.Do(func() {
+		file_plugin_daemon_v1_dispatch_proto_rawDescData = protoimpl.X.CompressGZIP(file_plugin_daemon_v1_dispatch_proto_rawDescData)
+	})
+	return file_plugin_daemon_v1_dispatch_proto_rawDescData
+}
+
+var file_plugin_daemon_v1_dispatch_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
+var file_plugin_daemon_v1_dispatch_proto_goTypes = []any{
+	(*InvokeContext)(nil),                      // 0: plugin_daemon.v1.InvokeContext
+	(*PromptMessageToolCall)(nil),              // 1: plugin_daemon.v1.PromptMessageToolCall
+	(*PromptMessage)(nil),                      // 2: plugin_daemon.v1.PromptMessage
+	(*PromptMessageTool)(nil),                  // 3: plugin_daemon.v1.PromptMessageTool
+	(*RequestInvokeTool)(nil),                  // 4: plugin_daemon.v1.RequestInvokeTool
+	(*RequestValidateToolCredentials)(nil),     // 5: plugin_daemon.v1.RequestValidateToolCredentials
+	(*RequestGetToolRuntimeParameters)(nil),    // 6: plugin_daemon.v1.RequestGetToolRuntimeParameters
+	(*RequestInvokeAgentStrategy)(nil),         // 7: plugin_daemon.v1.RequestInvokeAgentStrategy
+	(*RequestInvokeLLM)(nil),                   // 8: plugin_daemon.v1.RequestInvokeLLM
+	(*RequestGetLLMNumTokens)(nil),             // 9: plugin_daemon.v1.RequestGetLLMNumTokens
+	(*RequestInvokeTextEmbedding)(nil),         // 10: plugin_daemon.v1.RequestInvokeTextEmbedding
+	(*RequestGetTextEmbeddingNumTokens)(nil),   // 11: plugin_daemon.v1.RequestGetTextEmbeddingNumTokens
+	(*RequestInvokeRerank)(nil),                // 12: plugin_daemon.v1.RequestInvokeRerank
+	(*RequestInvokeTTS)(nil),                   // 13: plugin_daemon.v1.RequestInvokeTTS
+	(*RequestGetTTSModelVoices)(nil),           // 14: plugin_daemon.v1.RequestGetTTSModelVoices
+	(*RequestInvokeSpeech2Text)(nil),           // 15: plugin_daemon.v1.RequestInvokeSpeech2Text
+	(*RequestInvokeModeration)(nil),            // 16: plugin_daemon.v1.RequestInvokeModeration
+	(*RequestValidateProviderCredentials)(nil), // 17: plugin_daemon.v1.RequestValidateProviderCredentials
+	(*RequestValidateModelCredentials)(nil),    // 18: plugin_daemon.v1.RequestValidateModelCredentials
+	(*RequestGetAIModelSchema)(nil),            // 19: plugin_daemon.v1.RequestGetAIModelSchema
+	(*InvokeToolRequest)(nil),                  // 20: plugin_daemon.v1.InvokeToolRequest
+	(*ValidateToolCredentialsRequest)(nil),     // 21: plugin_daemon.v1.ValidateToolCredentialsRequest
+	(*GetToolRuntimeParametersRequest)(nil),    // 22: plugin_daemon.v1.GetToolRuntimeParametersRequest
+	(*InvokeAgentStrategyRequest)(nil),         // 23: plugin_daemon.v1.InvokeAgentStrategyRequest
+	(*InvokeLLMRequest)(nil),                   // 24: plugin_daemon.v1.InvokeLLMRequest
+	(*GetLLMNumTokensRequest)(nil),             // 25: plugin_daemon.v1.GetLLMNumTokensRequest
+	(*InvokeTextEmbeddingRequest)(nil),         // 26: plugin_daemon.v1.InvokeTextEmbeddingRequest
+	(*GetTextEmbeddingNumTokensRequest)(nil),   // 27: plugin_daemon.v1.GetTextEmbeddingNumTokensRequest
+	(*InvokeRerankRequest)(nil),                // 28: plugin_daemon.v1.InvokeRerankRequest
+	(*InvokeTTSRequest)(nil),                   // 29: plugin_daemon.v1.InvokeTTSRequest
+	(*GetTTSModelVoicesRequest)(nil),           // 30: plugin_daemon.v1.GetTTSModelVoicesRequest
+	(*InvokeSpeech2TextRequest)(nil),           // 31: plugin_daemon.v1.InvokeSpeech2TextRequest
+	(*InvokeModerationRequest)(nil),            // 32: plugin_daemon.v1.InvokeModerationRequest
+	(*ValidateProviderCredentialsRequest)(nil), // 33: plugin_daemon.v1.ValidateProviderCredentialsRequest
+	(*ValidateModelCredentialsRequest)(nil),    // 34: plugin_daemon.v1.ValidateModelCredentialsRequest
+	(*GetAIModelSchemaRequest)(nil),            // 35: plugin_daemon.v1.GetAIModelSchemaRequest
+	(*ToolResponseChunk)(nil),                  // 36: plugin_daemon.v1.ToolResponseChunk
+	(*ValidateCredentialsResult)(nil),          // 37: plugin_daemon.v1.ValidateCredentialsResult
+	(*GetToolRuntimeParametersResponse)(nil),   // 38: plugin_daemon.v1.GetToolRuntimeParametersResponse
+	(*LLMUsage)(nil),                           // 39: plugin_daemon.v1.LLMUsage
+	(*LLMResultChunkDelta)(nil),                // 40: plugin_daemon.v1.LLMResultChunkDelta
+	(*LLMResultChunk)(nil),                     // 41: plugin_daemon.v1.LLMResultChunk
+	(*LLMGetNumTokensResponse)(nil),            // 42: plugin_daemon.v1.LLMGetNumTokensResponse
+	(*EmbeddingUsage)(nil),                     // 43: plugin_daemon.v1.EmbeddingUsage
+	(*TextEmbeddingResult)(nil),                // 44: plugin_daemon.v1.TextEmbeddingResult
+	(*GetTextEmbeddingNumTokensResponse)(nil),  // 45: plugin_daemon.v1.GetTextEmbeddingNumTokensResponse
+	(*RerankDocument)(nil),                     // 46: plugin_daemon.v1.RerankDocument
+	(*RerankResult)(nil),                       // 47: plugin_daemon.v1.RerankResult
+	(*TTSResult)(nil),                          // 48: plugin_daemon.v1.TTSResult
+	(*TTSModelVoice)(nil),                      // 49: plugin_daemon.v1.TTSModelVoice
+	(*GetTTSVoicesResponse)(nil),               // 50: plugin_daemon.v1.GetTTSVoicesResponse
+	(*Speech2TextResult)(nil),                  // 51: plugin_daemon.v1.Speech2TextResult
+	(*ModerationResult)(nil),                   // 52: plugin_daemon.v1.ModerationResult
+	(*GetModelSchemasResponse)(nil),            // 53: plugin_daemon.v1.GetModelSchemasResponse
+	(*PromptMessageToolCall_Function)(nil),     // 54: plugin_daemon.v1.PromptMessageToolCall.Function
+	(*structpb.Value)(nil),                     // 55: google.protobuf.Value
+	(*structpb.Struct)(nil),                    // 56: google.protobuf.Struct
+	(*structpb.ListValue)(nil),                 // 57: google.protobuf.ListValue
+}
+var file_plugin_daemon_v1_dispatch_proto_depIdxs = []int32{
+	54, // 0: plugin_daemon.v1.PromptMessageToolCall.function:type_name -> plugin_daemon.v1.PromptMessageToolCall.Function
+	55, // 1: plugin_daemon.v1.PromptMessage.content:type_name -> google.protobuf.Value
+	1,  // 2: plugin_daemon.v1.PromptMessage.tool_calls:type_name -> plugin_daemon.v1.PromptMessageToolCall
+	56, // 3: plugin_daemon.v1.PromptMessageTool.parameters:type_name -> google.protobuf.Struct
+	56, // 4: plugin_daemon.v1.RequestInvokeTool.tool_parameters:type_name -> google.protobuf.Struct
+	56, // 5: plugin_daemon.v1.RequestInvokeTool.credentials:type_name -> google.protobuf.Struct
+	56, // 6: plugin_daemon.v1.RequestValidateToolCredentials.credentials:type_name -> google.protobuf.Struct
+	56, // 7: plugin_daemon.v1.RequestGetToolRuntimeParameters.credentials:type_name -> google.protobuf.Struct
+	56, // 8: plugin_daemon.v1.RequestInvokeAgentStrategy.agent_strategy_params:type_name -> google.protobuf.Struct
+	56, // 9: plugin_daemon.v1.RequestInvokeLLM.credentials:type_name -> google.protobuf.Struct
+	56, // 10: plugin_daemon.v1.RequestInvokeLLM.model_parameters:type_name -> google.protobuf.Struct
+	2,  // 11: plugin_daemon.v1.RequestInvokeLLM.prompt_messages:type_name -> plugin_daemon.v1.PromptMessage
+	3,  // 12: plugin_daemon.v1.RequestInvokeLLM.tools:type_name -> plugin_daemon.v1.PromptMessageTool
+	56, // 13: plugin_daemon.v1.RequestGetLLMNumTokens.credentials:type_name -> google.protobuf.Struct
+	2,  // 14: plugin_daemon.v1.RequestGetLLMNumTokens.prompt_messages:type_name -> plugin_daemon.v1.PromptMessage
+	3,  // 15: plugin_daemon.v1.RequestGetLLMNumTokens.tools:type_name -> plugin_daemon.v1.PromptMessageTool
+	56, // 16: plugin_daemon.v1.RequestInvokeTextEmbedding.credentials:type_name -> google.protobuf.Struct
+	56, // 17: plugin_daemon.v1.RequestGetTextEmbeddingNumTokens.credentials:type_name -> google.protobuf.Struct
+	56, // 18: plugin_daemon.v1.RequestInvokeRerank.credentials:type_name -> google.protobuf.Struct
+	56, // 19: plugin_daemon.v1.RequestInvokeTTS.credentials:type_name -> google.protobuf.Struct
+	56, // 20: plugin_daemon.v1.RequestGetTTSModelVoices.credentials:type_name -> google.protobuf.Struct
+	56, // 21: plugin_daemon.v1.RequestInvokeSpeech2Text.credentials:type_name -> google.protobuf.Struct
+	56, // 22: plugin_daemon.v1.RequestInvokeModeration.credentials:type_name -> google.protobuf.Struct
+	56, // 23: plugin_daemon.v1.RequestValidateProviderCredentials.credentials:type_name -> google.protobuf.Struct
+	56, // 24: plugin_daemon.v1.RequestValidateModelCredentials.credentials:type_name -> google.protobuf.Struct
+	56, // 25: plugin_daemon.v1.RequestGetAIModelSchema.credentials:type_name -> google.protobuf.Struct
+	0,  // 26: plugin_daemon.v1.InvokeToolRequest.context:type_name -> plugin_daemon.v1.InvokeContext
+	4,  // 27: plugin_daemon.v1.InvokeToolRequest.data:type_name -> plugin_daemon.v1.RequestInvokeTool
+	0,  // 28: plugin_daemon.v1.ValidateToolCredentialsRequest.context:type_name -> plugin_daemon.v1.InvokeContext
+	5,  // 29: plugin_daemon.v1.ValidateToolCredentialsRequest.data:type_name -> plugin_daemon.v1.RequestValidateToolCredentials
+	0,  // 30: plugin_daemon.v1.GetToolRuntimeParametersRequest.context:type_name -> plugin_daemon.v1.InvokeContext
+	6,  // 31: plugin_daemon.v1.GetToolRuntimeParametersRequest.data:type_name -> plugin_daemon.v1.RequestGetToolRuntimeParameters
+	0,  // 32: plugin_daemon.v1.InvokeAgentStrategyRequest.context:type_name -> plugin_daemon.v1.InvokeContext
+	7,  // 33: plugin_daemon.v1.InvokeAgentStrategyRequest.data:type_name -> plugin_daemon.v1.RequestInvokeAgentStrategy
+	0,  // 34: plugin_daemon.v1.InvokeLLMRequest.context:type_name -> plugin_daemon.v1.InvokeContext
+	8,  // 35: plugin_daemon.v1.InvokeLLMRequest.data:type_name -> plugin_daemon.v1.RequestInvokeLLM
+	0,  // 36: plugin_daemon.v1.GetLLMNumTokensRequest.context:type_name -> plugin_daemon.v1.InvokeContext
+	9,  // 37: plugin_daemon.v1.GetLLMNumTokensRequest.data:type_name -> plugin_daemon.v1.RequestGetLLMNumTokens
+	0,  // 38: plugin_daemon.v1.InvokeTextEmbeddingRequest.context:type_name -> plugin_daemon.v1.InvokeContext
+	10, // 39: plugin_daemon.v1.InvokeTextEmbeddingRequest.data:type_name -> plugin_daemon.v1.RequestInvokeTextEmbedding
+	0,  // 40: plugin_daemon.v1.GetTextEmbeddingNumTokensRequest.context:type_name -> plugin_daemon.v1.InvokeContext
+	11, // 41: plugin_daemon.v1.GetTextEmbeddingNumTokensRequest.data:type_name -> plugin_daemon.v1.RequestGetTextEmbeddingNumTokens
+	0,  // 42: plugin_daemon.v1.InvokeRerankRequest.context:type_name -> plugin_daemon.v1.InvokeContext
+	12, // 43: plugin_daemon.v1.InvokeRerankRequest.data:type_name -> plugin_daemon.v1.RequestInvokeRerank
+	0,  // 44: plugin_daemon.v1.InvokeTTSRequest.context:type_name -> plugin_daemon.v1.InvokeContext
+	13, // 45: plugin_daemon.v1.InvokeTTSRequest.data:type_name -> plugin_daemon.v1.RequestInvokeTTS
+	0,  // 46: plugin_daemon.v1.GetTTSModelVoicesRequest.context:type_name -> plugin_daemon.v1.InvokeContext
+	14, // 47: plugin_daemon.v1.GetTTSModelVoicesRequest.data:type_name -> plugin_daemon.v1.RequestGetTTSModelVoices
+	0,  // 48: plugin_daemon.v1.InvokeSpeech2TextRequest.context:type_name -> plugin_daemon.v1.InvokeContext
+	15, // 49: plugin_daemon.v1.InvokeSpeech2TextRequest.data:type_name -> plugin_daemon.v1.RequestInvokeSpeech2Text
+	0,  // 50: plugin_daemon.v1.InvokeModerationRequest.context:type_name -> plugin_daemon.v1.InvokeContext
+	16, // 51: plugin_daemon.v1.InvokeModerationRequest.data:type_name -> plugin_daemon.v1.RequestInvokeModeration
+	0,  // 52: plugin_daemon.v1.ValidateProviderCredentialsRequest.context:type_name -> plugin_daemon.v1.InvokeContext
+	17, // 53: plugin_daemon.v1.ValidateProviderCredentialsRequest.data:type_name -> plugin_daemon.v1.RequestValidateProviderCredentials
+	0,  // 54: plugin_daemon.v1.ValidateModelCredentialsRequest.context:type_name -> plugin_daemon.v1.InvokeContext
+	18, // 55: plugin_daemon.v1.ValidateModelCredentialsRequest.data:type_name -> plugin_daemon.v1.RequestValidateModelCredentials
+	0,  // 56: plugin_daemon.v1.GetAIModelSchemaRequest.context:type_name -> plugin_daemon.v1.InvokeContext
+	19, // 57: plugin_daemon.v1.GetAIModelSchemaRequest.data:type_name -> plugin_daemon.v1.RequestGetAIModelSchema
+	56, // 58: plugin_daemon.v1.ToolResponseChunk.message:type_name -> google.protobuf.Struct
+	56, // 59: plugin_daemon.v1.ToolResponseChunk.meta:type_name -> google.protobuf.Struct
+	56, // 60: plugin_daemon.v1.GetToolRuntimeParametersResponse.parameters:type_name -> google.protobuf.Struct
+	2,  // 61: plugin_daemon.v1.LLMResultChunkDelta.message:type_name -> plugin_daemon.v1.PromptMessage
+	39, // 62: plugin_daemon.v1.LLMResultChunkDelta.usage:type_name -> plugin_daemon.v1.LLMUsage
+	2,  // 63: plugin_daemon.v1.LLMResultChunk.prompt_messages:type_name -> plugin_daemon.v1.PromptMessage
+	40, // 64: plugin_daemon.v1.LLMResultChunk.delta:type_name -> plugin_daemon.v1.LLMResultChunkDelta
+	57, // 65: plugin_daemon.v1.TextEmbeddingResult.embeddings:type_name -> google.protobuf.ListValue
+	43, // 66: plugin_daemon.v1.TextEmbeddingResult.usage:type_name -> plugin_daemon.v1.EmbeddingUsage
+	46, // 67: plugin_daemon.v1.RerankResult.docs:type_name -> plugin_daemon.v1.RerankDocument
+	49, // 68: plugin_daemon.v1.GetTTSVoicesResponse.voices:type_name -> plugin_daemon.v1.TTSModelVoice
+	56, // 69: plugin_daemon.v1.GetModelSchemasResponse.model_schema:type_name -> google.protobuf.Struct
+	20, // 70: plugin_daemon.v1.PluginDispatch.InvokeTool:input_type -> plugin_daemon.v1.InvokeToolRequest
+	21, // 71: plugin_daemon.v1.PluginDispatch.ValidateToolCredentials:input_type -> plugin_daemon.v1.ValidateToolCredentialsRequest
+	22, // 72: plugin_daemon.v1.PluginDispatch.GetToolRuntimeParameters:input_type -> plugin_daemon.v1.GetToolRuntimeParametersRequest
+	23, // 73: plugin_daemon.v1.PluginDispatch.InvokeAgentStrategy:input_type -> plugin_daemon.v1.InvokeAgentStrategyRequest
+	24, // 74: plugin_daemon.v1.PluginDispatch.InvokeLLM:input_type -> plugin_daemon.v1.InvokeLLMRequest
+	25, // 75: plugin_daemon.v1.PluginDispatch.GetLLMNumTokens:input_type -> plugin_daemon.v1.GetLLMNumTokensRequest
+	26, // 76: plugin_daemon.v1.PluginDispatch.InvokeTextEmbedding:input_type -> plugin_daemon.v1.InvokeTextEmbeddingRequest
+	27, // 77: plugin_daemon.v1.PluginDispatch.GetTextEmbeddingNumTokens:input_type -> plugin_daemon.v1.GetTextEmbeddingNumTokensRequest
+	28, // 78: plugin_daemon.v1.PluginDispatch.InvokeRerank:input_type -> plugin_daemon.v1.InvokeRerankRequest
+	29, // 79: plugin_daemon.v1.PluginDispatch.InvokeTTS:input_type -> plugin_daemon.v1.InvokeTTSRequest
+	30, // 80: plugin_daemon.v1.PluginDispatch.GetTTSModelVoices:input_type -> plugin_daemon.v1.GetTTSModelVoicesRequest
+	31, // 81: plugin_daemon.v1.PluginDispatch.InvokeSpeech2Text:input_type -> plugin_daemon.v1.InvokeSpeech2TextRequest
+	32, // 82: plugin_daemon.v1.PluginDispatch.InvokeModeration:input_type -> plugin_daemon.v1.InvokeModerationRequest
+	33, // 83: plugin_daemon.v1.PluginDispatch.ValidateProviderCredentials:input_type -> plugin_daemon.v1.ValidateProviderCredentialsRequest
+	34, // 84: plugin_daemon.v1.PluginDispatch.ValidateModelCredentials:input_type -> plugin_daemon.v1.ValidateModelCredentialsRequest
+	35, // 85: plugin_daemon.v1.PluginDispatch.GetAIModelSchema:input_type -> plugin_daemon.v1.GetAIModelSchemaRequest
+	36, // 86: plugin_daemon.v1.PluginDispatch.InvokeTool:output_type -> plugin_daemon.v1.ToolResponseChunk
+	37, // 87: plugin_daemon.v1.PluginDispatch.ValidateToolCredentials:output_type -> plugin_daemon.v1.ValidateCredentialsResult
+	38, // 88: plugin_daemon.v1.PluginDispatch.GetToolRuntimeParameters:output_type -> plugin_daemon.v1.GetToolRuntimeParametersResponse
+	36, // 89: plugin_daemon.v1.PluginDispatch.InvokeAgentStrategy:output_type -> plugin_daemon.v1.ToolResponseChunk
+	41, // 90: plugin_daemon.v1.PluginDispatch.InvokeLLM:output_type -> plugin_daemon.v1.LLMResultChunk
+	42, // 91: plugin_daemon.v1.PluginDispatch.GetLLMNumTokens:output_type -> plugin_daemon.v1.LLMGetNumTokensResponse
+	44, // 92: plugin_daemon.v1.PluginDispatch.InvokeTextEmbedding:output_type -> plugin_daemon.v1.TextEmbeddingResult
+	45, // 93: plugin_daemon.v1.PluginDispatch.GetTextEmbeddingNumTokens:output_type -> plugin_daemon.v1.GetTextEmbeddingNumTokensResponse
+	47, // 94: plugin_daemon.v1.PluginDispatch.InvokeRerank:output_type -> plugin_daemon.v1.RerankResult
+	48, // 95: plugin_daemon.v1.PluginDispatch.InvokeTTS:output_type -> plugin_daemon.v1.TTSResult
+	50, // 96: plugin_daemon.v1.PluginDispatch.GetTTSModelVoices:output_type -> plugin_daemon.v1.GetTTSVoicesResponse
+	51, // 97: plugin_daemon.v1.PluginDispatch.InvokeSpeech2Text:output_type -> plugin_daemon.v1.Speech2TextResult
+	52, // 98: plugin_daemon.v1.PluginDispatch.InvokeModeration:output_type -> plugin_daemon.v1.ModerationResult
+	37, // 99: plugin_daemon.v1.PluginDispatch.ValidateProviderCredentials:output_type -> plugin_daemon.v1.ValidateCredentialsResult
+	37, // 100: plugin_daemon.v1.PluginDispatch.ValidateModelCredentials:output_type -> plugin_daemon.v1.ValidateCredentialsResult
+	53, // 101: plugin_daemon.v1.PluginDispatch.GetAIModelSchema:output_type -> plugin_daemon.v1.GetModelSchemasResponse
+	86, // [86:102] is the sub-list for method output_type
+	70, // [70:86] is the sub-list for method input_type
+	70, // [70:70] is the sub-list for extension type_name
+	70, // [70:70] is the sub-list for extension extendee
+	0,  // [0:70] is the sub-list for field type_name
+}
+
+func init() { file_plugin_daemon_v1_dispatch_proto_init() }
+func file_plugin_daemon_v1_dispatch_proto_init() {
+	if File_plugin_daemon_v1_dispatch_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*InvokeContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*PromptMessageToolCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*PromptMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*PromptMessageTool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestInvokeTool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestValidateToolCredentials); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestGetToolRuntimeParameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestInvokeAgentStrategy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestInvokeLLM); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestGetLLMNumTokens); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestInvokeTextEmbedding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestGetTextEmbeddingNumTokens); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestInvokeRerank); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestInvokeTTS); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestGetTTSModelVoices); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestInvokeSpeech2Text); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestInvokeModeration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestValidateProviderCredentials); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestValidateModelCredentials); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestGetAIModelSchema); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*InvokeToolRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*ValidateToolCredentialsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*GetToolRuntimeParametersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*InvokeAgentStrategyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*InvokeLLMRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLLMNumTokensRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*InvokeTextEmbeddingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTextEmbeddingNumTokensRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*InvokeRerankRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*InvokeTTSRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTTSModelVoicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*InvokeSpeech2TextRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*InvokeModerationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*ValidateProviderCredentialsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*ValidateModelCredentialsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAIModelSchemaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*ToolResponseChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*ValidateCredentialsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*GetToolRuntimeParametersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*LLMUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*LLMResultChunkDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*LLMResultChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*LLMGetNumTokensResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*EmbeddingUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*TextEmbeddingResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTextEmbeddingNumTokensResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*RerankDocument); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*RerankResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*TTSResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*TTSModelVoice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTTSVoicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*Speech2TextResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*ModerationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*GetModelSchemasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plugin_daemon_v1_dispatch_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*PromptMessageToolCall_Function); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_plugin_daemon_v1_dispatch_proto_msgTypes[0].OneofWrappers = []any{}
+	file_plugin_daemon_v1_dispatch_proto_msgTypes[40].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_plugin_daemon_v1_dispatch_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   55,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_plugin_daemon_v1_dispatch_proto_goTypes,
+		DependencyIndexes: file_plugin_daemon_v1_dispatch_proto_depIdxs,
+		MessageInfos:      file_plugin_daemon_v1_dispatch_proto_msgTypes,
+	}.Build()
+	File_plugin_daemon_v1_dispatch_proto = out.File
+	file_plugin_daemon_v1_dispatch_proto_rawDesc = nil
+	file_plugin_daemon_v1_dispatch_proto_goTypes = nil
+	file_plugin_daemon_v1_dispatch_proto_depIdxs = nil
+}
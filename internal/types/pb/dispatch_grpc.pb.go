@@ -0,0 +1,1122 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: plugin_daemon/v1/dispatch.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	PluginDispatch_InvokeTool_FullMethodName                  = "/plugin_daemon.v1.PluginDispatch/InvokeTool"
+	PluginDispatch_ValidateToolCredentials_FullMethodName     = "/plugin_daemon.v1.PluginDispatch/ValidateToolCredentials"
+	PluginDispatch_GetToolRuntimeParameters_FullMethodName    = "/plugin_daemon.v1.PluginDispatch/GetToolRuntimeParameters"
+	PluginDispatch_InvokeAgentStrategy_FullMethodName         = "/plugin_daemon.v1.PluginDispatch/InvokeAgentStrategy"
+	PluginDispatch_InvokeLLM_FullMethodName                   = "/plugin_daemon.v1.PluginDispatch/InvokeLLM"
+	PluginDispatch_GetLLMNumTokens_FullMethodName             = "/plugin_daemon.v1.PluginDispatch/GetLLMNumTokens"
+	PluginDispatch_InvokeTextEmbedding_FullMethodName         = "/plugin_daemon.v1.PluginDispatch/InvokeTextEmbedding"
+	PluginDispatch_GetTextEmbeddingNumTokens_FullMethodName   = "/plugin_daemon.v1.PluginDispatch/GetTextEmbeddingNumTokens"
+	PluginDispatch_InvokeRerank_FullMethodName                = "/plugin_daemon.v1.PluginDispatch/InvokeRerank"
+	PluginDispatch_InvokeTTS_FullMethodName                   = "/plugin_daemon.v1.PluginDispatch/InvokeTTS"
+	PluginDispatch_GetTTSModelVoices_FullMethodName           = "/plugin_daemon.v1.PluginDispatch/GetTTSModelVoices"
+	PluginDispatch_InvokeSpeech2Text_FullMethodName           = "/plugin_daemon.v1.PluginDispatch/InvokeSpeech2Text"
+	PluginDispatch_InvokeModeration_FullMethodName            = "/plugin_daemon.v1.PluginDispatch/InvokeModeration"
+	PluginDispatch_ValidateProviderCredentials_FullMethodName = "/plugin_daemon.v1.PluginDispatch/ValidateProviderCredentials"
+	PluginDispatch_ValidateModelCredentials_FullMethodName    = "/plugin_daemon.v1.PluginDispatch/ValidateModelCredentials"
+	PluginDispatch_GetAIModelSchema_FullMethodName            = "/plugin_daemon.v1.PluginDispatch/GetAIModelSchema"
+)
+
+// PluginDispatchClient is the client API for PluginDispatch service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PluginDispatch mirrors the `/plugin/:tenant_id/dispatch` apis, every rpc streams what
+// the http api streams over SSE, the plugin is selected by the `x-plugin-id` metadata and
+// the caller is authorized by the `x-api-key` metadata, a failure ends the stream with
+// the message of the error response of the http api as the status message
+type PluginDispatchClient interface {
+	InvokeTool(ctx context.Context, in *InvokeToolRequest, opts ...grpc.CallOption) (PluginDispatch_InvokeToolClient, error)
+	ValidateToolCredentials(ctx context.Context, in *ValidateToolCredentialsRequest, opts ...grpc.CallOption) (PluginDispatch_ValidateToolCredentialsClient, error)
+	GetToolRuntimeParameters(ctx context.Context, in *GetToolRuntimeParametersRequest, opts ...grpc.CallOption) (PluginDispatch_GetToolRuntimeParametersClient, error)
+	InvokeAgentStrategy(ctx context.Context, in *InvokeAgentStrategyRequest, opts ...grpc.CallOption) (PluginDispatch_InvokeAgentStrategyClient, error)
+	InvokeLLM(ctx context.Context, in *InvokeLLMRequest, opts ...grpc.CallOption) (PluginDispatch_InvokeLLMClient, error)
+	GetLLMNumTokens(ctx context.Context, in *GetLLMNumTokensRequest, opts ...grpc.CallOption) (PluginDispatch_GetLLMNumTokensClient, error)
+	InvokeTextEmbedding(ctx context.Context, in *InvokeTextEmbeddingRequest, opts ...grpc.CallOption) (PluginDispatch_InvokeTextEmbeddingClient, error)
+	GetTextEmbeddingNumTokens(ctx context.Context, in *GetTextEmbeddingNumTokensRequest, opts ...grpc.CallOption) (PluginDispatch_GetTextEmbeddingNumTokensClient, error)
+	InvokeRerank(ctx context.Context, in *InvokeRerankRequest, opts ...grpc.CallOption) (PluginDispatch_InvokeRerankClient, error)
+	InvokeTTS(ctx context.Context, in *InvokeTTSRequest, opts ...grpc.CallOption) (PluginDispatch_InvokeTTSClient, error)
+	GetTTSModelVoices(ctx context.Context, in *GetTTSModelVoicesRequest, opts ...grpc.CallOption) (PluginDispatch_GetTTSModelVoicesClient, error)
+	InvokeSpeech2Text(ctx context.Context, in *InvokeSpeech2TextRequest, opts ...grpc.CallOption) (PluginDispatch_InvokeSpeech2TextClient, error)
+	InvokeModeration(ctx context.Context, in *InvokeModerationRequest, opts ...grpc.CallOption) (PluginDispatch_InvokeModerationClient, error)
+	ValidateProviderCredentials(ctx context.Context, in *ValidateProviderCredentialsRequest, opts ...grpc.CallOption) (PluginDispatch_ValidateProviderCredentialsClient, error)
+	ValidateModelCredentials(ctx context.Context, in *ValidateModelCredentialsRequest, opts ...grpc.CallOption) (PluginDispatch_ValidateModelCredentialsClient, error)
+	GetAIModelSchema(ctx context.Context, in *GetAIModelSchemaRequest, opts ...grpc.CallOption) (PluginDispatch_GetAIModelSchemaClient, error)
+}
+
+type pluginDispatchClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPluginDispatchClient(cc grpc.ClientConnInterface) PluginDispatchClient {
+	return &pluginDispatchClient{cc}
+}
+
+func (c *pluginDispatchClient) InvokeTool(ctx context.Context, in *InvokeToolRequest, opts ...grpc.CallOption) (PluginDispatch_InvokeToolClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PluginDispatch_ServiceDesc.Streams[0], PluginDispatch_InvokeTool_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pluginDispatchInvokeToolClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PluginDispatch_InvokeToolClient interface {
+	Recv() (*ToolResponseChunk, error)
+	grpc.ClientStream
+}
+
+type pluginDispatchInvokeToolClient struct {
+	grpc.ClientStream
+}
+
+func (x *pluginDispatchInvokeToolClient) Recv() (*ToolResponseChunk, error) {
+	m := new(ToolResponseChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pluginDispatchClient) ValidateToolCredentials(ctx context.Context, in *ValidateToolCredentialsRequest, opts ...grpc.CallOption) (PluginDispatch_ValidateToolCredentialsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PluginDispatch_ServiceDesc.Streams[1], PluginDispatch_ValidateToolCredentials_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pluginDispatchValidateToolCredentialsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PluginDispatch_ValidateToolCredentialsClient interface {
+	Recv() (*ValidateCredentialsResult, error)
+	grpc.ClientStream
+}
+
+type pluginDispatchValidateToolCredentialsClient struct {
+	grpc.ClientStream
+}
+
+func (x *pluginDispatchValidateToolCredentialsClient) Recv() (*ValidateCredentialsResult, error) {
+	m := new(ValidateCredentialsResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pluginDispatchClient) GetToolRuntimeParameters(ctx context.Context, in *GetToolRuntimeParametersRequest, opts ...grpc.CallOption) (PluginDispatch_GetToolRuntimeParametersClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PluginDispatch_ServiceDesc.Streams[2], PluginDispatch_GetToolRuntimeParameters_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pluginDispatchGetToolRuntimeParametersClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PluginDispatch_GetToolRuntimeParametersClient interface {
+	Recv() (*GetToolRuntimeParametersResponse, error)
+	grpc.ClientStream
+}
+
+type pluginDispatchGetToolRuntimeParametersClient struct {
+	grpc.ClientStream
+}
+
+func (x *pluginDispatchGetToolRuntimeParametersClient) Recv() (*GetToolRuntimeParametersResponse, error) {
+	m := new(GetToolRuntimeParametersResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pluginDispatchClient) InvokeAgentStrategy(ctx context.Context, in *InvokeAgentStrategyRequest, opts ...grpc.CallOption) (PluginDispatch_InvokeAgentStrategyClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PluginDispatch_ServiceDesc.Streams[3], PluginDispatch_InvokeAgentStrategy_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pluginDispatchInvokeAgentStrategyClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PluginDispatch_InvokeAgentStrategyClient interface {
+	Recv() (*ToolResponseChunk, error)
+	grpc.ClientStream
+}
+
+type pluginDispatchInvokeAgentStrategyClient struct {
+	grpc.ClientStream
+}
+
+func (x *pluginDispatchInvokeAgentStrategyClient) Recv() (*ToolResponseChunk, error) {
+	m := new(ToolResponseChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pluginDispatchClient) InvokeLLM(ctx context.Context, in *InvokeLLMRequest, opts ...grpc.CallOption) (PluginDispatch_InvokeLLMClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PluginDispatch_ServiceDesc.Streams[4], PluginDispatch_InvokeLLM_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pluginDispatchInvokeLLMClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PluginDispatch_InvokeLLMClient interface {
+	Recv() (*LLMResultChunk, error)
+	grpc.ClientStream
+}
+
+type pluginDispatchInvokeLLMClient struct {
+	grpc.ClientStream
+}
+
+func (x *pluginDispatchInvokeLLMClient) Recv() (*LLMResultChunk, error) {
+	m := new(LLMResultChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pluginDispatchClient) GetLLMNumTokens(ctx context.Context, in *GetLLMNumTokensRequest, opts ...grpc.CallOption) (PluginDispatch_GetLLMNumTokensClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PluginDispatch_ServiceDesc.Streams[5], PluginDispatch_GetLLMNumTokens_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pluginDispatchGetLLMNumTokensClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PluginDispatch_GetLLMNumTokensClient interface {
+	Recv() (*LLMGetNumTokensResponse, error)
+	grpc.ClientStream
+}
+
+type pluginDispatchGetLLMNumTokensClient struct {
+	grpc.ClientStream
+}
+
+func (x *pluginDispatchGetLLMNumTokensClient) Recv() (*LLMGetNumTokensResponse, error) {
+	m := new(LLMGetNumTokensResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pluginDispatchClient) InvokeTextEmbedding(ctx context.Context, in *InvokeTextEmbeddingRequest, opts ...grpc.CallOption) (PluginDispatch_InvokeTextEmbeddingClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PluginDispatch_ServiceDesc.Streams[6], PluginDispatch_InvokeTextEmbedding_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pluginDispatchInvokeTextEmbeddingClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PluginDispatch_InvokeTextEmbeddingClient interface {
+	Recv() (*TextEmbeddingResult, error)
+	grpc.ClientStream
+}
+
+type pluginDispatchInvokeTextEmbeddingClient struct {
+	grpc.ClientStream
+}
+
+func (x *pluginDispatchInvokeTextEmbeddingClient) Recv() (*TextEmbeddingResult, error) {
+	m := new(TextEmbeddingResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pluginDispatchClient) GetTextEmbeddingNumTokens(ctx context.Context, in *GetTextEmbeddingNumTokensRequest, opts ...grpc.CallOption) (PluginDispatch_GetTextEmbeddingNumTokensClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PluginDispatch_ServiceDesc.Streams[7], PluginDispatch_GetTextEmbeddingNumTokens_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pluginDispatchGetTextEmbeddingNumTokensClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PluginDispatch_GetTextEmbeddingNumTokensClient interface {
+	Recv() (*GetTextEmbeddingNumTokensResponse, error)
+	grpc.ClientStream
+}
+
+type pluginDispatchGetTextEmbeddingNumTokensClient struct {
+	grpc.ClientStream
+}
+
+func (x *pluginDispatchGetTextEmbeddingNumTokensClient) Recv() (*GetTextEmbeddingNumTokensResponse, error) {
+	m := new(GetTextEmbeddingNumTokensResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pluginDispatchClient) InvokeRerank(ctx context.Context, in *InvokeRerankRequest, opts ...grpc.CallOption) (PluginDispatch_InvokeRerankClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PluginDispatch_ServiceDesc.Streams[8], PluginDispatch_InvokeRerank_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pluginDispatchInvokeRerankClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PluginDispatch_InvokeRerankClient interface {
+	Recv() (*RerankResult, error)
+	grpc.ClientStream
+}
+
+type pluginDispatchInvokeRerankClient struct {
+	grpc.ClientStream
+}
+
+func (x *pluginDispatchInvokeRerankClient) Recv() (*RerankResult, error) {
+	m := new(RerankResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pluginDispatchClient) InvokeTTS(ctx context.Context, in *InvokeTTSRequest, opts ...grpc.CallOption) (PluginDispatch_InvokeTTSClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PluginDispatch_ServiceDesc.Streams[9], PluginDispatch_InvokeTTS_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pluginDispatchInvokeTTSClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PluginDispatch_InvokeTTSClient interface {
+	Recv() (*TTSResult, error)
+	grpc.ClientStream
+}
+
+type pluginDispatchInvokeTTSClient struct {
+	grpc.ClientStream
+}
+
+func (x *pluginDispatchInvokeTTSClient) Recv() (*TTSResult, error) {
+	m := new(TTSResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pluginDispatchClient) GetTTSModelVoices(ctx context.Context, in *GetTTSModelVoicesRequest, opts ...grpc.CallOption) (PluginDispatch_GetTTSModelVoicesClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PluginDispatch_ServiceDesc.Streams[10], PluginDispatch_GetTTSModelVoices_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pluginDispatchGetTTSModelVoicesClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PluginDispatch_GetTTSModelVoicesClient interface {
+	Recv() (*GetTTSVoicesResponse, error)
+	grpc.ClientStream
+}
+
+type pluginDispatchGetTTSModelVoicesClient struct {
+	grpc.ClientStream
+}
+
+func (x *pluginDispatchGetTTSModelVoicesClient) Recv() (*GetTTSVoicesResponse, error) {
+	m := new(GetTTSVoicesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pluginDispatchClient) InvokeSpeech2Text(ctx context.Context, in *InvokeSpeech2TextRequest, opts ...grpc.CallOption) (PluginDispatch_InvokeSpeech2TextClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PluginDispatch_ServiceDesc.Streams[11], PluginDispatch_InvokeSpeech2Text_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pluginDispatchInvokeSpeech2TextClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PluginDispatch_InvokeSpeech2TextClient interface {
+	Recv() (*Speech2TextResult, error)
+	grpc.ClientStream
+}
+
+type pluginDispatchInvokeSpeech2TextClient struct {
+	grpc.ClientStream
+}
+
+func (x *pluginDispatchInvokeSpeech2TextClient) Recv() (*Speech2TextResult, error) {
+	m := new(Speech2TextResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pluginDispatchClient) InvokeModeration(ctx context.Context, in *InvokeModerationRequest, opts ...grpc.CallOption) (PluginDispatch_InvokeModerationClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PluginDispatch_ServiceDesc.Streams[12], PluginDispatch_InvokeModeration_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pluginDispatchInvokeModerationClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PluginDispatch_InvokeModerationClient interface {
+	Recv() (*ModerationResult, error)
+	grpc.ClientStream
+}
+
+type pluginDispatchInvokeModerationClient struct {
+	grpc.ClientStream
+}
+
+func (x *pluginDispatchInvokeModerationClient) Recv() (*ModerationResult, error) {
+	m := new(ModerationResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pluginDispatchClient) ValidateProviderCredentials(ctx context.Context, in *ValidateProviderCredentialsRequest, opts ...grpc.CallOption) (PluginDispatch_ValidateProviderCredentialsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PluginDispatch_ServiceDesc.Streams[13], PluginDispatch_ValidateProviderCredentials_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pluginDispatchValidateProviderCredentialsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PluginDispatch_ValidateProviderCredentialsClient interface {
+	Recv() (*ValidateCredentialsResult, error)
+	grpc.ClientStream
+}
+
+type pluginDispatchValidateProviderCredentialsClient struct {
+	grpc.ClientStream
+}
+
+func (x *pluginDispatchValidateProviderCredentialsClient) Recv() (*ValidateCredentialsResult, error) {
+	m := new(ValidateCredentialsResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pluginDispatchClient) ValidateModelCredentials(ctx context.Context, in *ValidateModelCredentialsRequest, opts ...grpc.CallOption) (PluginDispatch_ValidateModelCredentialsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PluginDispatch_ServiceDesc.Streams[14], PluginDispatch_ValidateModelCredentials_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pluginDispatchValidateModelCredentialsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PluginDispatch_ValidateModelCredentialsClient interface {
+	Recv() (*ValidateCredentialsResult, error)
+	grpc.ClientStream
+}
+
+type pluginDispatchValidateModelCredentialsClient struct {
+	grpc.ClientStream
+}
+
+func (x *pluginDispatchValidateModelCredentialsClient) Recv() (*ValidateCredentialsResult, error) {
+	m := new(ValidateCredentialsResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pluginDispatchClient) GetAIModelSchema(ctx context.Context, in *GetAIModelSchemaRequest, opts ...grpc.CallOption) (PluginDispatch_GetAIModelSchemaClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PluginDispatch_ServiceDesc.Streams[15], PluginDispatch_GetAIModelSchema_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pluginDispatchGetAIModelSchemaClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PluginDispatch_GetAIModelSchemaClient interface {
+	Recv() (*GetModelSchemasResponse, error)
+	grpc.ClientStream
+}
+
+type pluginDispatchGetAIModelSchemaClient struct {
+	grpc.ClientStream
+}
+
+func (x *pluginDispatchGetAIModelSchemaClient) Recv() (*GetModelSchemasResponse, error) {
+	m := new(GetModelSchemasResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// PluginDispatchServer is the server API for PluginDispatch service.
+// All implementations must embed UnimplementedPluginDispatchServer
+// for forward compatibility
+//
+// PluginDispatch mirrors the `/plugin/:tenant_id/dispatch` apis, every rpc streams what
+// the http api streams over SSE, the plugin is selected by the `x-plugin-id` metadata and
+// the caller is authorized by the `x-api-key` metadata, a failure ends the stream with
+// the message of the error response of the http api as the status message
+type PluginDispatchServer interface {
+	InvokeTool(*InvokeToolRequest, PluginDispatch_InvokeToolServer) error
+	ValidateToolCredentials(*ValidateToolCredentialsRequest, PluginDispatch_ValidateToolCredentialsServer) error
+	GetToolRuntimeParameters(*GetToolRuntimeParametersRequest, PluginDispatch_GetToolRuntimeParametersServer) error
+	InvokeAgentStrategy(*InvokeAgentStrategyRequest, PluginDispatch_InvokeAgentStrategyServer) error
+	InvokeLLM(*InvokeLLMRequest, PluginDispatch_InvokeLLMServer) error
+	GetLLMNumTokens(*GetLLMNumTokensRequest, PluginDispatch_GetLLMNumTokensServer) error
+	InvokeTextEmbedding(*InvokeTextEmbeddingRequest, PluginDispatch_InvokeTextEmbeddingServer) error
+	GetTextEmbeddingNumTokens(*GetTextEmbeddingNumTokensRequest, PluginDispatch_GetTextEmbeddingNumTokensServer) error
+	InvokeRerank(*InvokeRerankRequest, PluginDispatch_InvokeRerankServer) error
+	InvokeTTS(*InvokeTTSRequest, PluginDispatch_InvokeTTSServer) error
+	GetTTSModelVoices(*GetTTSModelVoicesRequest, PluginDispatch_GetTTSModelVoicesServer) error
+	InvokeSpeech2Text(*InvokeSpeech2TextRequest, PluginDispatch_InvokeSpeech2TextServer) error
+	InvokeModeration(*InvokeModerationRequest, PluginDispatch_InvokeModerationServer) error
+	ValidateProviderCredentials(*ValidateProviderCredentialsRequest, PluginDispatch_ValidateProviderCredentialsServer) error
+	ValidateModelCredentials(*ValidateModelCredentialsRequest, PluginDispatch_ValidateModelCredentialsServer) error
+	GetAIModelSchema(*GetAIModelSchemaRequest, PluginDispatch_GetAIModelSchemaServer) error
+	mustEmbedUnimplementedPluginDispatchServer()
+}
+
+// UnimplementedPluginDispatchServer must be embedded to have forward compatible implementations.
+type UnimplementedPluginDispatchServer struct {
+}
+
+func (UnimplementedPluginDispatchServer) InvokeTool(*InvokeToolRequest, PluginDispatch_InvokeToolServer) error {
+	return status.Errorf(codes.Unimplemented, "method InvokeTool not implemented")
+}
+func (UnimplementedPluginDispatchServer) ValidateToolCredentials(*ValidateToolCredentialsRequest, PluginDispatch_ValidateToolCredentialsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ValidateToolCredentials not implemented")
+}
+func (UnimplementedPluginDispatchServer) GetToolRuntimeParameters(*GetToolRuntimeParametersRequest, PluginDispatch_GetToolRuntimeParametersServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetToolRuntimeParameters not implemented")
+}
+func (UnimplementedPluginDispatchServer) InvokeAgentStrategy(*InvokeAgentStrategyRequest, PluginDispatch_InvokeAgentStrategyServer) error {
+	return status.Errorf(codes.Unimplemented, "method InvokeAgentStrategy not implemented")
+}
+func (UnimplementedPluginDispatchServer) InvokeLLM(*InvokeLLMRequest, PluginDispatch_InvokeLLMServer) error {
+	return status.Errorf(codes.Unimplemented, "method InvokeLLM not implemented")
+}
+func (UnimplementedPluginDispatchServer) GetLLMNumTokens(*GetLLMNumTokensRequest, PluginDispatch_GetLLMNumTokensServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetLLMNumTokens not implemented")
+}
+func (UnimplementedPluginDispatchServer) InvokeTextEmbedding(*InvokeTextEmbeddingRequest, PluginDispatch_InvokeTextEmbeddingServer) error {
+	return status.Errorf(codes.Unimplemented, "method InvokeTextEmbedding not implemented")
+}
+func (UnimplementedPluginDispatchServer) GetTextEmbeddingNumTokens(*GetTextEmbeddingNumTokensRequest, PluginDispatch_GetTextEmbeddingNumTokensServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetTextEmbeddingNumTokens not implemented")
+}
+func (UnimplementedPluginDispatchServer) InvokeRerank(*InvokeRerankRequest, PluginDispatch_InvokeRerankServer) error {
+	return status.Errorf(codes.Unimplemented, "method InvokeRerank not implemented")
+}
+func (UnimplementedPluginDispatchServer) InvokeTTS(*InvokeTTSRequest, PluginDispatch_InvokeTTSServer) error {
+	return status.Errorf(codes.Unimplemented, "method InvokeTTS not implemented")
+}
+func (UnimplementedPluginDispatchServer) GetTTSModelVoices(*GetTTSModelVoicesRequest, PluginDispatch_GetTTSModelVoicesServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetTTSModelVoices not implemented")
+}
+func (UnimplementedPluginDispatchServer) InvokeSpeech2Text(*InvokeSpeech2TextRequest, PluginDispatch_InvokeSpeech2TextServer) error {
+	return status.Errorf(codes.Unimplemented, "method InvokeSpeech2Text not implemented")
+}
+func (UnimplementedPluginDispatchServer) InvokeModeration(*InvokeModerationRequest, PluginDispatch_InvokeModerationServer) error {
+	return status.Errorf(codes.Unimplemented, "method InvokeModeration not implemented")
+}
+func (UnimplementedPluginDispatchServer) ValidateProviderCredentials(*ValidateProviderCredentialsRequest, PluginDispatch_ValidateProviderCredentialsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ValidateProviderCredentials not implemented")
+}
+func (UnimplementedPluginDispatchServer) ValidateModelCredentials(*ValidateModelCredentialsRequest, PluginDispatch_ValidateModelCredentialsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ValidateModelCredentials not implemented")
+}
+func (UnimplementedPluginDispatchServer) GetAIModelSchema(*GetAIModelSchemaRequest, PluginDispatch_GetAIModelSchemaServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetAIModelSchema not implemented")
+}
+func (UnimplementedPluginDispatchServer) mustEmbedUnimplementedPluginDispatchServer() {}
+
+// UnsafePluginDispatchServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PluginDispatchServer will
+// result in compilation errors.
+type UnsafePluginDispatchServer interface {
+	mustEmbedUnimplementedPluginDispatchServer()
+}
+
+func RegisterPluginDispatchServer(s grpc.ServiceRegistrar, srv PluginDispatchServer) {
+	s.RegisterService(&PluginDispatch_ServiceDesc, srv)
+}
+
+func _PluginDispatch_InvokeTool_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InvokeToolRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PluginDispatchServer).InvokeTool(m, &pluginDispatchInvokeToolServer{ServerStream: stream})
+}
+
+type PluginDispatch_InvokeToolServer interface {
+	Send(*ToolResponseChunk) error
+	grpc.ServerStream
+}
+
+type pluginDispatchInvokeToolServer struct {
+	grpc.ServerStream
+}
+
+func (x *pluginDispatchInvokeToolServer) Send(m *ToolResponseChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PluginDispatch_ValidateToolCredentials_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ValidateToolCredentialsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PluginDispatchServer).ValidateToolCredentials(m, &pluginDispatchValidateToolCredentialsServer{ServerStream: stream})
+}
+
+type PluginDispatch_ValidateToolCredentialsServer interface {
+	Send(*ValidateCredentialsResult) error
+	grpc.ServerStream
+}
+
+type pluginDispatchValidateToolCredentialsServer struct {
+	grpc.ServerStream
+}
+
+func (x *pluginDispatchValidateToolCredentialsServer) Send(m *ValidateCredentialsResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PluginDispatch_GetToolRuntimeParameters_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetToolRuntimeParametersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PluginDispatchServer).GetToolRuntimeParameters(m, &pluginDispatchGetToolRuntimeParametersServer{ServerStream: stream})
+}
+
+type PluginDispatch_GetToolRuntimeParametersServer interface {
+	Send(*GetToolRuntimeParametersResponse) error
+	grpc.ServerStream
+}
+
+type pluginDispatchGetToolRuntimeParametersServer struct {
+	grpc.ServerStream
+}
+
+func (x *pluginDispatchGetToolRuntimeParametersServer) Send(m *GetToolRuntimeParametersResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PluginDispatch_InvokeAgentStrategy_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InvokeAgentStrategyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PluginDispatchServer).InvokeAgentStrategy(m, &pluginDispatchInvokeAgentStrategyServer{ServerStream: stream})
+}
+
+type PluginDispatch_InvokeAgentStrategyServer interface {
+	Send(*ToolResponseChunk) error
+	grpc.ServerStream
+}
+
+type pluginDispatchInvokeAgentStrategyServer struct {
+	grpc.ServerStream
+}
+
+func (x *pluginDispatchInvokeAgentStrategyServer) Send(m *ToolResponseChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PluginDispatch_InvokeLLM_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InvokeLLMRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PluginDispatchServer).InvokeLLM(m, &pluginDispatchInvokeLLMServer{ServerStream: stream})
+}
+
+type PluginDispatch_InvokeLLMServer interface {
+	Send(*LLMResultChunk) error
+	grpc.ServerStream
+}
+
+type pluginDispatchInvokeLLMServer struct {
+	grpc.ServerStream
+}
+
+func (x *pluginDispatchInvokeLLMServer) Send(m *LLMResultChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PluginDispatch_GetLLMNumTokens_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetLLMNumTokensRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PluginDispatchServer).GetLLMNumTokens(m, &pluginDispatchGetLLMNumTokensServer{ServerStream: stream})
+}
+
+type PluginDispatch_GetLLMNumTokensServer interface {
+	Send(*LLMGetNumTokensResponse) error
+	grpc.ServerStream
+}
+
+type pluginDispatchGetLLMNumTokensServer struct {
+	grpc.ServerStream
+}
+
+func (x *pluginDispatchGetLLMNumTokensServer) Send(m *LLMGetNumTokensResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PluginDispatch_InvokeTextEmbedding_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InvokeTextEmbeddingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PluginDispatchServer).InvokeTextEmbedding(m, &pluginDispatchInvokeTextEmbeddingServer{ServerStream: stream})
+}
+
+type PluginDispatch_InvokeTextEmbeddingServer interface {
+	Send(*TextEmbeddingResult) error
+	grpc.ServerStream
+}
+
+type pluginDispatchInvokeTextEmbeddingServer struct {
+	grpc.ServerStream
+}
+
+func (x *pluginDispatchInvokeTextEmbeddingServer) Send(m *TextEmbeddingResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PluginDispatch_GetTextEmbeddingNumTokens_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTextEmbeddingNumTokensRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PluginDispatchServer).GetTextEmbeddingNumTokens(m, &pluginDispatchGetTextEmbeddingNumTokensServer{ServerStream: stream})
+}
+
+type PluginDispatch_GetTextEmbeddingNumTokensServer interface {
+	Send(*GetTextEmbeddingNumTokensResponse) error
+	grpc.ServerStream
+}
+
+type pluginDispatchGetTextEmbeddingNumTokensServer struct {
+	grpc.ServerStream
+}
+
+func (x *pluginDispatchGetTextEmbeddingNumTokensServer) Send(m *GetTextEmbeddingNumTokensResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PluginDispatch_InvokeRerank_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InvokeRerankRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PluginDispatchServer).InvokeRerank(m, &pluginDispatchInvokeRerankServer{ServerStream: stream})
+}
+
+type PluginDispatch_InvokeRerankServer interface {
+	Send(*RerankResult) error
+	grpc.ServerStream
+}
+
+type pluginDispatchInvokeRerankServer struct {
+	grpc.ServerStream
+}
+
+func (x *pluginDispatchInvokeRerankServer) Send(m *RerankResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PluginDispatch_InvokeTTS_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InvokeTTSRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PluginDispatchServer).InvokeTTS(m, &pluginDispatchInvokeTTSServer{ServerStream: stream})
+}
+
+type PluginDispatch_InvokeTTSServer interface {
+	Send(*TTSResult) error
+	grpc.ServerStream
+}
+
+type pluginDispatchInvokeTTSServer struct {
+	grpc.ServerStream
+}
+
+func (x *pluginDispatchInvokeTTSServer) Send(m *TTSResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PluginDispatch_GetTTSModelVoices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTTSModelVoicesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PluginDispatchServer).GetTTSModelVoices(m, &pluginDispatchGetTTSModelVoicesServer{ServerStream: stream})
+}
+
+type PluginDispatch_GetTTSModelVoicesServer interface {
+	Send(*GetTTSVoicesResponse) error
+	grpc.ServerStream
+}
+
+type pluginDispatchGetTTSModelVoicesServer struct {
+	grpc.ServerStream
+}
+
+func (x *pluginDispatchGetTTSModelVoicesServer) Send(m *GetTTSVoicesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PluginDispatch_InvokeSpeech2Text_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InvokeSpeech2TextRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PluginDispatchServer).InvokeSpeech2Text(m, &pluginDispatchInvokeSpeech2TextServer{ServerStream: stream})
+}
+
+type PluginDispatch_InvokeSpeech2TextServer interface {
+	Send(*Speech2TextResult) error
+	grpc.ServerStream
+}
+
+type pluginDispatchInvokeSpeech2TextServer struct {
+	grpc.ServerStream
+}
+
+func (x *pluginDispatchInvokeSpeech2TextServer) Send(m *Speech2TextResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PluginDispatch_InvokeModeration_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InvokeModerationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PluginDispatchServer).InvokeModeration(m, &pluginDispatchInvokeModerationServer{ServerStream: stream})
+}
+
+type PluginDispatch_InvokeModerationServer interface {
+	Send(*ModerationResult) error
+	grpc.ServerStream
+}
+
+type pluginDispatchInvokeModerationServer struct {
+	grpc.ServerStream
+}
+
+func (x *pluginDispatchInvokeModerationServer) Send(m *ModerationResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PluginDispatch_ValidateProviderCredentials_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ValidateProviderCredentialsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PluginDispatchServer).ValidateProviderCredentials(m, &pluginDispatchValidateProviderCredentialsServer{ServerStream: stream})
+}
+
+type PluginDispatch_ValidateProviderCredentialsServer interface {
+	Send(*ValidateCredentialsResult) error
+	grpc.ServerStream
+}
+
+type pluginDispatchValidateProviderCredentialsServer struct {
+	grpc.ServerStream
+}
+
+func (x *pluginDispatchValidateProviderCredentialsServer) Send(m *ValidateCredentialsResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PluginDispatch_ValidateModelCredentials_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ValidateModelCredentialsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PluginDispatchServer).ValidateModelCredentials(m, &pluginDispatchValidateModelCredentialsServer{ServerStream: stream})
+}
+
+type PluginDispatch_ValidateModelCredentialsServer interface {
+	Send(*ValidateCredentialsResult) error
+	grpc.ServerStream
+}
+
+type pluginDispatchValidateModelCredentialsServer struct {
+	grpc.ServerStream
+}
+
+func (x *pluginDispatchValidateModelCredentialsServer) Send(m *ValidateCredentialsResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PluginDispatch_GetAIModelSchema_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetAIModelSchemaRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PluginDispatchServer).GetAIModelSchema(m, &pluginDispatchGetAIModelSchemaServer{ServerStream: stream})
+}
+
+type PluginDispatch_GetAIModelSchemaServer interface {
+	Send(*GetModelSchemasResponse) error
+	grpc.ServerStream
+}
+
+type pluginDispatchGetAIModelSchemaServer struct {
+	grpc.ServerStream
+}
+
+func (x *pluginDispatchGetAIModelSchemaServer) Send(m *GetModelSchemasResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// PluginDispatch_ServiceDesc is the grpc.ServiceDesc for PluginDispatch service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PluginDispatch_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "plugin_daemon.v1.PluginDispatch",
+	HandlerType: (*PluginDispatchServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "InvokeTool",
+			Handler:       _PluginDispatch_InvokeTool_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ValidateToolCredentials",
+			Handler:       _PluginDispatch_ValidateToolCredentials_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetToolRuntimeParameters",
+			Handler:       _PluginDispatch_GetToolRuntimeParameters_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "InvokeAgentStrategy",
+			Handler:       _PluginDispatch_InvokeAgentStrategy_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "InvokeLLM",
+			Handler:       _PluginDispatch_InvokeLLM_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetLLMNumTokens",
+			Handler:       _PluginDispatch_GetLLMNumTokens_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "InvokeTextEmbedding",
+			Handler:       _PluginDispatch_InvokeTextEmbedding_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetTextEmbeddingNumTokens",
+			Handler:       _PluginDispatch_GetTextEmbeddingNumTokens_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "InvokeRerank",
+			Handler:       _PluginDispatch_InvokeRerank_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "InvokeTTS",
+			Handler:       _PluginDispatch_InvokeTTS_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetTTSModelVoices",
+			Handler:       _PluginDispatch_GetTTSModelVoices_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "InvokeSpeech2Text",
+			Handler:       _PluginDispatch_InvokeSpeech2Text_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "InvokeModeration",
+			Handler:       _PluginDispatch_InvokeModeration_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ValidateProviderCredentials",
+			Handler:       _PluginDispatch_ValidateProviderCredentials_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ValidateModelCredentials",
+			Handler:       _PluginDispatch_ValidateModelCredentials_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetAIModelSchema",
+			Handler:       _PluginDispatch_GetAIModelSchema_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "plugin_daemon/v1/dispatch.proto",
+}
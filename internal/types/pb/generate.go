@@ -0,0 +1,5 @@
+// Package pb contains the messages and services generated from proto/plugin_daemon/v1,
+// do not edit the generated files, regenerate them after changing the protos
+package pb
+
+//go:generate protoc --proto_path=../../../proto --go_out=../../.. --go_opt=module=github.com/mlchain/mlchain-plugin-daemon --go-grpc_out=../../.. --go-grpc_opt=module=github.com/mlchain/mlchain-plugin-daemon plugin_daemon/v1/dispatch.proto plugin_daemon/v1/management.proto
@@ -0,0 +1,1030 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: plugin_daemon/v1/management.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	PluginManagement_UploadPlugin_FullMethodName                         = "/plugin_daemon.v1.PluginManagement/UploadPlugin"
+	PluginManagement_UploadBundle_FullMethodName                         = "/plugin_daemon.v1.PluginManagement/UploadBundle"
+	PluginManagement_InstallPluginFromIdentifiers_FullMethodName         = "/plugin_daemon.v1.PluginManagement/InstallPluginFromIdentifiers"
+	PluginManagement_UpgradePlugin_FullMethodName                        = "/plugin_daemon.v1.PluginManagement/UpgradePlugin"
+	PluginManagement_FetchPluginInstallationTask_FullMethodName          = "/plugin_daemon.v1.PluginManagement/FetchPluginInstallationTask"
+	PluginManagement_DeleteAllPluginInstallationTasks_FullMethodName     = "/plugin_daemon.v1.PluginManagement/DeleteAllPluginInstallationTasks"
+	PluginManagement_DeletePluginInstallationTask_FullMethodName         = "/plugin_daemon.v1.PluginManagement/DeletePluginInstallationTask"
+	PluginManagement_DeletePluginInstallationItemFromTask_FullMethodName = "/plugin_daemon.v1.PluginManagement/DeletePluginInstallationItemFromTask"
+	PluginManagement_FetchPluginInstallationTasks_FullMethodName         = "/plugin_daemon.v1.PluginManagement/FetchPluginInstallationTasks"
+	PluginManagement_FetchPluginManifest_FullMethodName                  = "/plugin_daemon.v1.PluginManagement/FetchPluginManifest"
+	PluginManagement_FetchPluginFromIdentifier_FullMethodName            = "/plugin_daemon.v1.PluginManagement/FetchPluginFromIdentifier"
+	PluginManagement_UninstallPlugin_FullMethodName                      = "/plugin_daemon.v1.PluginManagement/UninstallPlugin"
+	PluginManagement_ListPluginRuntimes_FullMethodName                   = "/plugin_daemon.v1.PluginManagement/ListPluginRuntimes"
+	PluginManagement_ListPluginLogs_FullMethodName                       = "/plugin_daemon.v1.PluginManagement/ListPluginLogs"
+	PluginManagement_TailPluginLogs_FullMethodName                       = "/plugin_daemon.v1.PluginManagement/TailPluginLogs"
+	PluginManagement_ListPluginCrashes_FullMethodName                    = "/plugin_daemon.v1.PluginManagement/ListPluginCrashes"
+	PluginManagement_ListPlugins_FullMethodName                          = "/plugin_daemon.v1.PluginManagement/ListPlugins"
+	PluginManagement_BatchFetchPluginInstallationByIDs_FullMethodName    = "/plugin_daemon.v1.PluginManagement/BatchFetchPluginInstallationByIDs"
+	PluginManagement_FetchMissingPluginInstallations_FullMethodName      = "/plugin_daemon.v1.PluginManagement/FetchMissingPluginInstallations"
+	PluginManagement_ListModels_FullMethodName                           = "/plugin_daemon.v1.PluginManagement/ListModels"
+	PluginManagement_ListTools_FullMethodName                            = "/plugin_daemon.v1.PluginManagement/ListTools"
+	PluginManagement_GetTool_FullMethodName                              = "/plugin_daemon.v1.PluginManagement/GetTool"
+	PluginManagement_CheckToolExistence_FullMethodName                   = "/plugin_daemon.v1.PluginManagement/CheckToolExistence"
+	PluginManagement_ListAgentStrategies_FullMethodName                  = "/plugin_daemon.v1.PluginManagement/ListAgentStrategies"
+	PluginManagement_GetAgentStrategy_FullMethodName                     = "/plugin_daemon.v1.PluginManagement/GetAgentStrategy"
+)
+
+// PluginManagementClient is the client API for PluginManagement service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PluginManagement mirrors the `/plugin/:tenant_id/management` apis, the caller is authorized
+// by the `x-api-key` metadata, every rpc returns the data of the http api, typed for the list and
+// fetch rpcs, a failure is returned as an error with the message of the error response of the http api
+type PluginManagementClient interface {
+	UploadPlugin(ctx context.Context, in *UploadPluginRequest, opts ...grpc.CallOption) (*ManagementResponse, error)
+	UploadBundle(ctx context.Context, in *UploadBundleRequest, opts ...grpc.CallOption) (*ManagementResponse, error)
+	InstallPluginFromIdentifiers(ctx context.Context, in *InstallPluginFromIdentifiersRequest, opts ...grpc.CallOption) (*ManagementResponse, error)
+	UpgradePlugin(ctx context.Context, in *UpgradePluginRequest, opts ...grpc.CallOption) (*ManagementResponse, error)
+	FetchPluginInstallationTask(ctx context.Context, in *PluginInstallationTaskRequest, opts ...grpc.CallOption) (*FetchPluginInstallationTaskResponse, error)
+	DeleteAllPluginInstallationTasks(ctx context.Context, in *TenantRequest, opts ...grpc.CallOption) (*ManagementResponse, error)
+	DeletePluginInstallationTask(ctx context.Context, in *PluginInstallationTaskRequest, opts ...grpc.CallOption) (*ManagementResponse, error)
+	DeletePluginInstallationItemFromTask(ctx context.Context, in *DeletePluginInstallationItemFromTaskRequest, opts ...grpc.CallOption) (*ManagementResponse, error)
+	FetchPluginInstallationTasks(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*FetchPluginInstallationTasksResponse, error)
+	FetchPluginManifest(ctx context.Context, in *PluginRequest, opts ...grpc.CallOption) (*FetchPluginManifestResponse, error)
+	FetchPluginFromIdentifier(ctx context.Context, in *PluginRequest, opts ...grpc.CallOption) (*FetchPluginFromIdentifierResponse, error)
+	UninstallPlugin(ctx context.Context, in *UninstallPluginRequest, opts ...grpc.CallOption) (*ManagementResponse, error)
+	ListPluginRuntimes(ctx context.Context, in *TenantRequest, opts ...grpc.CallOption) (*ListPluginRuntimesResponse, error)
+	ListPluginLogs(ctx context.Context, in *PluginLogsRequest, opts ...grpc.CallOption) (*ManagementResponse, error)
+	TailPluginLogs(ctx context.Context, in *PluginLogsRequest, opts ...grpc.CallOption) (*ManagementResponse, error)
+	ListPluginCrashes(ctx context.Context, in *PluginCrashesRequest, opts ...grpc.CallOption) (*ManagementResponse, error)
+	ListPlugins(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListPluginsResponse, error)
+	BatchFetchPluginInstallationByIDs(ctx context.Context, in *BatchFetchPluginInstallationByIDsRequest, opts ...grpc.CallOption) (*ListPluginsResponse, error)
+	FetchMissingPluginInstallations(ctx context.Context, in *PluginsRequest, opts ...grpc.CallOption) (*FetchMissingPluginInstallationsResponse, error)
+	ListModels(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ManagementResponse, error)
+	ListTools(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ManagementResponse, error)
+	GetTool(ctx context.Context, in *ProviderRequest, opts ...grpc.CallOption) (*ManagementResponse, error)
+	CheckToolExistence(ctx context.Context, in *CheckToolExistenceRequest, opts ...grpc.CallOption) (*ManagementResponse, error)
+	ListAgentStrategies(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ManagementResponse, error)
+	GetAgentStrategy(ctx context.Context, in *ProviderRequest, opts ...grpc.CallOption) (*ManagementResponse, error)
+}
+
+type pluginManagementClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPluginManagementClient(cc grpc.ClientConnInterface) PluginManagementClient {
+	return &pluginManagementClient{cc}
+}
+
+func (c *pluginManagementClient) UploadPlugin(ctx context.Context, in *UploadPluginRequest, opts ...grpc.CallOption) (*ManagementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManagementResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_UploadPlugin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) UploadBundle(ctx context.Context, in *UploadBundleRequest, opts ...grpc.CallOption) (*ManagementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManagementResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_UploadBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) InstallPluginFromIdentifiers(ctx context.Context, in *InstallPluginFromIdentifiersRequest, opts ...grpc.CallOption) (*ManagementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManagementResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_InstallPluginFromIdentifiers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) UpgradePlugin(ctx context.Context, in *UpgradePluginRequest, opts ...grpc.CallOption) (*ManagementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManagementResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_UpgradePlugin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) FetchPluginInstallationTask(ctx context.Context, in *PluginInstallationTaskRequest, opts ...grpc.CallOption) (*FetchPluginInstallationTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FetchPluginInstallationTaskResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_FetchPluginInstallationTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) DeleteAllPluginInstallationTasks(ctx context.Context, in *TenantRequest, opts ...grpc.CallOption) (*ManagementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManagementResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_DeleteAllPluginInstallationTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) DeletePluginInstallationTask(ctx context.Context, in *PluginInstallationTaskRequest, opts ...grpc.CallOption) (*ManagementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManagementResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_DeletePluginInstallationTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) DeletePluginInstallationItemFromTask(ctx context.Context, in *DeletePluginInstallationItemFromTaskRequest, opts ...grpc.CallOption) (*ManagementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManagementResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_DeletePluginInstallationItemFromTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) FetchPluginInstallationTasks(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*FetchPluginInstallationTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FetchPluginInstallationTasksResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_FetchPluginInstallationTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) FetchPluginManifest(ctx context.Context, in *PluginRequest, opts ...grpc.CallOption) (*FetchPluginManifestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FetchPluginManifestResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_FetchPluginManifest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) FetchPluginFromIdentifier(ctx context.Context, in *PluginRequest, opts ...grpc.CallOption) (*FetchPluginFromIdentifierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FetchPluginFromIdentifierResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_FetchPluginFromIdentifier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) UninstallPlugin(ctx context.Context, in *UninstallPluginRequest, opts ...grpc.CallOption) (*ManagementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManagementResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_UninstallPlugin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) ListPluginRuntimes(ctx context.Context, in *TenantRequest, opts ...grpc.CallOption) (*ListPluginRuntimesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPluginRuntimesResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_ListPluginRuntimes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) ListPluginLogs(ctx context.Context, in *PluginLogsRequest, opts ...grpc.CallOption) (*ManagementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManagementResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_ListPluginLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) TailPluginLogs(ctx context.Context, in *PluginLogsRequest, opts ...grpc.CallOption) (*ManagementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManagementResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_TailPluginLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) ListPluginCrashes(ctx context.Context, in *PluginCrashesRequest, opts ...grpc.CallOption) (*ManagementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManagementResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_ListPluginCrashes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) ListPlugins(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListPluginsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPluginsResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_ListPlugins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) BatchFetchPluginInstallationByIDs(ctx context.Context, in *BatchFetchPluginInstallationByIDsRequest, opts ...grpc.CallOption) (*ListPluginsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPluginsResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_BatchFetchPluginInstallationByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) FetchMissingPluginInstallations(ctx context.Context, in *PluginsRequest, opts ...grpc.CallOption) (*FetchMissingPluginInstallationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FetchMissingPluginInstallationsResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_FetchMissingPluginInstallations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) ListModels(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ManagementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManagementResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_ListModels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) ListTools(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ManagementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManagementResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_ListTools_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) GetTool(ctx context.Context, in *ProviderRequest, opts ...grpc.CallOption) (*ManagementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManagementResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_GetTool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) CheckToolExistence(ctx context.Context, in *CheckToolExistenceRequest, opts ...grpc.CallOption) (*ManagementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManagementResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_CheckToolExistence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) ListAgentStrategies(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ManagementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManagementResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_ListAgentStrategies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginManagementClient) GetAgentStrategy(ctx context.Context, in *ProviderRequest, opts ...grpc.CallOption) (*ManagementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManagementResponse)
+	err := c.cc.Invoke(ctx, PluginManagement_GetAgentStrategy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PluginManagementServer is the server API for PluginManagement service.
+// All implementations must embed UnimplementedPluginManagementServer
+// for forward compatibility
+//
+// PluginManagement mirrors the `/plugin/:tenant_id/management` apis, the caller is authorized
+// by the `x-api-key` metadata, every rpc returns the data of the http api, typed for the list and
+// fetch rpcs, a failure is returned as an error with the message of the error response of the http api
+type PluginManagementServer interface {
+	UploadPlugin(context.Context, *UploadPluginRequest) (*ManagementResponse, error)
+	UploadBundle(context.Context, *UploadBundleRequest) (*ManagementResponse, error)
+	InstallPluginFromIdentifiers(context.Context, *InstallPluginFromIdentifiersRequest) (*ManagementResponse, error)
+	UpgradePlugin(context.Context, *UpgradePluginRequest) (*ManagementResponse, error)
+	FetchPluginInstallationTask(context.Context, *PluginInstallationTaskRequest) (*FetchPluginInstallationTaskResponse, error)
+	DeleteAllPluginInstallationTasks(context.Context, *TenantRequest) (*ManagementResponse, error)
+	DeletePluginInstallationTask(context.Context, *PluginInstallationTaskRequest) (*ManagementResponse, error)
+	DeletePluginInstallationItemFromTask(context.Context, *DeletePluginInstallationItemFromTaskRequest) (*ManagementResponse, error)
+	FetchPluginInstallationTasks(context.Context, *ListRequest) (*FetchPluginInstallationTasksResponse, error)
+	FetchPluginManifest(context.Context, *PluginRequest) (*FetchPluginManifestResponse, error)
+	FetchPluginFromIdentifier(context.Context, *PluginRequest) (*FetchPluginFromIdentifierResponse, error)
+	UninstallPlugin(context.Context, *UninstallPluginRequest) (*ManagementResponse, error)
+	ListPluginRuntimes(context.Context, *TenantRequest) (*ListPluginRuntimesResponse, error)
+	ListPluginLogs(context.Context, *PluginLogsRequest) (*ManagementResponse, error)
+	TailPluginLogs(context.Context, *PluginLogsRequest) (*ManagementResponse, error)
+	ListPluginCrashes(context.Context, *PluginCrashesRequest) (*ManagementResponse, error)
+	ListPlugins(context.Context, *ListRequest) (*ListPluginsResponse, error)
+	BatchFetchPluginInstallationByIDs(context.Context, *BatchFetchPluginInstallationByIDsRequest) (*ListPluginsResponse, error)
+	FetchMissingPluginInstallations(context.Context, *PluginsRequest) (*FetchMissingPluginInstallationsResponse, error)
+	ListModels(context.Context, *ListRequest) (*ManagementResponse, error)
+	ListTools(context.Context, *ListRequest) (*ManagementResponse, error)
+	GetTool(context.Context, *ProviderRequest) (*ManagementResponse, error)
+	CheckToolExistence(context.Context, *CheckToolExistenceRequest) (*ManagementResponse, error)
+	ListAgentStrategies(context.Context, *ListRequest) (*ManagementResponse, error)
+	GetAgentStrategy(context.Context, *ProviderRequest) (*ManagementResponse, error)
+	mustEmbedUnimplementedPluginManagementServer()
+}
+
+// UnimplementedPluginManagementServer must be embedded to have forward compatible implementations.
+type UnimplementedPluginManagementServer struct {
+}
+
+func (UnimplementedPluginManagementServer) UploadPlugin(context.Context, *UploadPluginRequest) (*ManagementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadPlugin not implemented")
+}
+func (UnimplementedPluginManagementServer) UploadBundle(context.Context, *UploadBundleRequest) (*ManagementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadBundle not implemented")
+}
+func (UnimplementedPluginManagementServer) InstallPluginFromIdentifiers(context.Context, *InstallPluginFromIdentifiersRequest) (*ManagementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallPluginFromIdentifiers not implemented")
+}
+func (UnimplementedPluginManagementServer) UpgradePlugin(context.Context, *UpgradePluginRequest) (*ManagementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradePlugin not implemented")
+}
+func (UnimplementedPluginManagementServer) FetchPluginInstallationTask(context.Context, *PluginInstallationTaskRequest) (*FetchPluginInstallationTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchPluginInstallationTask not implemented")
+}
+func (UnimplementedPluginManagementServer) DeleteAllPluginInstallationTasks(context.Context, *TenantRequest) (*ManagementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAllPluginInstallationTasks not implemented")
+}
+func (UnimplementedPluginManagementServer) DeletePluginInstallationTask(context.Context, *PluginInstallationTaskRequest) (*ManagementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePluginInstallationTask not implemented")
+}
+func (UnimplementedPluginManagementServer) DeletePluginInstallationItemFromTask(context.Context, *DeletePluginInstallationItemFromTaskRequest) (*ManagementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePluginInstallationItemFromTask not implemented")
+}
+func (UnimplementedPluginManagementServer) FetchPluginInstallationTasks(context.Context, *ListRequest) (*FetchPluginInstallationTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchPluginInstallationTasks not implemented")
+}
+func (UnimplementedPluginManagementServer) FetchPluginManifest(context.Context, *PluginRequest) (*FetchPluginManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchPluginManifest not implemented")
+}
+func (UnimplementedPluginManagementServer) FetchPluginFromIdentifier(context.Context, *PluginRequest) (*FetchPluginFromIdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchPluginFromIdentifier not implemented")
+}
+func (UnimplementedPluginManagementServer) UninstallPlugin(context.Context, *UninstallPluginRequest) (*ManagementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UninstallPlugin not implemented")
+}
+func (UnimplementedPluginManagementServer) ListPluginRuntimes(context.Context, *TenantRequest) (*ListPluginRuntimesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPluginRuntimes not implemented")
+}
+func (UnimplementedPluginManagementServer) ListPluginLogs(context.Context, *PluginLogsRequest) (*ManagementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPluginLogs not implemented")
+}
+func (UnimplementedPluginManagementServer) TailPluginLogs(context.Context, *PluginLogsRequest) (*ManagementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TailPluginLogs not implemented")
+}
+func (UnimplementedPluginManagementServer) ListPluginCrashes(context.Context, *PluginCrashesRequest) (*ManagementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPluginCrashes not implemented")
+}
+func (UnimplementedPluginManagementServer) ListPlugins(context.Context, *ListRequest) (*ListPluginsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlugins not implemented")
+}
+func (UnimplementedPluginManagementServer) BatchFetchPluginInstallationByIDs(context.Context, *BatchFetchPluginInstallationByIDsRequest) (*ListPluginsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchFetchPluginInstallationByIDs not implemented")
+}
+func (UnimplementedPluginManagementServer) FetchMissingPluginInstallations(context.Context, *PluginsRequest) (*FetchMissingPluginInstallationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchMissingPluginInstallations not implemented")
+}
+func (UnimplementedPluginManagementServer) ListModels(context.Context, *ListRequest) (*ManagementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModels not implemented")
+}
+func (UnimplementedPluginManagementServer) ListTools(context.Context, *ListRequest) (*ManagementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTools not implemented")
+}
+func (UnimplementedPluginManagementServer) GetTool(context.Context, *ProviderRequest) (*ManagementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTool not implemented")
+}
+func (UnimplementedPluginManagementServer) CheckToolExistence(context.Context, *CheckToolExistenceRequest) (*ManagementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckToolExistence not implemented")
+}
+func (UnimplementedPluginManagementServer) ListAgentStrategies(context.Context, *ListRequest) (*ManagementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAgentStrategies not implemented")
+}
+func (UnimplementedPluginManagementServer) GetAgentStrategy(context.Context, *ProviderRequest) (*ManagementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgentStrategy not implemented")
+}
+func (UnimplementedPluginManagementServer) mustEmbedUnimplementedPluginManagementServer() {}
+
+// UnsafePluginManagementServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PluginManagementServer will
+// result in compilation errors.
+type UnsafePluginManagementServer interface {
+	mustEmbedUnimplementedPluginManagementServer()
+}
+
+func RegisterPluginManagementServer(s grpc.ServiceRegistrar, srv PluginManagementServer) {
+	s.RegisterService(&PluginManagement_ServiceDesc, srv)
+}
+
+func _PluginManagement_UploadPlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadPluginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).UploadPlugin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_UploadPlugin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).UploadPlugin(ctx, req.(*UploadPluginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_UploadBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).UploadBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_UploadBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).UploadBundle(ctx, req.(*UploadBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_InstallPluginFromIdentifiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstallPluginFromIdentifiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).InstallPluginFromIdentifiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_InstallPluginFromIdentifiers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).InstallPluginFromIdentifiers(ctx, req.(*InstallPluginFromIdentifiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_UpgradePlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradePluginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).UpgradePlugin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_UpgradePlugin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).UpgradePlugin(ctx, req.(*UpgradePluginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_FetchPluginInstallationTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginInstallationTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).FetchPluginInstallationTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_FetchPluginInstallationTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).FetchPluginInstallationTask(ctx, req.(*PluginInstallationTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_DeleteAllPluginInstallationTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).DeleteAllPluginInstallationTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_DeleteAllPluginInstallationTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).DeleteAllPluginInstallationTasks(ctx, req.(*TenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_DeletePluginInstallationTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginInstallationTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).DeletePluginInstallationTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_DeletePluginInstallationTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).DeletePluginInstallationTask(ctx, req.(*PluginInstallationTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_DeletePluginInstallationItemFromTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePluginInstallationItemFromTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).DeletePluginInstallationItemFromTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_DeletePluginInstallationItemFromTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).DeletePluginInstallationItemFromTask(ctx, req.(*DeletePluginInstallationItemFromTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_FetchPluginInstallationTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).FetchPluginInstallationTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_FetchPluginInstallationTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).FetchPluginInstallationTasks(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_FetchPluginManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).FetchPluginManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_FetchPluginManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).FetchPluginManifest(ctx, req.(*PluginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_FetchPluginFromIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).FetchPluginFromIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_FetchPluginFromIdentifier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).FetchPluginFromIdentifier(ctx, req.(*PluginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_UninstallPlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UninstallPluginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).UninstallPlugin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_UninstallPlugin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).UninstallPlugin(ctx, req.(*UninstallPluginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_ListPluginRuntimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).ListPluginRuntimes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_ListPluginRuntimes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).ListPluginRuntimes(ctx, req.(*TenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_ListPluginLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).ListPluginLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_ListPluginLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).ListPluginLogs(ctx, req.(*PluginLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_TailPluginLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).TailPluginLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_TailPluginLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).TailPluginLogs(ctx, req.(*PluginLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_ListPluginCrashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginCrashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).ListPluginCrashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_ListPluginCrashes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).ListPluginCrashes(ctx, req.(*PluginCrashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_ListPlugins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).ListPlugins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_ListPlugins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).ListPlugins(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_BatchFetchPluginInstallationByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchFetchPluginInstallationByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).BatchFetchPluginInstallationByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_BatchFetchPluginInstallationByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).BatchFetchPluginInstallationByIDs(ctx, req.(*BatchFetchPluginInstallationByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_FetchMissingPluginInstallations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).FetchMissingPluginInstallations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_FetchMissingPluginInstallations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).FetchMissingPluginInstallations(ctx, req.(*PluginsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_ListModels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).ListModels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_ListModels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).ListModels(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_ListTools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).ListTools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_ListTools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).ListTools(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_GetTool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).GetTool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_GetTool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).GetTool(ctx, req.(*ProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_CheckToolExistence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckToolExistenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).CheckToolExistence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_CheckToolExistence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).CheckToolExistence(ctx, req.(*CheckToolExistenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_ListAgentStrategies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).ListAgentStrategies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_ListAgentStrategies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).ListAgentStrategies(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PluginManagement_GetAgentStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginManagementServer).GetAgentStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PluginManagement_GetAgentStrategy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginManagementServer).GetAgentStrategy(ctx, req.(*ProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PluginManagement_ServiceDesc is the grpc.ServiceDesc for PluginManagement service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PluginManagement_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "plugin_daemon.v1.PluginManagement",
+	HandlerType: (*PluginManagementServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UploadPlugin",
+			Handler:    _PluginManagement_UploadPlugin_Handler,
+		},
+		{
+			MethodName: "UploadBundle",
+			Handler:    _PluginManagement_UploadBundle_Handler,
+		},
+		{
+			MethodName: "InstallPluginFromIdentifiers",
+			Handler:    _PluginManagement_InstallPluginFromIdentifiers_Handler,
+		},
+		{
+			MethodName: "UpgradePlugin",
+			Handler:    _PluginManagement_UpgradePlugin_Handler,
+		},
+		{
+			MethodName: "FetchPluginInstallationTask",
+			Handler:    _PluginManagement_FetchPluginInstallationTask_Handler,
+		},
+		{
+			MethodName: "DeleteAllPluginInstallationTasks",
+			Handler:    _PluginManagement_DeleteAllPluginInstallationTasks_Handler,
+		},
+		{
+			MethodName: "DeletePluginInstallationTask",
+			Handler:    _PluginManagement_DeletePluginInstallationTask_Handler,
+		},
+		{
+			MethodName: "DeletePluginInstallationItemFromTask",
+			Handler:    _PluginManagement_DeletePluginInstallationItemFromTask_Handler,
+		},
+		{
+			MethodName: "FetchPluginInstallationTasks",
+			Handler:    _PluginManagement_FetchPluginInstallationTasks_Handler,
+		},
+		{
+			MethodName: "FetchPluginManifest",
+			Handler:    _PluginManagement_FetchPluginManifest_Handler,
+		},
+		{
+			MethodName: "FetchPluginFromIdentifier",
+			Handler:    _PluginManagement_FetchPluginFromIdentifier_Handler,
+		},
+		{
+			MethodName: "UninstallPlugin",
+			Handler:    _PluginManagement_UninstallPlugin_Handler,
+		},
+		{
+			MethodName: "ListPluginRuntimes",
+			Handler:    _PluginManagement_ListPluginRuntimes_Handler,
+		},
+		{
+			MethodName: "ListPluginLogs",
+			Handler:    _PluginManagement_ListPluginLogs_Handler,
+		},
+		{
+			MethodName: "TailPluginLogs",
+			Handler:    _PluginManagement_TailPluginLogs_Handler,
+		},
+		{
+			MethodName: "ListPluginCrashes",
+			Handler:    _PluginManagement_ListPluginCrashes_Handler,
+		},
+		{
+			MethodName: "ListPlugins",
+			Handler:    _PluginManagement_ListPlugins_Handler,
+		},
+		{
+			MethodName: "BatchFetchPluginInstallationByIDs",
+			Handler:    _PluginManagement_BatchFetchPluginInstallationByIDs_Handler,
+		},
+		{
+			MethodName: "FetchMissingPluginInstallations",
+			Handler:    _PluginManagement_FetchMissingPluginInstallations_Handler,
+		},
+		{
+			MethodName: "ListModels",
+			Handler:    _PluginManagement_ListModels_Handler,
+		},
+		{
+			MethodName: "ListTools",
+			Handler:    _PluginManagement_ListTools_Handler,
+		},
+		{
+			MethodName: "GetTool",
+			Handler:    _PluginManagement_GetTool_Handler,
+		},
+		{
+			MethodName: "CheckToolExistence",
+			Handler:    _PluginManagement_CheckToolExistence_Handler,
+		},
+		{
+			MethodName: "ListAgentStrategies",
+			Handler:    _PluginManagement_ListAgentStrategies_Handler,
+		},
+		{
+			MethodName: "GetAgentStrategy",
+			Handler:    _PluginManagement_GetAgentStrategy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "plugin_daemon/v1/management.proto",
+}
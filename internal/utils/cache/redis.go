@@ -261,6 +261,56 @@ func GetMap[V any](key string, context ...redis.Cmdable) (map[string]V, error) {
 	return result, nil
 }
 
+// PushList appends the values to the end of the list in a single round trip and refreshes the expire time of the list
+func PushList[T any](key string, values []T, expire time.Duration, context ...redis.Cmdable) error {
+	if client == nil {
+		return ErrDBNotInit
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	items := make([]any, 0, len(values))
+	for _, value := range values {
+		if str, ok := any(value).(string); ok {
+			items = append(items, str)
+		} else {
+			items = append(items, parser.MarshalJson(value))
+		}
+	}
+
+	_, err := getCmdable(context...).Pipelined(ctx, func(p redis.Pipeliner) error {
+		p.RPush(ctx, serialKey(key), items...)
+		p.Expire(ctx, serialKey(key), expire)
+		return nil
+	})
+	return err
+}
+
+// GetListRange get the items of the list from start to stop, both inclusive, negative indexes count from the end
+func GetListRange[T any](key string, start int64, stop int64, context ...redis.Cmdable) ([]T, error) {
+	if client == nil {
+		return nil, ErrDBNotInit
+	}
+
+	values, err := getCmdable(context...).LRange(ctx, serialKey(key), start, stop).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]T, 0, len(values))
+	for _, value := range values {
+		v, err := parser.UnmarshalJson[T](value)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+
+	return result, nil
+}
+
 // ScanKeys scan the keys with match pattern
 func ScanKeys(match string, context ...redis.Cmdable) ([]string, error) {
 	if client == nil {
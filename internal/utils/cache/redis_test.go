@@ -195,6 +195,47 @@ func TestRedisScanMap(t *testing.T) {
 	}
 }
 
+func TestRedisList(t *testing.T) {
+	// get redis connection
+	if err := getRedisConnection(); err != nil {
+		t.Errorf("get redis connection failed: %v", err)
+		return
+	}
+	defer Close()
+
+	type s struct {
+		Field string `json:"field"`
+	}
+
+	key := strings.Join([]string{TEST_PREFIX, "list"}, ":")
+	defer Del(key)
+
+	if err := PushList(key, []s{{Field: "value1"}}, time.Minute); err != nil {
+		t.Errorf("push list failed: %v", err)
+		return
+	}
+	if err := PushList(key, []s{{Field: "value2"}, {Field: "value3"}}, time.Minute); err != nil {
+		t.Errorf("push list failed: %v", err)
+		return
+	}
+
+	data, err := GetListRange[s](key, 1, -1)
+	if err != nil {
+		t.Errorf("get list range failed: %v", err)
+		return
+	}
+
+	if len(data) != 2 {
+		t.Errorf("get list range should return 2")
+		return
+	}
+
+	if data[0].Field != "value2" || data[1].Field != "value3" {
+		t.Errorf("get list range should return value2 and value3")
+		return
+	}
+}
+
 func TestRedisP2PPubsub(t *testing.T) {
 	// get redis connection
 	if err := getRedisConnection(); err != nil {
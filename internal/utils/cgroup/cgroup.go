@@ -0,0 +1,198 @@
+// Package cgroup manages cgroup v2 leaves through the unified hierarchy filesystem
+package cgroup
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+const (
+	// the default period of cpu.max in microseconds
+	CPU_PERIOD_USEC = 100000
+
+	// leaf the processes of the parent cgroup are moved to, a cgroup holding processes
+	// could not delegate controllers to its children
+	DAEMON_LEAF = "mlchain-plugin-daemon"
+)
+
+var ErrNotSupported = errors.New("cgroup v2 is not available, ensure the unified hierarchy is mounted and writable")
+
+// Cgroup is a leaf of the cgroup v2 hierarchy
+type Cgroup struct {
+	path string
+}
+
+// Events is a snapshot of the resource events of a cgroup
+type Events struct {
+	// times the OOM killer killed a process in the cgroup
+	OOMKills int64
+	// periods the cgroup was throttled by cpu.max
+	ThrottledPeriods int64
+	// total time the cgroup was throttled in microseconds
+	ThrottledUsec int64
+}
+
+// Init prepares the root cgroup where all the leaves are created,
+// memory and cpu controllers are delegated to its children
+func Init(root string) error {
+	parent := path.Dir(root)
+	if _, err := os.Stat(path.Join(parent, "cgroup.controllers")); err != nil {
+		return ErrNotSupported
+	}
+
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return err
+	}
+
+	// controllers must be enabled in the parent before they could be enabled in the root,
+	// a non-root parent which still holds processes, like the cgroup of a container, refuses it
+	if err := enableControllers(parent); errors.Is(err, syscall.EBUSY) {
+		if err := moveProcesses(parent, path.Join(parent, DAEMON_LEAF)); err != nil {
+			return err
+		}
+		if err := enableControllers(parent); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+
+	return enableControllers(root)
+}
+
+func enableControllers(dir string) error {
+	err := os.WriteFile(path.Join(dir, "cgroup.subtree_control"), []byte("+memory +cpu"), 0644)
+	if err != nil {
+		return fmt.Errorf("failed to enable controllers in %s: %w", dir, err)
+	}
+	return nil
+}
+
+// moveProcesses moves all the processes of the cgroup `from` into the leaf `to`, including the daemon itself
+func moveProcesses(from string, to string) error {
+	if err := os.MkdirAll(to, 0755); err != nil {
+		return err
+	}
+
+	procs, err := os.ReadFile(path.Join(from, "cgroup.procs"))
+	if err != nil {
+		return err
+	}
+
+	for _, pid := range strings.Fields(string(procs)) {
+		err := os.WriteFile(path.Join(to, "cgroup.procs"), []byte(pid), 0644)
+		// the process may have exited meanwhile
+		if err != nil && !errors.Is(err, syscall.ESRCH) {
+			return fmt.Errorf("failed to move process %s into %s: %w", pid, to, err)
+		}
+	}
+
+	return nil
+}
+
+// New creates a new leaf named `name` under the root cgroup
+func New(root string, name string) (*Cgroup, error) {
+	p := path.Join(root, name)
+	if err := os.Mkdir(p, 0755); err != nil && !os.IsExist(err) {
+		return nil, err
+	}
+
+	return &Cgroup{path: p}, nil
+}
+
+// Path returns the path of the cgroup
+func (c *Cgroup) Path() string {
+	return c.path
+}
+
+// SetMemoryMax sets the hard memory limit in bytes, swap is disabled
+// so that the limit could not be bypassed
+func (c *Cgroup) SetMemoryMax(bytes int64) error {
+	if err := c.write("memory.max", strconv.FormatInt(bytes, 10)); err != nil {
+		return err
+	}
+
+	// memory.swap.max does not exist if swap accounting is disabled
+	if _, err := os.Stat(path.Join(c.path, "memory.swap.max")); err == nil {
+		return c.write("memory.swap.max", "0")
+	}
+
+	return nil
+}
+
+// SetCPUQuota limits the cgroup to `cores` cpus, 1.5 means one and a half cpus
+func (c *Cgroup) SetCPUQuota(cores float64) error {
+	quota := int64(cores * CPU_PERIOD_USEC)
+	if quota <= 0 {
+		return c.write("cpu.max", fmt.Sprintf("max %d", CPU_PERIOD_USEC))
+	}
+	return c.write("cpu.max", fmt.Sprintf("%d %d", quota, CPU_PERIOD_USEC))
+}
+
+// AddProcess moves a running process into the cgroup, use Attach to start a process right inside it
+func (c *Cgroup) AddProcess(pid int) error {
+	return c.write("cgroup.procs", strconv.Itoa(pid))
+}
+
+// Events reads the OOM and throttling counters of the cgroup
+func (c *Cgroup) Events() (Events, error) {
+	events := Events{}
+
+	memoryEvents, err := c.readKeyValues("memory.events")
+	if err != nil {
+		return events, err
+	}
+	events.OOMKills = memoryEvents["oom_kill"]
+
+	cpuStat, err := c.readKeyValues("cpu.stat")
+	if err != nil {
+		return events, err
+	}
+	events.ThrottledPeriods = cpuStat["nr_throttled"]
+	events.ThrottledUsec = cpuStat["throttled_usec"]
+
+	return events, nil
+}
+
+// Delete removes the cgroup, it fails if there are still processes in it
+func (c *Cgroup) Delete() error {
+	return os.Remove(c.path)
+}
+
+func (c *Cgroup) write(file string, value string) error {
+	if err := os.WriteFile(path.Join(c.path, file), []byte(value), 0644); err != nil {
+		return fmt.Errorf("failed to write %s of cgroup %s: %s", file, c.path, err)
+	}
+	return nil
+}
+
+// readKeyValues parses flat keyed files like memory.events and cpu.stat
+func (c *Cgroup) readKeyValues(file string) (map[string]int64, error) {
+	f, err := os.Open(path.Join(c.path, file))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	result := map[string]int64{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		value, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		result[fields[0]] = value
+	}
+
+	return result, scanner.Err()
+}
@@ -0,0 +1,24 @@
+package cgroup
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// Attach makes the command start right inside the cgroup, so the process could not run or fork
+// before the limits apply, the returned file must be kept open until the command is started
+func (c *Cgroup) Attach(cmd *exec.Cmd) (*os.File, error) {
+	dir, err := os.OpenFile(c.path, os.O_RDONLY|syscall.O_DIRECTORY, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.UseCgroupFD = true
+	cmd.SysProcAttr.CgroupFD = int(dir.Fd())
+
+	return dir, nil
+}
@@ -0,0 +1,24 @@
+package cgroup
+
+import (
+	"os/exec"
+	"testing"
+)
+
+func TestAttachStartsCommandInCgroup(t *testing.T) {
+	c, err := New(t.TempDir(), "plugin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command("true")
+	dir, err := c.Attach(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dir.Close()
+
+	if cmd.SysProcAttr == nil || !cmd.SysProcAttr.UseCgroupFD || cmd.SysProcAttr.CgroupFD != int(dir.Fd()) {
+		t.Fatalf("expected the command to be started in the cgroup, got %+v", cmd.SysProcAttr)
+	}
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+
+package cgroup
+
+import (
+	"os"
+	"os/exec"
+)
+
+// Attach is not supported on this platform
+func (c *Cgroup) Attach(cmd *exec.Cmd) (*os.File, error) {
+	return nil, ErrNotSupported
+}
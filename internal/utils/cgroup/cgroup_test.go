@@ -0,0 +1,65 @@
+package cgroup
+
+import (
+	"os"
+	"path"
+	"testing"
+)
+
+func TestCgroupLimits(t *testing.T) {
+	root := t.TempDir()
+
+	c, err := New(root, "plugin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.SetMemoryMax(256 * 1024 * 1024); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.SetCPUQuota(1.5); err != nil {
+		t.Fatal(err)
+	}
+
+	memoryMax, _ := os.ReadFile(path.Join(c.Path(), "memory.max"))
+	if string(memoryMax) != "268435456" {
+		t.Fatalf("unexpected memory.max: %s", memoryMax)
+	}
+
+	cpuMax, _ := os.ReadFile(path.Join(c.Path(), "cpu.max"))
+	if string(cpuMax) != "150000 100000" {
+		t.Fatalf("unexpected cpu.max: %s", cpuMax)
+	}
+}
+
+func TestCgroupEvents(t *testing.T) {
+	root := t.TempDir()
+
+	c, err := New(root, "plugin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	os.WriteFile(path.Join(c.Path(), "memory.events"), []byte("low 0\nhigh 0\nmax 3\noom 1\noom_kill 1\n"), 0644)
+	os.WriteFile(path.Join(c.Path(), "cpu.stat"), []byte("usage_usec 100\nnr_periods 10\nnr_throttled 4\nthrottled_usec 2000\n"), 0644)
+
+	events, err := c.Events()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if events.OOMKills != 1 {
+		t.Fatalf("expected 1 oom kill, got %d", events.OOMKills)
+	}
+
+	if events.ThrottledPeriods != 4 || events.ThrottledUsec != 2000 {
+		t.Fatalf("unexpected throttling: %+v", events)
+	}
+}
+
+func TestInitWithoutCgroupV2(t *testing.T) {
+	if err := Init(path.Join(t.TempDir(), "mlchain")); err != ErrNotSupported {
+		t.Fatalf("expected ErrNotSupported, got %v", err)
+	}
+}
@@ -23,13 +23,7 @@ func MaskConfigCredentials(
 		if config, ok := configsMap[key]; ok {
 			if config.Type == plugin_entities.CONFIG_TYPE_SECRET_INPUT {
 				if originalValue, ok := value.(string); ok {
-					if len(originalValue) > 6 {
-						copiedCredentials[key] = originalValue[:2] +
-							strings.Repeat("*", len(originalValue)-4) +
-							originalValue[len(originalValue)-2:]
-					} else {
-						copiedCredentials[key] = strings.Repeat("*", len(originalValue))
-					}
+					copiedCredentials[key] = MaskSecret(originalValue)
 				} else {
 					copiedCredentials[key] = value
 				}
@@ -43,3 +37,12 @@ func MaskConfigCredentials(
 
 	return copiedCredentials
 }
+
+// MaskSecret keeps only the first and last two characters of a long secret
+func MaskSecret(value string) string {
+	if len(value) > 6 {
+		return value[:2] + strings.Repeat("*", len(value)-4) + value[len(value)-2:]
+	}
+
+	return strings.Repeat("*", len(value))
+}
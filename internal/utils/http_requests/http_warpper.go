@@ -93,6 +93,8 @@ func RequestAndParseStream[T any](client *http.Client, url string, method string
 	}
 
 	ch := stream.NewStream[T](1024)
+	// stop reading the body rather than dropping data once the reader is slow
+	ch.SetBlocking()
 
 	// get read timeout
 	readTimeout := int64(60000)
@@ -106,8 +108,9 @@ func RequestAndParseStream[T any](client *http.Client, url string, method string
 		}
 	}
 	time.AfterFunc(time.Millisecond*time.Duration(readTimeout), func() {
-		// close the response body if timeout
+		// close the response body if timeout, a writer waiting for the reader is released as well
 		resp.Body.Close()
+		ch.Close()
 	})
 
 	routine.Submit(map[string]string{
@@ -30,6 +30,15 @@ func (m *Map[K, V]) Delete(key K) {
 	m.store.Delete(key)
 }
 
+// CompareAndDelete deletes the key only if it's still mapped to old, old must be comparable
+func (m *Map[K, V]) CompareAndDelete(key K, old V) (deleted bool) {
+	deleted = m.store.CompareAndDelete(key, old)
+	if deleted {
+		atomic.AddInt32(&m.len, -1)
+	}
+	return
+}
+
 func (m *Map[K, V]) Range(f func(key K, value V) bool) {
 	m.store.Range(func(key, value interface{}) bool {
 		return f(key.(K), value.(V))
@@ -0,0 +1,82 @@
+package stream
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/gammazero/deque"
+)
+
+// spillFile keeps the data which does not fit in the buffer of a stream in a temporary file,
+// the file is unlinked once it's created, so nothing is left behind if the daemon exits
+type spillFile[T any] struct {
+	dir  string
+	file *os.File
+
+	// sizes of the spilled items in order, an item starts where the previous one ends
+	sizes   deque.Deque[int64]
+	readAt  int64
+	writeAt int64
+}
+
+func (s *spillFile[T]) len() int {
+	return s.sizes.Len()
+}
+
+func (s *spillFile[T]) push(data T) error {
+	if s.file == nil {
+		file, err := os.CreateTemp(s.dir, "stream-spill-*")
+		if err != nil {
+			return err
+		}
+		os.Remove(file.Name())
+		s.file = file
+	}
+
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.file.WriteAt(bytes, s.writeAt); err != nil {
+		return err
+	}
+
+	s.writeAt += int64(len(bytes))
+	s.sizes.PushBack(int64(len(bytes)))
+	return nil
+}
+
+func (s *spillFile[T]) pop() (T, error) {
+	var data T
+
+	size := s.sizes.PopFront()
+	bytes := make([]byte, size)
+	if _, err := s.file.ReadAt(bytes, s.readAt); err != nil {
+		return data, err
+	}
+	s.readAt += size
+
+	// start over once everything is read back, the file never grows beyond the largest backlog
+	if s.sizes.Len() == 0 {
+		s.readAt = 0
+		s.writeAt = 0
+		s.file.Truncate(0)
+	}
+
+	if err := json.Unmarshal(bytes, &data); err != nil {
+		return data, err
+	}
+
+	return data, nil
+}
+
+// release closes the file, the disk space is freed then
+func (s *spillFile[T]) release() {
+	if s.file == nil {
+		return
+	}
+
+	s.file.Close()
+	s.file = nil
+}
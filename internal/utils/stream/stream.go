@@ -1,6 +1,7 @@
 package stream
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"sync/atomic"
@@ -8,7 +9,10 @@ import (
 	"github.com/gammazero/deque"
 )
 
-var ErrEmpty = errors.New("no data available")
+var (
+	ErrEmpty = errors.New("no data available")
+	ErrFull  = errors.New("queue is full")
+)
 
 type Stream[T any] struct {
 	q         deque.Deque[T]
@@ -23,16 +27,38 @@ type Stream[T any] struct {
 	filter      []func(T) error
 
 	err error
+
+	// what happens to writes once max items are buffered, see SetBlocking and SetSpill
+	blocking bool
+	spill    *spillFile[T]
+	// signaled once the reader frees a slot
+	space chan bool
+	// closed once the stream is closed, blocked writers give up then
+	done chan bool
 }
 
 func NewStream[T any](max int) *Stream[T] {
 	return &Stream[T]{
-		l:   &sync.Mutex{},
-		sig: make(chan bool),
-		max: max,
+		l:     &sync.Mutex{},
+		sig:   make(chan bool),
+		max:   max,
+		space: make(chan bool, 1),
+		done:  make(chan bool),
 	}
 }
 
+// SetBlocking makes writes wait for the reader once the buffer is full instead of failing,
+// it should be called before the stream is used
+func (r *Stream[T]) SetBlocking() {
+	r.blocking = true
+}
+
+// SetSpill makes writes go to a temporary file in dir once the buffer is full instead of failing,
+// data must be json serializable, it should be called before the stream is used
+func (r *Stream[T]) SetSpill(dir string) {
+	r.spill = &spillFile[T]{dir: dir}
+}
+
 // Filter filters the stream with a function
 // if the function returns an error, the stream will be closed
 func (r *Stream[T]) Filter(f func(T) error) {
@@ -55,12 +81,16 @@ func (r *Stream[T]) BeforeClose(f func()) {
 // NOTE: even if the stream is closed, it will return true if there is data available
 func (r *Stream[T]) Next() bool {
 	r.l.Lock()
-	if r.closed == 1 && r.q.Len() == 0 && r.err == nil {
+	if atomic.LoadInt32(&r.closed) == 1 && r.size() == 0 && r.err == nil {
+		// nothing would be spilled anymore
+		if r.spill != nil {
+			r.spill.release()
+		}
 		r.l.Unlock()
 		return false
 	}
 
-	if r.q.Len() > 0 || r.err != nil {
+	if r.size() > 0 || r.err != nil {
 		r.l.Unlock()
 		return true
 	}
@@ -80,8 +110,15 @@ func (r *Stream[T]) Read() (T, error) {
 	r.l.Lock()
 	defer r.l.Unlock()
 
+	if err := r.unspill(); err != nil {
+		var data T
+		return data, err
+	}
+
 	if r.q.Len() > 0 {
 		data := r.q.PopFront()
+		r.signalSpace()
+
 		for _, f := range r.filter {
 			err := f(data)
 			if err != nil {
@@ -116,36 +153,109 @@ func (r *Stream[T]) Async(fn func(T)) error {
 	return nil
 }
 
-// Write writes data to the stream,
-// returns error if the buffer is full
+// Write writes data to the stream, once the buffer is full it returns ErrFull,
+// waits for the reader if the stream is blocking, or spills data to disk if a spill is set
 func (r *Stream[T]) Write(data T) error {
-	if atomic.LoadInt32(&r.closed) == 1 {
-		return nil
-	}
+	return r.WriteContext(context.Background(), data)
+}
 
-	r.l.Lock()
+// WriteContext is like Write, but a blocking stream gives up waiting for the reader once ctx is done
+func (r *Stream[T]) WriteContext(ctx context.Context, data T) error {
+	for {
+		if atomic.LoadInt32(&r.closed) == 1 {
+			return nil
+		}
+
+		r.l.Lock()
+
+		// data is kept in order, nothing skips ahead of spilled data
+		if r.q.Len() < r.max && (r.spill == nil || r.spill.len() == 0) {
+			r.q.PushBack(data)
+			r.notify()
+			// another blocked writer could take the rest of the space
+			if r.q.Len() < r.max {
+				r.signalSpace()
+			}
+			r.l.Unlock()
+			return nil
+		}
+
+		if r.spill != nil {
+			err := r.spill.push(data)
+			if err == nil {
+				r.notify()
+			}
+			r.l.Unlock()
+			return err
+		}
 
-	if r.q.Len() >= r.max {
 		r.l.Unlock()
-		return errors.New("queue is full")
+
+		if !r.blocking {
+			return ErrFull
+		}
+
+		select {
+		case <-r.space:
+		case <-r.done:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
 	}
+}
 
-	r.q.PushBack(data)
-	if r.q.Len() == 1 {
+// notify wakes the reader up once the first item is buffered
+func (r *Stream[T]) notify() {
+	if r.size() == 1 {
 		if r.listening {
 			r.sig <- true
 		}
 	}
-	r.l.Unlock()
+}
+
+func (r *Stream[T]) signalSpace() {
+	select {
+	case r.space <- true:
+	default:
+	}
+}
+
+// unspill moves spilled data back to the buffer as long as it fits
+func (r *Stream[T]) unspill() error {
+	if r.spill == nil {
+		return nil
+	}
+
+	for r.spill.len() > 0 && (r.q.Len() < r.max || r.q.Len() == 0) {
+		data, err := r.spill.pop()
+		if err != nil {
+			return err
+		}
+		r.q.PushBack(data)
+	}
+
 	return nil
 }
 
+// size returns the number of buffered items including spilled ones
+func (r *Stream[T]) size() int {
+	size := r.q.Len()
+	if r.spill != nil {
+		size += r.spill.len()
+	}
+	return size
+}
+
 // Close closes the stream
 func (r *Stream[T]) Close() {
 	if !atomic.CompareAndSwapInt32(&r.closed, 0, 1) {
 		return
 	}
 
+	// release blocked writers first, they may hold locks needed by the callbacks
+	close(r.done)
+
 	for _, f := range r.beforeClose {
 		f()
 	}
@@ -169,7 +279,7 @@ func (r *Stream[T]) Size() int {
 	r.l.Lock()
 	defer r.l.Unlock()
 
-	return r.q.Len()
+	return r.size()
 }
 
 // WriteError writes an error to the stream
@@ -183,7 +293,7 @@ func (r *Stream[T]) WriteError(err error) {
 
 	r.err = err
 
-	if r.q.Len() == 0 {
+	if r.size() == 0 {
 		if r.listening {
 			r.sig <- true
 		}
@@ -1,6 +1,7 @@
 package stream
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"testing"
@@ -91,3 +92,116 @@ func TestStreamGeneratorWrapper(t *testing.T) {
 		t.Errorf("Expected 10000 messages, got %d", nums)
 	}
 }
+
+func TestStreamFull(t *testing.T) {
+	response := NewStream[int](2)
+	response.Write(1)
+	response.Write(2)
+
+	if err := response.Write(3); err != ErrFull {
+		t.Errorf("Expected ErrFull, got %v", err)
+	}
+}
+
+func TestStreamBlocking(t *testing.T) {
+	response := NewStream[int](4)
+	response.SetBlocking()
+
+	go func() {
+		for i := 0; i < 1000; i++ {
+			if err := response.Write(i); err != nil {
+				t.Error(err)
+			}
+		}
+		response.Close()
+	}()
+
+	expected := 0
+	for response.Next() {
+		data, err := response.Read()
+		if err != nil {
+			t.Error(err)
+		}
+		if data != expected {
+			t.Errorf("Expected %d, got %d", expected, data)
+		}
+		expected++
+
+		// a slow reader
+		if expected%100 == 0 {
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	if expected != 1000 {
+		t.Errorf("Expected 1000 messages, got %d", expected)
+	}
+}
+
+func TestStreamBlockingContext(t *testing.T) {
+	response := NewStream[int](1)
+	response.SetBlocking()
+	response.Write(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := response.WriteContext(ctx, 2); err != context.DeadlineExceeded {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestStreamBlockingClose(t *testing.T) {
+	response := NewStream[int](1)
+	response.SetBlocking()
+	response.Write(1)
+
+	done := make(chan error)
+	go func() {
+		done <- response.Write(2)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	response.Close()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Expected nil, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Error("Write should return once the stream is closed")
+	}
+}
+
+func TestStreamSpill(t *testing.T) {
+	response := NewStream[map[string]int](4)
+	response.SetSpill(t.TempDir())
+
+	for i := 0; i < 1000; i++ {
+		if err := response.Write(map[string]int{"i": i}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	response.Close()
+
+	if response.Size() != 1000 {
+		t.Errorf("Expected 1000 buffered messages, got %d", response.Size())
+	}
+
+	expected := 0
+	for response.Next() {
+		data, err := response.Read()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if data["i"] != expected {
+			t.Errorf("Expected %d, got %d", expected, data["i"])
+		}
+		expected++
+	}
+
+	if expected != 1000 {
+		t.Errorf("Expected 1000 messages, got %d", expected)
+	}
+}